@@ -8,14 +8,19 @@
 //	  --input ./chain_configs \
 //	  --pathfinder-output ./generated/pathfinder_config.toml \
 //	  --client-output ./generated/client_config.json
+//
+// With --diff the configs at the output paths are not overwritten, the changes from them
+// are printed instead, as text or with --diff-format json as JSON.
 package main
 
 import (
+	"encoding/json"
 	"flag"
 	"log"
 	"os"
 	"strings"
 
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/output"
 	"github.com/Cogwheel-Validator/spectra-portal/config_manager/pipeline"
 )
 
@@ -29,8 +34,12 @@ func main() {
 	localIbcRegistry := flag.String("local-registry-cache", "", "Path to cache IBC registry data (optional)")
 	localKeplrRegistry := flag.String("local-keplr-cache", "", "Path to cache Keplr registry data (optional)")
 	skipNetwork := flag.Bool("skip-network", false, "Skip network validation of endpoints")
+	skipChannelCheck := flag.Bool("skip-channel-check", false, "Skip checking on chain that the route channels are open")
+	skipDenomCheck := flag.Bool("skip-denom-check", false, "Skip cross-checking the IBC denoms against the on-chain denom traces")
 	useLocalReg := flag.Bool("use-local-data", false, "Use cached registry data instead of downloading fresh")
 	validate := flag.Bool("validate-only", false, "Only validate configs, don't generate")
+	diff := flag.Bool("diff", false, "Print the changes from the configs at the output paths instead of overwriting them")
+	diffFormat := flag.String("diff-format", "text", "Diff report format: text, json")
 	// If the path is set for this option the program will assume this is enabled and will try to copy the icons.
 	copyIconsPath := flag.String("copy-icons", "", "Copy icons to the public/icons directory")
 	allowedExplorersPath := flag.String("allowed-explorers", "./explorers/allowed_explorers.toml", "Path to the allowed explorers file")
@@ -44,23 +53,31 @@ func main() {
 	}
 
 	config := pipeline.GeneratorConfig{
-		InputDir:               *inputDir,
-		PathfinderOutputPath:   *pathfinderOutput,
-		ClientOutputPath:       *clientOutput,
-		PathfinderOutputFormat: parseFormat(*pathfinderFormat),
-		ClientOutputFormat:     parseFormat(*clientFormat),
-		LocalIbcRegistryPath:   *localIbcRegistry,
-		LocalKeplrRegistryPath: *localKeplrRegistry,
-		SkipNetworkValidation:  *skipNetwork,
-		UseLocalIbcReg:         *useLocalReg,
-		UseLocalKeplrReg:       *useLocalReg,
-		CopyIconsPath:          *copyIconsPath,
-		AllowedExplorersPath:   *allowedExplorersPath,
+		InputDir:                *inputDir,
+		PathfinderOutputPath:    *pathfinderOutput,
+		ClientOutputPath:        *clientOutput,
+		PathfinderOutputFormat:  parseFormat(*pathfinderFormat),
+		ClientOutputFormat:      parseFormat(*clientFormat),
+		LocalIbcRegistryPath:    *localIbcRegistry,
+		LocalKeplrRegistryPath:  *localKeplrRegistry,
+		SkipNetworkValidation:   *skipNetwork,
+		SkipChannelVerification: *skipChannelCheck,
+		SkipDenomVerification:   *skipDenomCheck,
+		UseLocalIbcReg:          *useLocalReg,
+		UseLocalKeplrReg:        *useLocalReg,
+		CopyIconsPath:           *copyIconsPath,
+		AllowedExplorersPath:    *allowedExplorersPath,
+		DiffOnly:                *diff,
 	}
 
 	if *validate {
 		config.PathfinderOutputPath = ""
 		config.ClientOutputPath = ""
+		config.DiffOnly = false
+	}
+	if config.DiffOnly {
+		// Nothing is written in diff mode
+		config.CopyIconsPath = ""
 	}
 
 	generator := pipeline.NewGenerator(config)
@@ -84,6 +101,13 @@ func main() {
 		}
 	}
 
+	if result.Diff != nil {
+		if err := printDiff(result.Diff, *diffFormat); err != nil {
+			log.Printf("Error while printing the diff report: %v", err)
+			os.Exit(1)
+		}
+	}
+
 	// Print validation failures
 	hasFailures := false
 	for chainID, valResult := range result.ValidationResults {
@@ -98,7 +122,7 @@ func main() {
 		os.Exit(1)
 	}
 
-	if !*validate {
+	if !*validate && !*diff {
 		log.Printf("Output files:")
 		if result.PathfinderConfigPath != "" {
 			log.Printf("\tPathfinder: %s", result.PathfinderConfigPath)
@@ -111,6 +135,16 @@ func main() {
 	log.Printf("Finished the generation pipeline!")
 }
 
+// printDiff writes the diff report to stdout, apart from the logs on stderr so it can be piped into a file
+func printDiff(diff *output.ConfigDiff, format string) error {
+	if strings.ToLower(format) == "json" {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		return encoder.Encode(diff)
+	}
+	return diff.WriteText(os.Stdout)
+}
+
 func parseFormat(s string) pipeline.OutputFormat {
 	switch strings.ToLower(s) {
 	case "toml":
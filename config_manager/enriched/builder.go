@@ -24,12 +24,15 @@ const (
 // Builder builds enriched configurations from input configs and IBC registry data.
 // It computes IBC denoms deterministically from the defined tokens and channels,
 // without querying chain REST APIs. This ensures only "legitimate" tokens are included.
-// It also validates the network reachability of the chain fully.
+// It also validates the network reachability of the chain fully and checks on chain that
+// the channels of the routes are still open and that the computed IBC denoms match the denom traces of the chain.
 type Builder struct {
 	retryAttempts    int
 	retryDelay       time.Duration
 	timeout          time.Duration
 	skipNetCheck     bool
+	verifyChannels   bool
+	verifyDenoms     bool
 	allowedExplorers []input.AllowedExplorer
 }
 
@@ -64,6 +67,20 @@ func WithSkipNetworkCheck(skip bool) BuilderOption {
 	}
 }
 
+// WithChannelVerification enables or disables querying the on-chain state of the route channels.
+func WithChannelVerification(verify bool) BuilderOption {
+	return func(b *Builder) {
+		b.verifyChannels = verify
+	}
+}
+
+// WithDenomVerification enables or disables cross-checking the computed IBC denoms against the on-chain denom traces.
+func WithDenomVerification(verify bool) BuilderOption {
+	return func(b *Builder) {
+		b.verifyDenoms = verify
+	}
+}
+
 // NewBuilder creates a new enriched config builder.
 func NewBuilder(allowedExplorers []input.AllowedExplorer, opts ...BuilderOption) *Builder {
 	b := &Builder{
@@ -71,6 +88,8 @@ func NewBuilder(allowedExplorers []input.AllowedExplorer, opts ...BuilderOption)
 		retryDelay:       defaultRetryDelay,
 		timeout:          defaultTimeout,
 		skipNetCheck:     false,
+		verifyChannels:   true,
+		verifyDenoms:     true,
 		allowedExplorers: allowedExplorers,
 	}
 	for _, opt := range opts {
@@ -124,6 +143,11 @@ func (b *Builder) BuildRegistry(
 		return nil, fmt.Errorf("failed to build any chain configurations")
 	}
 
+	// Both ends of a channel are known now, a route is only usable if the counterparty end is open too
+	if b.verifyChannels {
+		routeBuilder.VerifyCounterparties(reg.Chains)
+	}
+
 	return reg, nil
 }
 
@@ -151,6 +175,7 @@ func (b *Builder) buildChainConfig(
 		IsBroker:         chain.IsBroker,
 		BrokerID:         chain.BrokerID,
 		IBCHooksContract: chain.IBCHooksContract,
+		PFMFeePercentage: chain.PFMFeePercentage,
 		KeplrChainConfig: keplrConfig,
 	}
 
@@ -176,6 +201,24 @@ func (b *Builder) buildChainConfig(
 	config.CosmosSdkVersion = additionalNodeInfo.ApplicationVersion.CosmosSdkVersion
 	config.HasPFM = findPfmSupport(additionalNodeInfo)
 
+	// Check on chain that the channels of the routes are still open and their clients active
+	if b.verifyChannels {
+		querier := query.NewIBCQuerier(randomRestEndpoint.URL, b.timeout, b.retryAttempts, b.retryDelay)
+		routeBuilder.VerifyRoutes(chain.ID, config.Routes, querier)
+	}
+
+	// Cross-check the computed IBC denoms against the denom traces of the chain
+	if b.verifyDenoms {
+		querier := query.NewDenomQuerier(randomRestEndpoint.URL, b.timeout, b.retryAttempts, b.retryDelay)
+		traces, err := querier.QueryAllDenomTraces()
+		if err != nil {
+			log.Printf("Warning: could not verify the IBC denoms of chain %s: %v", chain.ID, err)
+		} else {
+			report := routeBuilder.VerifyIBCDenoms(chain.ID, traces)
+			config.DenomReport = &report
+		}
+	}
+
 	log.Printf("Chain %s: %d routes, %d native tokens, %d IBC tokens",
 		chain.ID, len(config.Routes), len(config.NativeTokens), len(config.IBCTokens))
 
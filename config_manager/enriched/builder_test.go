@@ -5,6 +5,8 @@ import (
 
 	"github.com/Cogwheel-Validator/spectra-portal/config_manager/input"
 	"github.com/Cogwheel-Validator/spectra-portal/config_manager/keplr"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query/querytest"
 	"github.com/Cogwheel-Validator/spectra-portal/config_manager/registry"
 )
 
@@ -596,6 +598,110 @@ func TestStatusCheck(t *testing.T) {
 	}
 }
 
+func TestRouteChannelVerification(t *testing.T) {
+	// Both chains are served by fake REST endpoints, the client AtomOne tracks Osmosis with has expired
+	atomoneRest := querytest.NewServer("atomone-1")
+	defer atomoneRest.Close()
+	atomoneRest.AddChannel("channel-2", "connection-2", "07-tendermint-2")
+	atomoneRest.SetClientStatus("07-tendermint-2", querytest.ClientExpired)
+
+	osmosisRest := querytest.NewServer("osmosis-1")
+	defer osmosisRest.Close()
+	osmosisRest.AddChannel("channel-94814", "connection-4829", "07-tendermint-3396")
+
+	inputConfigs := createTestInputConfigs()
+	inputConfigs["atomone-1"].Chain.Rest = []input.APIEndpoint{{URL: atomoneRest.URL}}
+	inputConfigs["osmosis-1"].Chain.Rest = []input.APIEndpoint{{URL: osmosisRest.URL}}
+
+	builder := NewBuilder(createTestAllowedExplorers(), WithSkipNetworkCheck(true), WithRetryAttempts(0))
+	reg, err := builder.BuildRegistry(inputConfigs, createTestIBCData(), createTestKeplrConfigs())
+	if err != nil {
+		t.Fatalf("BuildRegistry() error = %v", err)
+	}
+
+	atomoneRoute := reg.Chains["atomone-1"].Routes[0]
+	if atomoneRoute.LiveStatus == nil {
+		t.Fatal("atomone route LiveStatus should be set")
+	}
+	if atomoneRoute.LiveStatus.ClientStatus != "Expired" {
+		t.Errorf("atomone route ClientStatus = %q, want 'Expired'", atomoneRoute.LiveStatus.ClientStatus)
+	}
+	if atomoneRoute.IsUsable() {
+		t.Error("atomone route should not be usable with an expired client")
+	}
+
+	osmosisRoute := reg.Chains["osmosis-1"].Routes[0]
+	if osmosisRoute.IsUsable() {
+		t.Error("osmosis route should not be usable when the AtomOne end of the channel has an expired client")
+	}
+	if osmosisRoute.LiveStatus.ClientStatus != "Active" {
+		t.Errorf("osmosis route ClientStatus = %q, want 'Active'", osmosisRoute.LiveStatus.ClientStatus)
+	}
+	if osmosisRoute.Version != "ics20-1" {
+		t.Errorf("osmosis route Version = %q, want 'ics20-1'", osmosisRoute.Version)
+	}
+}
+
+func TestRouteChannelVerificationCounterparty(t *testing.T) {
+	// Only the client Osmosis tracks AtomOne with has expired, the AtomOne end of the channel is healthy
+	atomoneRest := querytest.NewServer("atomone-1")
+	defer atomoneRest.Close()
+	atomoneRest.AddChannel("channel-2", "connection-2", "07-tendermint-2")
+
+	osmosisRest := querytest.NewServer("osmosis-1")
+	defer osmosisRest.Close()
+	osmosisRest.AddChannel("channel-94814", "connection-4829", "07-tendermint-3396")
+	osmosisRest.SetClientStatus("07-tendermint-3396", querytest.ClientExpired)
+
+	inputConfigs := createTestInputConfigs()
+	inputConfigs["atomone-1"].Chain.Rest = []input.APIEndpoint{{URL: atomoneRest.URL}}
+	inputConfigs["osmosis-1"].Chain.Rest = []input.APIEndpoint{{URL: osmosisRest.URL}}
+
+	builder := NewBuilder(createTestAllowedExplorers(), WithSkipNetworkCheck(true), WithRetryAttempts(0))
+	reg, err := builder.BuildRegistry(inputConfigs, createTestIBCData(), createTestKeplrConfigs())
+	if err != nil {
+		t.Fatalf("BuildRegistry() error = %v", err)
+	}
+
+	atomoneRoute := reg.Chains["atomone-1"].Routes[0]
+	if atomoneRoute.IsUsable() {
+		t.Error("atomone route should not be usable when the destination client has expired")
+	}
+	if atomoneRoute.LiveStatus.ClientStatus != "Active" {
+		t.Errorf("atomone route ClientStatus = %q, want 'Active'", atomoneRoute.LiveStatus.ClientStatus)
+	}
+	if atomoneRoute.LiveStatus.Counterparty == nil || atomoneRoute.LiveStatus.Counterparty.ClientStatus != "Expired" {
+		t.Errorf("atomone route counterparty status = %+v, want an expired client", atomoneRoute.LiveStatus.Counterparty)
+	}
+
+	if reg.Chains["osmosis-1"].Routes[0].IsUsable() {
+		t.Error("osmosis route should not be usable with an expired client")
+	}
+}
+
+func TestRouteChannelVerificationUnreachable(t *testing.T) {
+	// The channel isn't known to the endpoint, the route keeps its registry status
+	atomoneRest := querytest.NewServer("atomone-1")
+	defer atomoneRest.Close()
+	osmosisRest := querytest.NewServer("osmosis-1")
+	defer osmosisRest.Close()
+
+	inputConfigs := createTestInputConfigs()
+	inputConfigs["atomone-1"].Chain.Rest = []input.APIEndpoint{{URL: atomoneRest.URL}}
+	inputConfigs["osmosis-1"].Chain.Rest = []input.APIEndpoint{{URL: osmosisRest.URL}}
+
+	builder := NewBuilder(createTestAllowedExplorers(), WithSkipNetworkCheck(true), WithRetryAttempts(0))
+	reg, err := builder.BuildRegistry(inputConfigs, createTestIBCData(), createTestKeplrConfigs())
+	if err != nil {
+		t.Fatalf("BuildRegistry() error = %v", err)
+	}
+
+	route := reg.Chains["atomone-1"].Routes[0]
+	if route.LiveStatus != nil || !route.IsUsable() {
+		t.Errorf("unverified route should be kept, live status = %+v", route.LiveStatus)
+	}
+}
+
 func TestEmptyInputConfigs(t *testing.T) {
 	builder := NewBuilder(createTestAllowedExplorers(), WithSkipNetworkCheck(true))
 	_, err := builder.BuildRegistry(map[string]*input.ChainInput{}, nil, nil)
@@ -666,3 +772,227 @@ func TestNativeVsRoutableTokenCategorization(t *testing.T) {
 		t.Errorf("Routable STARS OriginDenom = %q, want 'ustars'", routable.OriginDenom)
 	}
 }
+
+func TestVerifyIBCDenoms(t *testing.T) {
+	// The test config sets the denom of STARS over channel-75 as the routable STARS on Osmosis
+	starsOnOsmosis := query.ParseDenomTrace("transfer/channel-75", "ustars").IBCDenom
+	typoStars := "ibc/987C17B11ABC2B20019178ACE62929FE9840202CE79498E29FE8E5CB02B7C0A5"
+
+	t.Run("no traces", func(t *testing.T) {
+		rb := NewRouteBuilder(createTestInputConfigsWithMultiHop(), createTestIBCDataWithStargaze())
+		report := rb.VerifyIBCDenoms("osmosis-1", nil)
+		if len(report.Mismatches) != 0 {
+			t.Errorf("Mismatches = %v, want none", report.Mismatches)
+		}
+		if len(report.Unverified) != 1 || report.Unverified[0] != starsOnOsmosis {
+			t.Errorf("Unverified = %v, want [%s]", report.Unverified, starsOnOsmosis)
+		}
+	})
+
+	t.Run("wrong denom", func(t *testing.T) {
+		configs := createTestInputConfigsWithMultiHop()
+		configs["osmosis-1"].Tokens[1].Denom = typoStars
+
+		rb := NewRouteBuilder(configs, createTestIBCDataWithStargaze())
+		report := rb.VerifyIBCDenoms("osmosis-1", []query.DenomTraceInfo{
+			{Path: "transfer/channel-94814", BaseDenom: "uatone"},
+			{Path: "transfer/channel-75", BaseDenom: "ustars"},
+		})
+		if len(report.Mismatches) != 1 {
+			t.Fatalf("Mismatches = %v, want 1", report.Mismatches)
+		}
+		mismatch := report.Mismatches[0]
+		if mismatch.Denom != typoStars {
+			t.Errorf("Mismatch denom = %q, want %q", mismatch.Denom, typoStars)
+		}
+		if len(mismatch.OnChain) != 1 || mismatch.OnChain[0] != starsOnOsmosis {
+			t.Errorf("Mismatch OnChain = %v, want [%s]", mismatch.OnChain, starsOnOsmosis)
+		}
+	})
+
+	t.Run("denom of another token", func(t *testing.T) {
+		configs := createTestInputConfigsWithMultiHop()
+		atoneOnOsmosis := query.ParseDenomTrace("transfer/channel-94814", "uatone").IBCDenom
+		configs["osmosis-1"].Tokens[1].Denom = atoneOnOsmosis
+
+		rb := NewRouteBuilder(configs, createTestIBCDataWithStargaze())
+		report := rb.VerifyIBCDenoms("osmosis-1", []query.DenomTraceInfo{
+			{Path: "transfer/channel-94814", BaseDenom: "uatone"},
+		})
+		if len(report.Mismatches) != 1 || report.Mismatches[0].Denom != atoneOnOsmosis {
+			t.Errorf("Mismatches = %v, want the STARS token with the ATONE denom", report.Mismatches)
+		}
+	})
+
+	t.Run("matching denom", func(t *testing.T) {
+		rb := NewRouteBuilder(createTestInputConfigsWithMultiHop(), createTestIBCDataWithStargaze())
+		report := rb.VerifyIBCDenoms("osmosis-1", []query.DenomTraceInfo{
+			{Path: "transfer/channel-75", BaseDenom: "ustars"},
+		})
+		if len(report.Mismatches) != 0 || len(report.Unverified) != 0 {
+			t.Errorf("report = %+v, want no mismatches and nothing unverified", report)
+		}
+	})
+
+	t.Run("wrong one-hop channel", func(t *testing.T) {
+		// The Osmosis end of the AtomOne channel has a typo, ATONE arrives over channel-94814
+		ibcData := createTestIBCDataWithStargaze()
+		ibcData[0].Channels[0].Chain2.ChannelID = "channel-9481"
+		atoneOnOsmosis := query.ParseDenomTrace("transfer/channel-94814", "uatone").IBCDenom
+		computedAtone := query.ParseDenomTrace("transfer/channel-9481", "uatone").IBCDenom
+
+		rb := NewRouteBuilder(createTestInputConfigsWithMultiHop(), ibcData)
+		report := rb.VerifyIBCDenoms("osmosis-1", []query.DenomTraceInfo{
+			{Path: "transfer/channel-94814", BaseDenom: "uatone"},
+			{Path: "transfer/channel-75", BaseDenom: "ustars"},
+		})
+		if len(report.Mismatches) != 1 {
+			t.Fatalf("Mismatches = %v, want 1", report.Mismatches)
+		}
+		mismatch := report.Mismatches[0]
+		if mismatch.Denom != computedAtone || mismatch.OriginChain != "atomone-1" {
+			t.Errorf("Mismatch = %+v, want the ATONE denom over channel-9481", mismatch)
+		}
+		if len(mismatch.OnChain) != 1 || mismatch.OnChain[0] != atoneOnOsmosis {
+			t.Errorf("Mismatch OnChain = %v, want [%s]", mismatch.OnChain, atoneOnOsmosis)
+		}
+	})
+
+	t.Run("multi-hop suggestion", func(t *testing.T) {
+		rb := NewRouteBuilder(createTestInputConfigsWithMultiHop(), createTestIBCDataWithStargaze())
+		report := rb.VerifyIBCDenoms("atomone-1", []query.DenomTraceInfo{
+			{Path: "transfer/channel-2", BaseDenom: "uosmo"},
+			{Path: "transfer/channel-2/transfer/channel-75", BaseDenom: "ustars"},
+			{Path: "transfer/channel-2/transfer/channel-9999", BaseDenom: "uunknown"},
+		})
+		if len(report.Suggestions) != 1 {
+			t.Fatalf("Suggestions = %v, want 1", report.Suggestions)
+		}
+		suggestion := report.Suggestions[0]
+		if suggestion.OriginChain != "stargaze-1" || suggestion.OriginDenom != "ustars" {
+			t.Errorf("Suggestion = %+v, want STARS from stargaze-1", suggestion)
+		}
+		want := query.ParseDenomTrace("transfer/channel-2/transfer/channel-75", "ustars").IBCDenom
+		if suggestion.Denom != want {
+			t.Errorf("Suggestion denom = %q, want %q", suggestion.Denom, want)
+		}
+	})
+}
+
+func TestDenomVerification(t *testing.T) {
+	atomoneRest := querytest.NewServer("atomone-1")
+	defer atomoneRest.Close()
+	osmosisRest := querytest.NewServer("osmosis-1")
+	defer osmosisRest.Close()
+	stargazeRest := querytest.NewServer("stargaze-1")
+	defer stargazeRest.Close()
+	osmosisRest.AddDenomTrace("transfer/channel-75", "ustars")
+
+	// The routable STARS on Osmosis has a typo in its denom
+	inputConfigs := createTestInputConfigsWithMultiHop()
+	inputConfigs["osmosis-1"].Tokens[1].Denom = "ibc/987C17B11ABC2B20019178ACE62929FE9840202CE79498E29FE8E5CB02B7C0A5"
+	inputConfigs["atomone-1"].Chain.Rest = []input.APIEndpoint{{URL: atomoneRest.URL}}
+	inputConfigs["osmosis-1"].Chain.Rest = []input.APIEndpoint{{URL: osmosisRest.URL}}
+	inputConfigs["stargaze-1"].Chain.Rest = []input.APIEndpoint{{URL: stargazeRest.URL}}
+
+	builder := NewBuilder(createTestAllowedExplorers(),
+		WithSkipNetworkCheck(true), WithRetryAttempts(0), WithChannelVerification(false))
+	reg, err := builder.BuildRegistry(inputConfigs, createTestIBCDataWithStargaze(), createTestKeplrConfigs())
+	if err != nil {
+		t.Fatalf("BuildRegistry() error = %v", err)
+	}
+
+	report := reg.Chains["osmosis-1"].DenomReport
+	if report == nil {
+		t.Fatal("osmosis DenomReport should be set")
+	}
+	if len(report.Mismatches) != 1 {
+		t.Errorf("osmosis Mismatches = %v, want the STARS denom with the typo", report.Mismatches)
+	}
+	if reg.Chains["atomone-1"].DenomReport == nil {
+		t.Error("atomone DenomReport should be set")
+	}
+}
+
+func TestTracePaths(t *testing.T) {
+	starsOnOsmosis := query.ParseDenomTrace("transfer/channel-75", "ustars").IBCDenom
+	starsOnAtomone := query.ParseDenomTrace("transfer/channel-2/transfer/channel-75", "ustars").IBCDenom
+
+	// STARS is also routable on AtomOne, where it arrives from Stargaze through Osmosis
+	configs := createTestInputConfigsWithMultiHop()
+	configs["atomone-1"].Tokens = append(configs["atomone-1"].Tokens, input.TokenMeta{
+		Denom:       starsOnAtomone,
+		Name:        "Stargaze",
+		Symbol:      "STARS",
+		Exponent:    6,
+		Icon:        "https://example.com/stars.png",
+		OriginChain: "stargaze-1",
+		OriginDenom: "ustars",
+	})
+	rb := NewRouteBuilder(configs, createTestIBCDataWithStargaze())
+
+	if path := rb.tracePaths["osmosis-1"][starsOnOsmosis]; path != "transfer/channel-75" {
+		t.Errorf("STARS path on Osmosis = %q, want 'transfer/channel-75'", path)
+	}
+	if path := rb.tracePaths["atomone-1"][starsOnAtomone]; path != "transfer/channel-2/transfer/channel-75" {
+		t.Errorf("STARS path on AtomOne = %q, want 'transfer/channel-2/transfer/channel-75'", path)
+	}
+
+	findToken := func(fromChainID, toChainID, denom string) RouteTokenInfo {
+		t.Helper()
+		for _, route := range rb.BuildRoutesForChain(fromChainID) {
+			if route.ToChainID != toChainID {
+				continue
+			}
+			for _, token := range route.AllowedTokens {
+				if token.SourceDenom == denom {
+					return token
+				}
+			}
+		}
+		t.Fatalf("%s not found on route %s -> %s", denom, fromChainID, toChainID)
+		return RouteTokenInfo{}
+	}
+
+	// Forwarded from Osmosis, AtomOne prepends its channel
+	forwarded := findToken("osmosis-1", "atomone-1", starsOnOsmosis)
+	if forwarded.SourcePath != "transfer/channel-75" {
+		t.Errorf("forwarded SourcePath = %q, want 'transfer/channel-75'", forwarded.SourcePath)
+	}
+	if forwarded.DestinationPath != "transfer/channel-2/transfer/channel-75" {
+		t.Errorf("forwarded DestinationPath = %q, want 'transfer/channel-2/transfer/channel-75'", forwarded.DestinationPath)
+	}
+	if forwarded.DestinationDenom != starsOnAtomone {
+		t.Errorf("forwarded DestinationDenom = %q, want %q", forwarded.DestinationDenom, starsOnAtomone)
+	}
+
+	// Sent back from AtomOne to Osmosis, it unwinds a hop
+	unwound := findToken("atomone-1", "osmosis-1", starsOnAtomone)
+	if unwound.DestinationPath != "transfer/channel-75" || unwound.DestinationDenom != starsOnOsmosis {
+		t.Errorf("unwound destination = %q (%s), want %q (transfer/channel-75)",
+			unwound.DestinationDenom, unwound.DestinationPath, starsOnOsmosis)
+	}
+
+	// Native tokens get the channel of the destination
+	atone := findToken("atomone-1", "osmosis-1", "uatone")
+	if atone.SourcePath != "" || atone.DestinationPath != "transfer/channel-94814" {
+		t.Errorf("native paths = %q -> %q, want '' -> 'transfer/channel-94814'", atone.SourcePath, atone.DestinationPath)
+	}
+
+	for _, token := range rb.BuildIBCTokensForChain("atomone-1") {
+		if token.IBCDenom == starsOnAtomone && (token.IBCPath != "transfer/channel-2/transfer/channel-75" || token.SourceChannel != "channel-2") {
+			t.Errorf("STARS IBC token path = %q over %q, want the derived path over channel-2", token.IBCPath, token.SourceChannel)
+		}
+	}
+}
+
+func TestTracePathsUnknownDenom(t *testing.T) {
+	// A denom no path gives keeps an unknown path
+	configs := createTestInputConfigsWithMultiHop()
+	configs["osmosis-1"].Tokens[1].Denom = "ibc/0000000000000000000000000000000000000000000000000000000000000000"
+	rb := NewRouteBuilder(configs, createTestIBCDataWithStargaze())
+
+	if path, ok := rb.tracePaths["osmosis-1"][configs["osmosis-1"].Tokens[1].Denom]; ok {
+		t.Errorf("unknown denom got path %q", path)
+	}
+}
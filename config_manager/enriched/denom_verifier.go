@@ -0,0 +1,200 @@
+package enriched
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/input"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+)
+
+// DenomReport is the result of cross-checking the IBC denoms of a chain against its on-chain denom traces.
+type DenomReport struct {
+	// Routable and one-hop IBC tokens whose denom doesn't match the on-chain traces, these are config errors
+	Mismatches []DenomMismatch `json:"mismatches,omitempty"`
+
+	// Routable tokens without any on-chain trace of their origin token yet, they can't be verified
+	Unverified []string `json:"unverified,omitempty"`
+
+	// Multi-hop IBC tokens found on chain that could be added as routable tokens
+	Suggestions []DenomSuggestion `json:"suggestions,omitempty"`
+}
+
+// DenomMismatch is an IBC token whose configured or computed denom doesn't match the chain.
+type DenomMismatch struct {
+	Denom       string   `json:"denom"`
+	OriginChain string   `json:"origin_chain"`
+	OriginDenom string   `json:"origin_denom"`
+	Reason      string   `json:"reason"`
+	OnChain     []string `json:"on_chain,omitempty"` // Denoms the origin token has on chain
+}
+
+func (m DenomMismatch) Error() string {
+	msg := fmt.Sprintf("IBC token %s (%s from %s): %s", m.Denom, m.OriginDenom, m.OriginChain, m.Reason)
+	if len(m.OnChain) > 0 {
+		msg += fmt.Sprintf(", on chain the token is %s", strings.Join(m.OnChain, " or "))
+	}
+	return msg
+}
+
+// DenomSuggestion is a multi-hop IBC token on chain that is not configured as a routable token.
+type DenomSuggestion struct {
+	Denom       string `json:"denom"`
+	Path        string `json:"path"`
+	OriginChain string `json:"origin_chain"`
+	OriginDenom string `json:"origin_denom"`
+}
+
+func (s DenomSuggestion) String() string {
+	return fmt.Sprintf("%s (%s from %s via %s) is on chain but not a routable token",
+		s.Denom, s.OriginDenom, s.OriginChain, s.Path)
+}
+
+// VerifyIBCDenoms cross-checks the routable IBC tokens of a chain and the one-hop IBC tokens computed from its
+// channels against the denom traces of the chain.
+//
+// The origin of every trace is found by following its channels through the configured IBC channels.
+// A routable token is a mismatch if no trace has its denom while its origin token is on chain under
+// another denom, or if the trace of its denom leads to another token. A one-hop token is a mismatch if no
+// trace has its denom while its origin token arrived over a single hop under another denom, which points
+// to a wrong configured channel. Multi-hop traces of native tokens of configured chains that are not
+// routable tokens yet are suggested.
+func (rb *RouteBuilder) VerifyIBCDenoms(chainID string, traces []query.DenomTraceInfo) DenomReport {
+	report := DenomReport{}
+
+	type tracedToken struct {
+		trace       *query.ParsedDenomTrace
+		originChain string // empty if the path leaves the configured chains
+	}
+	byDenom := make(map[string]tracedToken, len(traces))
+	for _, trace := range traces {
+		parsed := query.ParseDenomTrace(trace.Path, trace.BaseDenom)
+		originChain, _ := rb.traceOrigin(chainID, parsed)
+		byDenom[parsed.IBCDenom] = tracedToken{trace: parsed, originChain: originChain}
+	}
+
+	// onChainDenoms returns the denoms the given origin token has on chain
+	onChainDenoms := func(originChain, originDenom string) []string {
+		denoms := make([]string, 0)
+		for denom, traced := range byDenom {
+			if traced.originChain == originChain && traced.trace.BaseDenom == originDenom {
+				denoms = append(denoms, denom)
+			}
+		}
+		slices.Sort(denoms)
+		return denoms
+	}
+
+	configured := make(map[string]bool)
+	for _, token := range rb.routableTokens[chainID] {
+		configured[token.Denom] = true
+
+		mismatch := DenomMismatch{Denom: token.Denom, OriginChain: token.OriginChain, OriginDenom: token.OriginDenom}
+		traced, found := byDenom[token.Denom]
+		switch {
+		case !found:
+			mismatch.Reason = "no denom trace on chain has this denom"
+			if path, ok := rb.tracePaths[chainID][token.Denom]; ok {
+				mismatch.Reason += fmt.Sprintf(" (derived path %s)", path)
+			}
+		case traced.trace.BaseDenom != token.OriginDenom:
+			mismatch.Reason = fmt.Sprintf("the denom traces to %s via %s", traced.trace.BaseDenom, traced.trace.Path)
+		case traced.originChain != "" && traced.originChain != token.OriginChain:
+			mismatch.Reason = fmt.Sprintf("the denom traces to %s via %s", traced.originChain, traced.trace.Path)
+		default:
+			continue
+		}
+		mismatch.OnChain = onChainDenoms(token.OriginChain, token.OriginDenom)
+
+		// A token nobody has sent over its path yet has no trace, that alone is not an error
+		if !found && len(mismatch.OnChain) == 0 {
+			report.Unverified = append(report.Unverified, token.Denom)
+			continue
+		}
+		report.Mismatches = append(report.Mismatches, mismatch)
+	}
+
+	// The denoms of the one-hop tokens are computed from the configured channels, a wrong channel gives a denom
+	// nobody sends. A single hop trace of the token over a channel leading elsewhere is another token.
+	for _, token := range rb.BuildIBCTokensForChain(chainID) {
+		if configured[token.IBCDenom] {
+			continue
+		}
+		if _, found := byDenom[token.IBCDenom]; found {
+			continue
+		}
+
+		onChain := make([]string, 0)
+		for denom, traced := range byDenom {
+			if traced.trace.HopCount != 1 || traced.trace.BaseDenom != token.BaseDenom {
+				continue
+			}
+			if traced.originChain == "" || traced.originChain == token.OriginChain {
+				onChain = append(onChain, denom)
+			}
+		}
+		// A token nobody has sent over the channel yet has no trace, that alone is not an error
+		if len(onChain) == 0 {
+			continue
+		}
+		slices.Sort(onChain)
+		report.Mismatches = append(report.Mismatches, DenomMismatch{
+			Denom:       token.IBCDenom,
+			OriginChain: token.OriginChain,
+			OriginDenom: token.BaseDenom,
+			Reason:      fmt.Sprintf("no denom trace on chain has this denom (derived path %s)", token.IBCPath),
+			OnChain:     onChain,
+		})
+	}
+	slices.SortStableFunc(report.Mismatches, func(a, b DenomMismatch) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+
+	for denom, traced := range byDenom {
+		if traced.trace.HopCount < 2 || traced.originChain == "" || configured[denom] {
+			continue
+		}
+		if !rb.isNativeDenom(traced.originChain, traced.trace.BaseDenom) {
+			continue
+		}
+		report.Suggestions = append(report.Suggestions, DenomSuggestion{
+			Denom:       denom,
+			Path:        traced.trace.Path,
+			OriginChain: traced.originChain,
+			OriginDenom: traced.trace.BaseDenom,
+		})
+	}
+	slices.SortFunc(report.Suggestions, func(a, b DenomSuggestion) int {
+		return strings.Compare(a.Denom, b.Denom)
+	})
+
+	return report
+}
+
+// traceOrigin follows the channels of a trace from chainID through the configured channels and returns
+// the chain the token came from. ok is false if a channel of the path is not a configured channel.
+func (rb *RouteBuilder) traceOrigin(chainID string, trace *query.ParsedDenomTrace) (string, bool) {
+	current := chainID
+	for i, channelID := range trace.Channels {
+		next := ""
+		for toChainID, channel := range rb.channelMap[current] {
+			if channel.ChannelID == channelID && channel.PortID == trace.Ports[i] {
+				next = toChainID
+				break
+			}
+		}
+		if next == "" {
+			return "", false
+		}
+		current = next
+	}
+	return current, current != chainID
+}
+
+// isNativeDenom reports whether denom is a native token of the chain
+func (rb *RouteBuilder) isNativeDenom(chainID, denom string) bool {
+	return slices.ContainsFunc(rb.nativeTokens[chainID], func(token *input.TokenMeta) bool {
+		return token.Denom == denom
+	})
+}
@@ -32,6 +32,7 @@ type RouteBuilder struct {
 	tokenLookup     map[string]map[string]*input.TokenMeta // chainID -> denom -> token
 	nativeTokens    map[string][]*input.TokenMeta          // chainID -> native tokens only
 	routableTokens  map[string][]*input.TokenMeta          // chainID -> routable IBC tokens only
+	tracePaths      map[string]map[string]string           // chainID -> routable denom -> derived trace path
 }
 
 // ChannelInfo contains channel information for a direct connection
@@ -55,8 +56,10 @@ func NewRouteBuilder(inputConfigs map[string]*input.ChainInput, ibcData []regist
 		tokenLookup:     make(map[string]map[string]*input.TokenMeta),
 		nativeTokens:    make(map[string][]*input.TokenMeta),
 		routableTokens:  make(map[string][]*input.TokenMeta),
+		tracePaths:      make(map[string]map[string]string),
 	}
 	rb.buildLookupMaps()
+	rb.resolveTracePaths()
 	return rb
 }
 
@@ -177,6 +180,78 @@ func (rb *RouteBuilder) BuildRoutesForChain(chainID string) []RouteConfig {
 	return routes
 }
 
+// ChannelStatusQuerier queries the live state of the IBC channels of a chain.
+type ChannelStatusQuerier interface {
+	QueryChannelStatus(portID, channelID string) (query.ChannelStatus, error)
+}
+
+// VerifyRoutes queries the on-chain state of every route's channel on the source chain and records it
+// on the route. Closed channels, closed connections and expired or frozen clients make a route unusable.
+// Routes whose channel can't be queried keep the status from the registry.
+func (rb *RouteBuilder) VerifyRoutes(chainID string, routes []RouteConfig, querier ChannelStatusQuerier) {
+	for i := range routes {
+		route := &routes[i]
+		status, err := querier.QueryChannelStatus(route.PortID, route.ChannelID)
+		if err != nil {
+			log.Printf("Warning: could not verify channel %s of route %s -> %s: %v",
+				route.ChannelID, chainID, route.ToChainID, err)
+			continue
+		}
+
+		route.Ordering = status.Ordering
+		route.Version = status.Version
+		route.LiveStatus = &ChannelLiveStatus{
+			ChannelState:    status.State,
+			ConnectionState: status.ConnectionState,
+			ClientID:        status.ClientID,
+			ClientStatus:    status.ClientStatus,
+			Open:            status.IsOpen(),
+		}
+		if !status.IsOpen() {
+			log.Printf("Warning: route %s -> %s is unusable, channel %s is %s, connection %s is %s, client %s is %s",
+				chainID, route.ToChainID, route.ChannelID, status.State,
+				status.ConnectionID, status.ConnectionState, status.ClientID, status.ClientStatus)
+		}
+	}
+}
+
+// VerifyCounterparties marks the routes whose counterparty channel end is not open as unusable.
+// A transfer needs both ends of the channel, an expired client on the destination chain stops the
+// acknowledgements even if the source end is healthy. Must run after every chain's routes are verified.
+func (rb *RouteBuilder) VerifyCounterparties(chains map[string]*ChainConfig) {
+	for chainID, chain := range chains {
+		for i := range chain.Routes {
+			route := &chain.Routes[i]
+			counterparty := findCounterpartyRoute(chains[route.ToChainID], chainID, route.CounterpartyChannelID)
+			if counterparty == nil || counterparty.LiveStatus == nil || counterparty.LiveStatus.Open {
+				continue
+			}
+
+			if route.LiveStatus == nil {
+				route.LiveStatus = &ChannelLiveStatus{}
+			}
+			route.LiveStatus.Open = false
+			route.LiveStatus.Counterparty = counterparty.LiveStatus
+			log.Printf("Warning: route %s -> %s is unusable, counterparty channel %s is %s, connection is %s, client %s is %s",
+				chainID, route.ToChainID, counterparty.ChannelID, counterparty.LiveStatus.ChannelState,
+				counterparty.LiveStatus.ConnectionState, counterparty.LiveStatus.ClientID, counterparty.LiveStatus.ClientStatus)
+		}
+	}
+}
+
+// findCounterpartyRoute returns the route of chain back to toChainID over channelID.
+func findCounterpartyRoute(chain *ChainConfig, toChainID, channelID string) *RouteConfig {
+	if chain == nil {
+		return nil
+	}
+	for i := range chain.Routes {
+		if chain.Routes[i].ToChainID == toChainID && chain.Routes[i].ChannelID == channelID {
+			return &chain.Routes[i]
+		}
+	}
+	return nil
+}
+
 // buildAllowedTokensForRoute computes allowed tokens for a route.
 //
 // The logic is:
@@ -208,13 +283,15 @@ func (rb *RouteBuilder) buildAllowedTokensForRoute(
 
 		// Compute IBC denom on destination chain
 		// When we SEND, the destination receives: ibc/hash(transfer/THEIR_CHANNEL/denom)
-		ibcDenom := rb.computeIBCDenom(channelInfo.PortID, channelInfo.CounterpartyChannelID, token.Denom)
+		destPath := forwardTracePath("", channelInfo)
+		ibcDenom := denomFromTracePath(destPath, token.Denom)
 
 		log.Printf("\tAdding native token %s -> %s", token.Denom, ibcDenom)
 
 		tokens = append(tokens, RouteTokenInfo{
 			SourceDenom:      token.Denom,
 			DestinationDenom: ibcDenom,
+			DestinationPath:  destPath,
 			BaseDenom:        token.Denom,
 			OriginChain:      fromChainID,
 			Symbol:           token.Symbol,
@@ -237,7 +314,8 @@ func (rb *RouteBuilder) buildAllowedTokensForRoute(
 
 		// Compute IBC denom of this token ON OUR CHAIN
 		// When we RECEIVE from toChain: ibc/hash(transfer/OUR_CHANNEL/denom)
-		ibcDenomOnOurChain := rb.computeIBCDenom(channelInfo.PortID, channelInfo.ChannelID, token.Denom)
+		sourcePath := channelInfo.PortID + "/" + channelInfo.ChannelID
+		ibcDenomOnOurChain := denomFromTracePath(sourcePath, token.Denom)
 
 		if seen[ibcDenomOnOurChain] {
 			continue
@@ -248,6 +326,7 @@ func (rb *RouteBuilder) buildAllowedTokensForRoute(
 
 		tokens = append(tokens, RouteTokenInfo{
 			SourceDenom:      ibcDenomOnOurChain,
+			SourcePath:       sourcePath,
 			DestinationDenom: token.Denom, // Unwound to native
 			BaseDenom:        token.Denom,
 			OriginChain:      toChainID,
@@ -270,15 +349,25 @@ func (rb *RouteBuilder) buildAllowedTokensForRoute(
 		}
 
 		// The token is already an IBC denom on our chain
-		// When we forward it, compute what it becomes on destination
-		destDenom := rb.computeForwardedTokenDenom(token, channelInfo)
+		// When we forward it, compute what it becomes on destination from its trace path if it is known
+		sourcePath, known := rb.tracePaths[fromChainID][token.Denom]
+		destPath := ""
+		destDenom := ""
+		if known {
+			destPath = forwardTracePath(sourcePath, channelInfo)
+			destDenom = denomFromTracePath(destPath, token.OriginDenom)
+		} else {
+			destDenom = rb.computeForwardedTokenDenom(token, channelInfo)
+		}
 
 		log.Printf("\tAdding routable token %s (origin: %s) -> %s",
 			token.Denom, token.OriginChain, destDenom)
 
 		tokens = append(tokens, RouteTokenInfo{
 			SourceDenom:      token.Denom,
+			SourcePath:       sourcePath,
 			DestinationDenom: destDenom,
+			DestinationPath:  destPath,
 			BaseDenom:        token.OriginDenom,
 			OriginChain:      token.OriginChain,
 			Symbol:           token.Symbol,
@@ -290,7 +379,7 @@ func (rb *RouteBuilder) buildAllowedTokensForRoute(
 	return tokens
 }
 
-// computeForwardedTokenDenom computes what an IBC token becomes when forwarded
+// computeForwardedTokenDenom guesses what an IBC token with an unknown trace path becomes when forwarded
 func (rb *RouteBuilder) computeForwardedTokenDenom(token *input.TokenMeta, channelInfo *ChannelInfo) string {
 	// If we're sending to the token's origin chain, it might unwind
 	if channelInfo.ToChainID == token.OriginChain {
@@ -380,6 +469,14 @@ func (rb *RouteBuilder) BuildIBCTokensForChain(chainID string) []IBCTokenConfig
 		}
 		seen[token.Denom] = true
 
+		ibcPath := fmt.Sprintf("multi-hop from %s", token.OriginChain)
+		sourceChannel := ""
+		if path, ok := rb.tracePaths[chainID][token.Denom]; ok {
+			ibcPath = path
+			// The first hop of the path is the channel the token arrived on
+			sourceChannel = query.ParseDenomTrace(path, token.OriginDenom).FirstHop
+		}
+
 		tokens = append(tokens, IBCTokenConfig{
 			IBCDenom:      token.Denom,
 			BaseDenom:     token.OriginDenom,
@@ -388,8 +485,8 @@ func (rb *RouteBuilder) BuildIBCTokensForChain(chainID string) []IBCTokenConfig
 			Decimals:      token.Exponent,
 			Icon:          token.Icon,
 			OriginChain:   token.OriginChain,
-			IBCPath:       fmt.Sprintf("multi-hop from %s", token.OriginChain),
-			SourceChannel: "", // Multi-hop
+			IBCPath:       ibcPath,
+			SourceChannel: sourceChannel,
 		})
 	}
 
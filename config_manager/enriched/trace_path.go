@@ -0,0 +1,102 @@
+package enriched
+
+import (
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+)
+
+// maxTraceHops is the longest path searched for the trace of a routable token
+const maxTraceHops = 4
+
+// resolveTracePaths derives the trace path of every routable token from the channels between its origin
+// chain and the chain it is defined on. The shortest path over the preferred channels whose denom hash is the
+// configured denom is used, tokens without such a path keep an unknown path.
+func (rb *RouteBuilder) resolveTracePaths() {
+	for chainID, tokens := range rb.routableTokens {
+		rb.tracePaths[chainID] = make(map[string]string)
+		for _, token := range tokens {
+			path, ok := rb.findTracePath(token.OriginChain, chainID, token.OriginDenom, token.Denom)
+			if !ok {
+				log.Printf("Warning: no path of at most %d hops from %s gives the denom %s of routable token %s on %s",
+					maxTraceHops, token.OriginChain, token.Denom, token.Symbol, chainID)
+				continue
+			}
+			rb.tracePaths[chainID][token.Denom] = path
+		}
+	}
+}
+
+// findTracePath searches the channels breadth first for the path baseDenom takes from originChainID to
+// chainID to arrive as denom. The path is the ICS-20 trace path on chainID, e.g. "transfer/channel-2/transfer/channel-75".
+func (rb *RouteBuilder) findTracePath(originChainID, chainID, baseDenom, denom string) (string, bool) {
+	type hop struct {
+		chainID string
+		path    string
+		visited []string
+	}
+
+	queue := []hop{{chainID: originChainID, visited: []string{originChainID}}}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if len(current.visited) > maxTraceHops {
+			continue
+		}
+
+		// Sorted so the same path is picked on every run
+		nextChainIDs := make([]string, 0, len(rb.channelMap[current.chainID]))
+		for nextChainID := range rb.channelMap[current.chainID] {
+			nextChainIDs = append(nextChainIDs, nextChainID)
+		}
+		slices.Sort(nextChainIDs)
+
+		for _, nextChainID := range nextChainIDs {
+			if slices.Contains(current.visited, nextChainID) {
+				continue
+			}
+			path := forwardTracePath(current.path, rb.channelMap[current.chainID][nextChainID])
+			if nextChainID == chainID {
+				if denomFromTracePath(path, baseDenom) == denom {
+					return path, true
+				}
+				continue
+			}
+			queue = append(queue, hop{
+				chainID: nextChainID,
+				path:    path,
+				visited: append(slices.Clone(current.visited), nextChainID),
+			})
+		}
+	}
+	return "", false
+}
+
+// forwardTracePath returns the trace path a token with the given path gets when it is sent over channel.
+// A token sent back over the channel it arrived on unwinds a hop, otherwise the destination prepends its channel.
+func forwardTracePath(path string, channel *ChannelInfo) string {
+	arrivedOver := channel.PortID + "/" + channel.ChannelID
+	if path == arrivedOver {
+		return ""
+	}
+	if rest, ok := strings.CutPrefix(path, arrivedOver+"/"); ok {
+		return rest
+	}
+
+	// The counterparty port is the transfer port like on our side
+	receivedOver := channel.PortID + "/" + channel.CounterpartyChannelID
+	if path == "" {
+		return receivedOver
+	}
+	return receivedOver + "/" + path
+}
+
+// denomFromTracePath returns the denom of baseDenom with the given trace path, the base denom itself if the path is empty
+func denomFromTracePath(path, baseDenom string) string {
+	if path == "" {
+		return baseDenom
+	}
+	return query.ComputeDenomHash(path + "/" + baseDenom)
+}
@@ -30,6 +30,9 @@ type ChainConfig struct {
 	// Middleware support detected from the REST endpoint
 	HasPFM bool `json:"has_pfm"`
 
+	// Fee kept by PFM from forwarded transfers as a fraction (from input config), empty if unknown
+	PFMFeePercentage string `json:"pfm_fee_percentage,omitempty"`
+
 	CosmosSdkVersion string `json:"cosmos_sdk_version"`
 
 	// Verified endpoints (after health checks)
@@ -45,6 +48,9 @@ type ChainConfig struct {
 	// IBC routes to other chains
 	Routes []RouteConfig `json:"routes"`
 
+	// Result of cross-checking the routable IBC tokens against the on-chain denom traces, nil if not checked
+	DenomReport *DenomReport `json:"denom_report,omitempty"`
+
 	// Keplr chain config used for client only
 	KeplrChainConfig keplr.KeplrChainConfig `json:"keplr_chain_config,omitempty"`
 }
@@ -144,6 +150,36 @@ type RouteConfig struct {
 
 	// Tokens that can be sent on this route
 	AllowedTokens []RouteTokenInfo `json:"allowed_tokens"`
+
+	// Live state of the channel queried from the chain, nil if it could not be queried
+	LiveStatus *ChannelLiveStatus `json:"live_status,omitempty"`
+}
+
+// IsUsable reports whether transfers can be sent on the route.
+// Routes whose channel could not be queried are kept with their registry status.
+func (r RouteConfig) IsUsable() bool {
+	return r.LiveStatus == nil || r.LiveStatus.Open
+}
+
+// ChannelLiveStatus is the on-chain state of a route's channel, its connection and light client.
+type ChannelLiveStatus struct {
+	// Channel state (e.g., "STATE_OPEN", "STATE_CLOSED")
+	ChannelState string `json:"channel_state"`
+
+	// Connection state (e.g., "STATE_OPEN")
+	ConnectionState string `json:"connection_state"`
+
+	// Light client of the connection
+	ClientID string `json:"client_id"`
+
+	// Light client status ("Active", "Expired", "Frozen" or "Unknown")
+	ClientStatus string `json:"client_status"`
+
+	// True if the channel and connection are open and the client is active on both ends
+	Open bool `json:"open"`
+
+	// Status of the counterparty end when it is what makes the route unusable
+	Counterparty *ChannelLiveStatus `json:"counterparty,omitempty"`
 }
 
 // RouteTokenInfo contains token information specific to a route.
@@ -151,9 +187,17 @@ type RouteTokenInfo struct {
 	// Denom on the source chain (current chain)
 	SourceDenom string `json:"source_denom"`
 
+	// ICS-20 trace path of the source denom (e.g., "transfer/channel-0"), empty for native tokens
+	// and routable tokens whose path could not be derived
+	SourcePath string `json:"source_path,omitempty"`
+
 	// What the denom becomes on the destination chain
 	DestinationDenom string `json:"destination_denom"`
 
+	// ICS-20 trace path of the destination denom (e.g., "transfer/channel-2/transfer/channel-75"),
+	// empty if the token arrives as its native denom or its path could not be derived
+	DestinationPath string `json:"destination_path,omitempty"`
+
 	// Original base denom (for IBC tokens)
 	BaseDenom string `json:"base_denom"`
 
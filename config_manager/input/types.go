@@ -57,6 +57,11 @@ type ChainMeta struct {
 	// Required if IsBroker is true and you want to generate executable swap memos
 	IBCHooksContract string `toml:"ibc_hooks_contract,omitempty"`
 
+	// Optional: Fee the packet forward middleware keeps from the transfers it forwards, as a fraction
+	// the same as the fee_percentage param of the PFM module (e.g., "0.001" for 0.1%)
+	// Set it to "0" if the chain doesn't charge one, if it is left out the fee is unknown
+	PFMFeePercentage string `toml:"pfm_fee_percentage,omitempty"`
+
 	// RPC and REST endpoints
 	RPCs []APIEndpoint `toml:"rpcs"`
 	Rest []APIEndpoint `toml:"rest"`
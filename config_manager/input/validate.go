@@ -8,6 +8,7 @@ import (
 	"net/http"
 	"reflect"
 	"slices"
+	"strconv"
 	"strings"
 	"time"
 )
@@ -173,6 +174,13 @@ func (v *Validator) validateTypes(config *ChainInput, result *ValidationResult)
 		result.Errors = append(result.Errors, &ValidationError{"chain.slip44", "must be non-negative"})
 	}
 
+	if chain.PFMFeePercentage != "" {
+		fee, err := strconv.ParseFloat(chain.PFMFeePercentage, 64)
+		if err != nil || fee < 0 || fee >= 1 {
+			result.Errors = append(result.Errors, &ValidationError{"chain.pfm_fee_percentage", "must be a fraction between 0 and 1"})
+		}
+	}
+
 	for i, token := range config.Tokens {
 		if token.Exponent < 0 || token.Exponent > 18 {
 			result.Errors = append(result.Errors, &ValidationError{
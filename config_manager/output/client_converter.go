@@ -267,7 +267,7 @@ func (c *ClientConverter) buildConnectedChains(
 
 	for _, route := range chain.Routes {
 		destChain, exists := reg.Chains[route.ToChainID]
-		if !exists {
+		if !exists || !route.IsUsable() {
 			continue
 		}
 
@@ -0,0 +1,33 @@
+package output
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// LoadClientConfig loads a client configuration from a file.
+// Supports both TOML and JSON formats based on file extension.
+func LoadClientConfig(filePath string) (*ClientConfig, error) {
+	data, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read client config: %w", err)
+	}
+
+	var config ClientConfig
+
+	if strings.HasSuffix(filePath, ".json") {
+		if err := json.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse JSON client config: %w", err)
+		}
+	} else {
+		if err := toml.Unmarshal(data, &config); err != nil {
+			return nil, fmt.Errorf("failed to parse TOML client config: %w", err)
+		}
+	}
+
+	return &config, nil
+}
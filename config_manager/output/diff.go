@@ -0,0 +1,376 @@
+package output
+
+import (
+	"fmt"
+	"io"
+	"maps"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ChangeKind is the kind of a difference between two generated configs.
+type ChangeKind string
+
+const (
+	ChangeAdded    ChangeKind = "added"
+	ChangeRemoved  ChangeKind = "removed"
+	ChangeModified ChangeKind = "changed"
+)
+
+// Change is a single difference between a previously generated config and a new one.
+type Change struct {
+	Kind ChangeKind `json:"kind"`
+
+	// What changed: "chain", "route", "channel", "token", "denom" or "endpoint"
+	Object string `json:"object"`
+
+	// Chain ID the object belongs to
+	Chain string `json:"chain"`
+
+	// Destination chain ID for objects of a route
+	ToChain string `json:"to_chain,omitempty"`
+
+	// Denom of the token the change is about
+	Token string `json:"token,omitempty"`
+
+	// Changed field, e.g. "ibc_denom" or "rpc"
+	Field string `json:"field,omitempty"`
+
+	// Previous and new value, only one of them is set for added and removed objects
+	Old string `json:"old,omitempty"`
+	New string `json:"new,omitempty"`
+}
+
+func (c Change) String() string {
+	var b strings.Builder
+	switch c.Kind {
+	case ChangeAdded:
+		b.WriteString("+ ")
+	case ChangeRemoved:
+		b.WriteString("- ")
+	default:
+		b.WriteString("~ ")
+	}
+	b.WriteString(c.Object + " " + c.Chain)
+	if c.ToChain != "" {
+		b.WriteString(" -> " + c.ToChain)
+	}
+	if c.Token != "" {
+		b.WriteString(" " + c.Token)
+	}
+	if c.Field != "" {
+		b.WriteString(" " + c.Field)
+	}
+
+	switch {
+	case c.Kind == ChangeModified:
+		fmt.Fprintf(&b, ": %s -> %s", valueOrNone(c.Old), valueOrNone(c.New))
+	case c.New != "":
+		b.WriteString(": " + c.New)
+	case c.Old != "":
+		b.WriteString(": " + c.Old)
+	}
+	return b.String()
+}
+
+func valueOrNone(value string) string {
+	if value == "" {
+		return "(none)"
+	}
+	return value
+}
+
+// ConfigDiff is the report of the changes between the previously generated configs and the new ones.
+type ConfigDiff struct {
+	Pathfinder []Change `json:"pathfinder"`
+	Client     []Change `json:"client"`
+}
+
+// HasChanges reports whether any of the configs changed
+func (d *ConfigDiff) HasChanges() bool {
+	return len(d.Pathfinder) > 0 || len(d.Client) > 0
+}
+
+// WriteText writes the report as a human-readable list of changes per config
+func (d *ConfigDiff) WriteText(w io.Writer) error {
+	sections := []struct {
+		name    string
+		changes []Change
+	}{
+		{"Pathfinder config", d.Pathfinder},
+		{"Client config", d.Client},
+	}
+	for _, section := range sections {
+		if len(section.changes) == 0 {
+			if _, err := fmt.Fprintf(w, "%s: no changes\n", section.name); err != nil {
+				return err
+			}
+			continue
+		}
+		if _, err := fmt.Fprintf(w, "%s: %d changes\n", section.name, len(section.changes)); err != nil {
+			return err
+		}
+		for _, change := range section.changes {
+			if _, err := fmt.Fprintf(w, "  %s\n", change); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// changeList collects the changes found while comparing two configs
+type changeList []Change
+
+// compare adds a change of the field to the list if the values differ, base identifies the changed object
+func (l *changeList) compare(base Change, field, oldValue, newValue string) {
+	if oldValue == newValue {
+		return
+	}
+	base.Kind = ChangeModified
+	base.Field = field
+	base.Old = oldValue
+	base.New = newValue
+	*l = append(*l, base)
+}
+
+// diffKeyed compares two maps in the order of their keys, calling added and removed for the keys only one of them
+// has and both for the keys in both
+func diffKeyed[V any](oldMap, newMap map[string]V, added, removed func(V), both func(oldValue, newValue V)) {
+	keys := slices.Collect(maps.Keys(oldMap))
+	for key := range newMap {
+		if _, ok := oldMap[key]; !ok {
+			keys = append(keys, key)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, key := range keys {
+		oldValue, inOld := oldMap[key]
+		newValue, inNew := newMap[key]
+		switch {
+		case !inOld:
+			added(newValue)
+		case !inNew:
+			removed(oldValue)
+		default:
+			both(oldValue, newValue)
+		}
+	}
+}
+
+// keyBy indexes a slice by the given key
+func keyBy[V any](values []V, key func(V) string) map[string]V {
+	indexed := make(map[string]V, len(values))
+	for _, value := range values {
+		indexed[key(value)] = value
+	}
+	return indexed
+}
+
+// DiffPathfinderConfigs returns the changes of the chains, routes, channels and tokens from the previous
+// pathfinder config to the new one. A nil previous config is compared as an empty one.
+func DiffPathfinderConfigs(previous, current *PathfinderConfig) []Change {
+	if previous == nil {
+		previous = &PathfinderConfig{}
+	}
+	if current == nil {
+		current = &PathfinderConfig{}
+	}
+
+	changes := changeList{}
+	chainID := func(chain PathfinderChain) string { return chain.ID }
+	diffKeyed(keyBy(previous.Chains, chainID), keyBy(current.Chains, chainID),
+		func(chain PathfinderChain) {
+			changes = append(changes, Change{Kind: ChangeAdded, Object: "chain", Chain: chain.ID, New: chain.Name})
+		},
+		func(chain PathfinderChain) {
+			changes = append(changes, Change{Kind: ChangeRemoved, Object: "chain", Chain: chain.ID, Old: chain.Name})
+		},
+		func(oldChain, newChain PathfinderChain) {
+			changes.diffPathfinderChain(oldChain, newChain)
+		},
+	)
+	return changes
+}
+
+func (l *changeList) diffPathfinderChain(oldChain, newChain PathfinderChain) {
+	chain := Change{Object: "chain", Chain: newChain.ID}
+	l.compare(chain, "name", oldChain.Name, newChain.Name)
+	l.compare(chain, "has_pfm", strconv.FormatBool(oldChain.HasPFM), strconv.FormatBool(newChain.HasPFM))
+	l.compare(chain, "pfm_fee_percentage", oldChain.PFMFeePercentage, newChain.PFMFeePercentage)
+	l.compare(chain, "broker", strconv.FormatBool(oldChain.Broker), strconv.FormatBool(newChain.Broker))
+	l.compare(chain, "broker_id", oldChain.BrokerID, newChain.BrokerID)
+	l.compare(chain, "ibc_hooks_contract", oldChain.IBCHooksContract, newChain.IBCHooksContract)
+	l.compare(chain, "bech32_prefix", oldChain.Bech32Prefix, newChain.Bech32Prefix)
+
+	chainDenom := func(token PathfinderTokenInfo) string { return token.ChainDenom }
+	diffKeyed(keyBy(oldChain.NativeTokens, chainDenom), keyBy(newChain.NativeTokens, chainDenom),
+		func(token PathfinderTokenInfo) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "token", Chain: newChain.ID, Token: token.ChainDenom, New: token.Symbol})
+		},
+		func(token PathfinderTokenInfo) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "token", Chain: newChain.ID, Token: token.ChainDenom, Old: token.Symbol})
+		},
+		func(oldToken, newToken PathfinderTokenInfo) {
+			l.diffPathfinderToken(Change{Chain: newChain.ID, Token: newToken.ChainDenom}, oldToken, newToken)
+		},
+	)
+
+	toChainID := func(route PathfinderRoute) string { return route.ToChainID }
+	diffKeyed(keyBy(oldChain.Routes, toChainID), keyBy(newChain.Routes, toChainID),
+		func(route PathfinderRoute) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "route", Chain: newChain.ID, ToChain: route.ToChainID,
+				New: route.PortID + "/" + route.ChannelID})
+		},
+		func(route PathfinderRoute) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "route", Chain: newChain.ID, ToChain: route.ToChainID,
+				Old: route.PortID + "/" + route.ChannelID})
+		},
+		func(oldRoute, newRoute PathfinderRoute) {
+			l.diffPathfinderRoute(newChain.ID, oldRoute, newRoute)
+		},
+	)
+}
+
+func (l *changeList) diffPathfinderRoute(chainID string, oldRoute, newRoute PathfinderRoute) {
+	channel := Change{Object: "channel", Chain: chainID, ToChain: newRoute.ToChainID}
+	l.compare(channel, "channel_id", oldRoute.ChannelID, newRoute.ChannelID)
+	l.compare(channel, "connection_id", oldRoute.ConnectionID, newRoute.ConnectionID)
+	l.compare(channel, "port_id", oldRoute.PortID, newRoute.PortID)
+
+	diffKeyed(oldRoute.AllowedTokens, newRoute.AllowedTokens,
+		func(token PathfinderTokenInfo) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "token", Chain: chainID, ToChain: newRoute.ToChainID,
+				Token: token.ChainDenom, New: token.Symbol})
+		},
+		func(token PathfinderTokenInfo) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "token", Chain: chainID, ToChain: newRoute.ToChainID,
+				Token: token.ChainDenom, Old: token.Symbol})
+		},
+		func(oldToken, newToken PathfinderTokenInfo) {
+			l.diffPathfinderToken(Change{Chain: chainID, ToChain: newRoute.ToChainID, Token: newToken.ChainDenom}, oldToken, newToken)
+		},
+	)
+}
+
+// diffPathfinderToken compares the denoms and the details of a token with the same chain denom
+func (l *changeList) diffPathfinderToken(base Change, oldToken, newToken PathfinderTokenInfo) {
+	denom := base
+	denom.Object = "denom"
+	l.compare(denom, "chain_path", oldToken.ChainPath, newToken.ChainPath)
+	l.compare(denom, "ibc_denom", oldToken.IBCDenom, newToken.IBCDenom)
+	l.compare(denom, "ibc_path", oldToken.IBCPath, newToken.IBCPath)
+	l.compare(denom, "base_denom", oldToken.BaseDenom, newToken.BaseDenom)
+	l.compare(denom, "origin_chain", oldToken.OriginChain, newToken.OriginChain)
+
+	token := base
+	token.Object = "token"
+	l.compare(token, "symbol", oldToken.Symbol, newToken.Symbol)
+	l.compare(token, "decimals", strconv.Itoa(oldToken.Decimals), strconv.Itoa(newToken.Decimals))
+	l.compare(token, "coingecko_id", oldToken.CoinGeckoID, newToken.CoinGeckoID)
+}
+
+// DiffClientConfigs returns the changes of the chains, endpoints, tokens and connected chains from the previous
+// client config to the new one. A nil previous config is compared as an empty one.
+func DiffClientConfigs(previous, current *ClientConfig) []Change {
+	if previous == nil {
+		previous = &ClientConfig{}
+	}
+	if current == nil {
+		current = &ClientConfig{}
+	}
+
+	changes := changeList{}
+	chainID := func(chain ClientChain) string { return chain.ID }
+	diffKeyed(keyBy(previous.Chains, chainID), keyBy(current.Chains, chainID),
+		func(chain ClientChain) {
+			changes = append(changes, Change{Kind: ChangeAdded, Object: "chain", Chain: chain.ID, New: chain.Name})
+		},
+		func(chain ClientChain) {
+			changes = append(changes, Change{Kind: ChangeRemoved, Object: "chain", Chain: chain.ID, Old: chain.Name})
+		},
+		func(oldChain, newChain ClientChain) {
+			changes.diffClientChain(oldChain, newChain)
+		},
+	)
+	return changes
+}
+
+func (l *changeList) diffClientChain(oldChain, newChain ClientChain) {
+	chain := Change{Object: "chain", Chain: newChain.ID}
+	l.compare(chain, "name", oldChain.Name, newChain.Name)
+	l.compare(chain, "bech32_prefix", oldChain.Bech32Prefix, newChain.Bech32Prefix)
+	l.compare(chain, "slip44", strconv.Itoa(oldChain.Slip44), strconv.Itoa(newChain.Slip44))
+	l.compare(chain, "cosmos_sdk_version", oldChain.CosmosSdkVersion, newChain.CosmosSdkVersion)
+	l.compare(chain, "is_dex", strconv.FormatBool(oldChain.IsDEX), strconv.FormatBool(newChain.IsDEX))
+	l.compare(chain, "chain_logo", oldChain.ChainLogo, newChain.ChainLogo)
+
+	explorer := Change{Object: "endpoint", Chain: newChain.ID}
+	l.compare(explorer, "explorer_base_url", oldChain.ExplorerDetails.BaseUrl, newChain.ExplorerDetails.BaseUrl)
+	l.compare(explorer, "explorer_account_path", oldChain.ExplorerDetails.AccountPath, newChain.ExplorerDetails.AccountPath)
+	l.compare(explorer, "explorer_transaction_path", oldChain.ExplorerDetails.TransactionPath, newChain.ExplorerDetails.TransactionPath)
+	l.diffEndpoints(newChain.ID, "rpc", oldChain.RPCEndpoints, newChain.RPCEndpoints)
+	l.diffEndpoints(newChain.ID, "rest", oldChain.RESTEndpoints, newChain.RESTEndpoints)
+
+	denom := func(token ClientToken) string { return token.Denom }
+	oldTokens := keyBy(slices.Concat(oldChain.NativeTokens, oldChain.IBCTokens), denom)
+	newTokens := keyBy(slices.Concat(newChain.NativeTokens, newChain.IBCTokens), denom)
+	diffKeyed(oldTokens, newTokens,
+		func(token ClientToken) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "token", Chain: newChain.ID, Token: token.Denom, New: token.Symbol})
+		},
+		func(token ClientToken) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "token", Chain: newChain.ID, Token: token.Denom, Old: token.Symbol})
+		},
+		func(oldToken, newToken ClientToken) {
+			token := Change{Object: "token", Chain: newChain.ID, Token: newToken.Denom}
+			l.compare(token, "symbol", oldToken.Symbol, newToken.Symbol)
+			l.compare(token, "name", oldToken.Name, newToken.Name)
+			l.compare(token, "decimals", strconv.Itoa(oldToken.Decimals), strconv.Itoa(newToken.Decimals))
+			l.compare(token, "icon", oldToken.Icon, newToken.Icon)
+			l.compare(token, "coingecko_id", oldToken.CoinGeckoID, newToken.CoinGeckoID)
+
+			token.Object = "denom"
+			l.compare(token, "origin_chain", oldToken.OriginChain, newToken.OriginChain)
+			l.compare(token, "base_denom", oldToken.BaseDenom, newToken.BaseDenom)
+		},
+	)
+
+	connectedID := func(connected ConnectedChainInfo) string { return connected.ID }
+	diffKeyed(keyBy(oldChain.ConnectedChains, connectedID), keyBy(newChain.ConnectedChains, connectedID),
+		func(connected ConnectedChainInfo) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "route", Chain: newChain.ID, ToChain: connected.ID})
+		},
+		func(connected ConnectedChainInfo) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "route", Chain: newChain.ID, ToChain: connected.ID})
+		},
+		func(oldConnected, newConnected ConnectedChainInfo) {
+			sendable := func(denom string) string { return denom }
+			diffKeyed(keyBy(oldConnected.SendableTokens, sendable), keyBy(newConnected.SendableTokens, sendable),
+				func(denom string) {
+					*l = append(*l, Change{Kind: ChangeAdded, Object: "token", Chain: newChain.ID, ToChain: newConnected.ID, Token: denom})
+				},
+				func(denom string) {
+					*l = append(*l, Change{Kind: ChangeRemoved, Object: "token", Chain: newChain.ID, ToChain: newConnected.ID, Token: denom})
+				},
+				func(string, string) {},
+			)
+		},
+	)
+}
+
+// diffEndpoints reports the endpoint URLs of the given kind that were added or removed
+func (l *changeList) diffEndpoints(chainID, field string, oldEndpoints, newEndpoints []ClientEndpoint) {
+	url := func(endpoint ClientEndpoint) string { return endpoint.URL }
+	diffKeyed(keyBy(oldEndpoints, url), keyBy(newEndpoints, url),
+		func(endpoint ClientEndpoint) {
+			*l = append(*l, Change{Kind: ChangeAdded, Object: "endpoint", Chain: chainID, Field: field, New: endpoint.URL})
+		},
+		func(endpoint ClientEndpoint) {
+			*l = append(*l, Change{Kind: ChangeRemoved, Object: "endpoint", Chain: chainID, Field: field, Old: endpoint.URL})
+		},
+		func(ClientEndpoint, ClientEndpoint) {},
+	)
+}
@@ -0,0 +1,175 @@
+package output_test
+
+import (
+	"encoding/json"
+	"errors"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/output"
+)
+
+func atomRoute(channelID, ibcDenom string) output.PathfinderRoute {
+	return output.PathfinderRoute{
+		ToChain:      "Osmosis",
+		ToChainID:    "osmosis-1",
+		ConnectionID: "connection-257",
+		ChannelID:    channelID,
+		PortID:       "transfer",
+		AllowedTokens: map[string]output.PathfinderTokenInfo{
+			"uatom": {ChainDenom: "uatom", IBCDenom: ibcDenom, BaseDenom: "uatom", OriginChain: "cosmoshub-4", Symbol: "ATOM", Decimals: 6},
+		},
+	}
+}
+
+func TestDiffPathfinderConfigs(t *testing.T) {
+	previous := &output.PathfinderConfig{
+		Chains: []output.PathfinderChain{
+			{Name: "Cosmos Hub", ID: "cosmoshub-4", HasPFM: true, Routes: []output.PathfinderRoute{
+				atomRoute("channel-141", "ibc/27394FB0"),
+			}},
+			{Name: "Juno", ID: "juno-1"},
+		},
+	}
+	hubRoute := atomRoute("channel-142", "ibc/27394FB1")
+	hubRoute.AllowedTokens["ibc/toosmo"] = output.PathfinderTokenInfo{ChainDenom: "ibc/toosmo", Symbol: "TEST"}
+	current := &output.PathfinderConfig{
+		Chains: []output.PathfinderChain{
+			{Name: "Cosmos Hub", ID: "cosmoshub-4", HasPFM: true, Routes: []output.PathfinderRoute{hubRoute}},
+			{Name: "Osmosis", ID: "osmosis-1", Broker: true},
+		},
+	}
+
+	got := make([]string, 0)
+	for _, change := range output.DiffPathfinderConfigs(previous, current) {
+		got = append(got, change.String())
+	}
+	want := []string{
+		"~ channel cosmoshub-4 -> osmosis-1 channel_id: channel-141 -> channel-142",
+		"+ token cosmoshub-4 -> osmosis-1 ibc/toosmo: TEST",
+		"~ denom cosmoshub-4 -> osmosis-1 uatom ibc_denom: ibc/27394FB0 -> ibc/27394FB1",
+		"- chain juno-1: Juno",
+		"+ chain osmosis-1: Osmosis",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("changes = %q, want %q", got, want)
+	}
+
+	if changes := output.DiffPathfinderConfigs(current, current); len(changes) != 0 {
+		t.Errorf("expected no changes between equal configs, got %v", changes)
+	}
+}
+
+func TestDiffPathfinderConfigsWithoutPrevious(t *testing.T) {
+	current := &output.PathfinderConfig{Chains: []output.PathfinderChain{{Name: "Osmosis", ID: "osmosis-1"}}}
+
+	changes := output.DiffPathfinderConfigs(nil, current)
+	if len(changes) != 1 || changes[0].Kind != output.ChangeAdded || changes[0].Object != "chain" {
+		t.Errorf("expected the chain to be added, got %v", changes)
+	}
+}
+
+func TestDiffClientConfigs(t *testing.T) {
+	chain := func(rpcs []string, token output.ClientToken) output.ClientChain {
+		endpoints := make([]output.ClientEndpoint, 0, len(rpcs))
+		for _, url := range rpcs {
+			endpoints = append(endpoints, output.ClientEndpoint{URL: url})
+		}
+		return output.ClientChain{
+			Name:         "Osmosis",
+			ID:           "osmosis-1",
+			RPCEndpoints: endpoints,
+			IBCTokens:    []output.ClientToken{token},
+			ConnectedChains: []output.ConnectedChainInfo{
+				{ID: "cosmoshub-4", SendableTokens: []string{token.Denom}},
+			},
+		}
+	}
+	atom := output.ClientToken{Denom: "ibc/27394FB0", Symbol: "ATOM", Decimals: 6, OriginChain: "cosmoshub-4", BaseDenom: "uatom"}
+	movedAtom := atom
+	movedAtom.Denom = "ibc/27394FB1"
+
+	previous := &output.ClientConfig{Chains: []output.ClientChain{
+		chain([]string{"https://rpc.osmosis.zone", "https://osmosis-rpc.polkachu.com"}, atom),
+	}}
+	current := &output.ClientConfig{Chains: []output.ClientChain{
+		chain([]string{"https://rpc.osmosis.zone", "https://rpc.cogwheel.zone"}, movedAtom),
+	}}
+
+	got := make([]string, 0)
+	for _, change := range output.DiffClientConfigs(previous, current) {
+		got = append(got, change.String())
+	}
+	want := []string{
+		"- endpoint osmosis-1 rpc: https://osmosis-rpc.polkachu.com",
+		"+ endpoint osmosis-1 rpc: https://rpc.cogwheel.zone",
+		"- token osmosis-1 ibc/27394FB0: ATOM",
+		"+ token osmosis-1 ibc/27394FB1: ATOM",
+		"- token osmosis-1 -> cosmoshub-4 ibc/27394FB0",
+		"+ token osmosis-1 -> cosmoshub-4 ibc/27394FB1",
+	}
+	if !slices.Equal(got, want) {
+		t.Errorf("changes = %q, want %q", got, want)
+	}
+}
+
+func TestConfigDiffReport(t *testing.T) {
+	diff := &output.ConfigDiff{
+		Pathfinder: output.DiffPathfinderConfigs(nil, &output.PathfinderConfig{
+			Chains: []output.PathfinderChain{{Name: "Osmosis", ID: "osmosis-1"}},
+		}),
+		Client: output.DiffClientConfigs(nil, nil),
+	}
+	if !diff.HasChanges() {
+		t.Fatal("expected the diff to have changes")
+	}
+
+	var text strings.Builder
+	if err := diff.WriteText(&text); err != nil {
+		t.Fatalf("failed to write the text report: %v", err)
+	}
+	wantText := "Pathfinder config: 1 changes\n  + chain osmosis-1: Osmosis\nClient config: no changes\n"
+	if text.String() != wantText {
+		t.Errorf("text report = %q, want %q", text.String(), wantText)
+	}
+
+	data, err := json.Marshal(diff)
+	if err != nil {
+		t.Fatalf("failed to marshal the report: %v", err)
+	}
+	wantJSON := `{"pathfinder":[{"kind":"added","object":"chain","chain":"osmosis-1","new":"Osmosis"}],"client":[]}`
+	if string(data) != wantJSON {
+		t.Errorf("JSON report = %s, want %s", data, wantJSON)
+	}
+}
+
+func TestLoadClientConfig(t *testing.T) {
+	config := output.ClientConfig{
+		Version: "1.0",
+		Chains:  []output.ClientChain{{Name: "Osmosis", ID: "osmosis-1", Bech32Prefix: "osmo"}},
+	}
+	data, err := json.Marshal(config)
+	if err != nil {
+		t.Fatalf("failed to marshal client config: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "client_config.json")
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatalf("failed to write client config: %v", err)
+	}
+
+	loaded, err := output.LoadClientConfig(path)
+	if err != nil {
+		t.Fatalf("failed to load client config: %v", err)
+	}
+	if len(loaded.Chains) != 1 || loaded.Chains[0].Bech32Prefix != "osmo" {
+		t.Errorf("unexpected client config: %+v", loaded)
+	}
+
+	if _, err := output.LoadClientConfig(filepath.Join(t.TempDir(), "missing.json")); !errors.Is(err, fs.ErrNotExist) {
+		t.Errorf("expected a not exist error for a missing file, got %v", err)
+	}
+}
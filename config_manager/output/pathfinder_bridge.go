@@ -63,11 +63,14 @@ type RouterRoute struct {
 
 type RouterTokenInfo struct {
 	ChainDenom  string
+	ChainPath   string
 	IBCDenom    string
+	IBCPath     string
 	BaseDenom   string
 	OriginChain string
 	Symbol      string
 	Decimals    int
+	CoinGeckoID string
 }
 
 // ToRouterTypes converts the pathfinder config to router-compatible types.
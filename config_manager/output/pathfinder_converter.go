@@ -2,11 +2,13 @@ package output
 
 import (
 	"fmt"
+	"log"
 	"slices"
 	"strings"
 	"time"
 
 	"github.com/Cogwheel-Validator/spectra-portal/config_manager/enriched"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/keplr"
 )
 
 // PathfinderConverter converts enriched configs to pathfinder-compatible format.
@@ -46,10 +48,12 @@ func (c *PathfinderConverter) convertChain(chain *enriched.ChainConfig) Pathfind
 		Name:             chain.Name,
 		ID:               chain.ID,
 		HasPFM:           chain.HasPFM,
+		PFMFeePercentage: chain.PFMFeePercentage,
 		Broker:           chain.IsBroker,
 		BrokerID:         chain.BrokerID,
 		IBCHooksContract: chain.IBCHooksContract,
 		Bech32Prefix:     chain.Bech32Prefix,
+		FeeCurrencies:    convertFeeCurrencies(chain.KeplrChainConfig.FeeCurrencies),
 		NativeTokens:     make([]PathfinderTokenInfo, 0, len(chain.NativeTokens)),
 		Routes:           make([]PathfinderRoute, 0, len(chain.Routes)),
 	}
@@ -67,6 +71,7 @@ func (c *PathfinderConverter) convertChain(chain *enriched.ChainConfig) Pathfind
 			OriginChain: chain.ID,
 			Symbol:      token.Symbol,
 			Decimals:    token.Decimals,
+			CoinGeckoID: token.CoinGeckoID,
 		})
 	}
 
@@ -75,6 +80,12 @@ func (c *PathfinderConverter) convertChain(chain *enriched.ChainConfig) Pathfind
 	})
 
 	for _, route := range chain.Routes {
+		// Channels that are closed on chain or whose client expired can't carry transfers
+		if !route.IsUsable() {
+			log.Printf("Excluding route %s -> %s over channel %s, the channel is not open on chain",
+				chain.ID, route.ToChainID, route.ChannelID)
+			continue
+		}
 		pathfinderRoute := c.convertRoute(route)
 		pathfinderChain.Routes = append(pathfinderChain.Routes, pathfinderRoute)
 	}
@@ -82,6 +93,32 @@ func (c *PathfinderConverter) convertChain(chain *enriched.ChainConfig) Pathfind
 	return pathfinderChain
 }
 
+// Gas prices Keplr uses for fee currencies that don't define a gas price step
+var defaultGasPriceStep = PathfinderGasPriceStep{Low: 0.01, Average: 0.025, High: 0.04}
+
+// convertFeeCurrencies keeps the Keplr fee currencies in their order of preference
+func convertFeeCurrencies(feeCurrencies []keplr.FeeCurrency) []PathfinderFeeCurrency {
+	converted := make([]PathfinderFeeCurrency, 0, len(feeCurrencies))
+	for _, feeCurrency := range feeCurrencies {
+		if feeCurrency.CoinMinimalDenom == "" {
+			continue
+		}
+
+		gasPriceStep := PathfinderGasPriceStep(feeCurrency.GasPriceStep)
+		if gasPriceStep == (PathfinderGasPriceStep{}) {
+			gasPriceStep = defaultGasPriceStep
+		}
+
+		converted = append(converted, PathfinderFeeCurrency{
+			Denom:        feeCurrency.CoinMinimalDenom,
+			Symbol:       feeCurrency.CoinDenom,
+			Decimals:     feeCurrency.CoinDecimals,
+			GasPriceStep: gasPriceStep,
+		})
+	}
+	return converted
+}
+
 func (c *PathfinderConverter) convertRoute(route enriched.RouteConfig) PathfinderRoute {
 	pathfinderRoute := PathfinderRoute{
 		ToChain:       route.ToChainName,
@@ -103,7 +140,9 @@ func (c *PathfinderConverter) convertRoute(route enriched.RouteConfig) Pathfinde
 	for _, token := range route.AllowedTokens {
 		pathfinderRoute.AllowedTokens[token.SourceDenom] = PathfinderTokenInfo{
 			ChainDenom:  token.SourceDenom,
+			ChainPath:   token.SourcePath,
 			IBCDenom:    token.DestinationDenom,
+			IBCPath:     token.DestinationPath,
 			BaseDenom:   token.BaseDenom,
 			OriginChain: token.OriginChain,
 			Symbol:      token.Symbol,
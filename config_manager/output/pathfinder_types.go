@@ -27,6 +27,9 @@ type PathfinderChain struct {
 	// Whether this chain supports Packet Forward Middleware
 	HasPFM bool `json:"has_pfm" toml:"has_pfm"`
 
+	// Fee PFM keeps from forwarded transfers as a fraction (e.g., "0.001"), empty if unknown
+	PFMFeePercentage string `json:"pfm_fee_percentage,omitempty" toml:"pfm_fee_percentage,omitempty"`
+
 	// Whether this chain is a DEX broker (for swap routing)
 	Broker bool `json:"broker" toml:"broker"`
 
@@ -40,6 +43,10 @@ type PathfinderChain struct {
 	// Bech32 prefix for addresses on this chain (e.g., "osmo", "cosmos")
 	Bech32Prefix string `json:"bech32_prefix,omitempty" toml:"bech32_prefix,omitempty"`
 
+	// Tokens transaction fees can be paid with on this chain, in order of preference.
+	// The first one is used to estimate the fees of the transactions signed on this chain.
+	FeeCurrencies []PathfinderFeeCurrency `json:"fee_currencies,omitempty" toml:"fee_currencies,omitempty"`
+
 	// Native tokens on this chain (includes tokens with no allowed destinations)
 	// These are always available on their native chain even if they can't be sent via IBC.
 	//
@@ -51,6 +58,29 @@ type PathfinderChain struct {
 	Routes []PathfinderRoute `json:"routes" toml:"routes"`
 }
 
+// PathfinderFeeCurrency is a token transaction fees can be paid with.
+// This maps directly to the router.FeeCurrency type.
+type PathfinderFeeCurrency struct {
+	// Minimal denom of the fee token (e.g., "uosmo")
+	Denom string `json:"denom" toml:"denom"`
+
+	// Human-readable symbol (e.g., "OSMO")
+	Symbol string `json:"symbol,omitempty" toml:"symbol,omitempty"`
+
+	// Number of decimal places
+	Decimals int `json:"decimals" toml:"decimals"`
+
+	// Gas prices in Denom per unit of gas
+	GasPriceStep PathfinderGasPriceStep `json:"gas_price_step" toml:"gas_price_step"`
+}
+
+// PathfinderGasPriceStep contains the low, average and high gas prices of a fee currency.
+type PathfinderGasPriceStep struct {
+	Low     float64 `json:"low" toml:"low"`
+	Average float64 `json:"average" toml:"average"`
+	High    float64 `json:"high" toml:"high"`
+}
+
 // PathfinderRoute represents an IBC route in the pathfinder's routing graph.
 // This maps directly to the router.BasicRoute type.
 type PathfinderRoute struct {
@@ -79,9 +109,15 @@ type PathfinderTokenInfo struct {
 	// Denom on the source chain in the route context
 	ChainDenom string `json:"chain_denom" toml:"chain_denom"`
 
+	// ICS-20 trace path of ChainDenom (e.g., "transfer/channel-0"), empty for native tokens or if unknown
+	ChainPath string `json:"chain_path,omitempty" toml:"chain_path,omitempty"`
+
 	// Denom on the destination chain (after IBC transfer)
 	IBCDenom string `json:"ibc_denom" toml:"ibc_denom"`
 
+	// ICS-20 trace path of IBCDenom on the destination chain, empty if it arrives native or if unknown
+	IBCPath string `json:"ibc_path,omitempty" toml:"ibc_path,omitempty"`
+
 	// Original native denom on the token's origin chain
 	BaseDenom string `json:"base_denom" toml:"base_denom"`
 
@@ -93,4 +129,7 @@ type PathfinderTokenInfo struct {
 
 	// Number of decimal places
 	Decimals int `json:"decimals" toml:"decimals"`
+
+	// CoinGecko ID for price lookups, only set on native tokens
+	CoinGeckoID string `json:"coingecko_id,omitempty" toml:"coingecko_id,omitempty"`
 }
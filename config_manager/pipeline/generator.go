@@ -4,7 +4,9 @@ package pipeline
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io/fs"
 	"log"
 	"os"
 	"path/filepath"
@@ -54,6 +56,12 @@ type GeneratorConfig struct {
 	// Skip network validation of endpoints
 	SkipNetworkValidation bool
 
+	// Skip checking on chain that the channels of the routes are open and their clients active
+	SkipChannelVerification bool
+
+	// Skip cross-checking the computed IBC denoms against the on-chain denom traces
+	SkipDenomVerification bool
+
 	// Skip downloading fresh registry data (use stored data)
 	UseLocalIbcReg bool
 
@@ -65,6 +73,9 @@ type GeneratorConfig struct {
 
 	// Path to the allowed explorers file
 	AllowedExplorersPath string
+
+	// Compare the generated configs with the ones at the output paths instead of overwriting them
+	DiffOnly bool
 }
 
 // Generator is the main config generation pipeline.
@@ -87,6 +98,12 @@ func NewGenerator(config GeneratorConfig) *Generator {
 	if config.SkipNetworkValidation {
 		builderOpts = append(builderOpts, enriched.WithSkipNetworkCheck(true))
 	}
+	if config.SkipChannelVerification {
+		builderOpts = append(builderOpts, enriched.WithChannelVerification(false))
+	}
+	if config.SkipDenomVerification {
+		builderOpts = append(builderOpts, enriched.WithDenomVerification(false))
+	}
 
 	if config.CopyIconsPath != "" {
 		clientConvOpts = append(clientConvOpts, output.WithIconCopy(true))
@@ -128,6 +145,9 @@ type GenerateResult struct {
 
 	// Any warnings during generation
 	Warnings []string
+
+	// Changes from the configs at the output paths, only set in diff mode
+	Diff *output.ConfigDiff
 }
 
 // Generate runs the complete configuration generation pipeline.
@@ -186,6 +206,7 @@ func (g *Generator) Generate() (*GenerateResult, error) {
 		return nil, fmt.Errorf("failed to build enriched config: %w", err)
 	}
 	result.ChainsProcessed = len(enrichedReg.Chains)
+	addDenomReports(result, enrichedReg)
 
 	// Step 5: Generate pathfinder config
 	log.Println("Generating pathfinder config...")
@@ -194,7 +215,13 @@ func (g *Generator) Generate() (*GenerateResult, error) {
 		return nil, fmt.Errorf("failed to convert to pathfinder config: %w", err)
 	}
 
-	if g.config.PathfinderOutputPath != "" {
+	if g.config.DiffOnly {
+		previous, err := g.loadPreviousPathfinderConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load previous pathfinder config: %w", err)
+		}
+		result.Diff = &output.ConfigDiff{Pathfinder: output.DiffPathfinderConfigs(previous, pathfinderConfig)}
+	} else if g.config.PathfinderOutputPath != "" {
 		if err := g.writePathfinderConfig(pathfinderConfig); err != nil {
 			return nil, fmt.Errorf("failed to write pathfinder config: %w", err)
 		}
@@ -209,7 +236,13 @@ func (g *Generator) Generate() (*GenerateResult, error) {
 		return nil, fmt.Errorf("failed to convert to client config: %w", err)
 	}
 
-	if g.config.ClientOutputPath != "" {
+	if g.config.DiffOnly {
+		previous, err := g.loadPreviousClientConfig()
+		if err != nil {
+			return nil, fmt.Errorf("failed to load previous client config: %w", err)
+		}
+		result.Diff.Client = output.DiffClientConfigs(previous, clientConfig)
+	} else if g.config.ClientOutputPath != "" {
 		if err := g.writeClientConfig(clientConfig); err != nil {
 			return nil, fmt.Errorf("failed to write client config: %w", err)
 		}
@@ -229,6 +262,37 @@ func (g *Generator) Generate() (*GenerateResult, error) {
 	return result, nil
 }
 
+// addDenomReports adds the denom mismatches found while building the registry to the validation results
+// of their chains and reports unverifiable denoms and suggested routable tokens as warnings.
+func addDenomReports(result *GenerateResult, enrichedReg *enriched.RegistryConfig) {
+	for chainID, chain := range enrichedReg.Chains {
+		if chain.DenomReport == nil {
+			continue
+		}
+
+		if len(chain.DenomReport.Mismatches) > 0 {
+			valResult, ok := result.ValidationResults[chainID]
+			if !ok {
+				valResult = &input.ValidationResult{ChainID: chainID, IsValid: true}
+				result.ValidationResults[chainID] = valResult
+			}
+			valResult.IsValid = false
+			log.Printf("%s: IBC denom verification failed", chainID)
+			for _, mismatch := range chain.DenomReport.Mismatches {
+				valResult.Errors = append(valResult.Errors, mismatch)
+				log.Printf("\t- %v", mismatch)
+			}
+		}
+		for _, denom := range chain.DenomReport.Unverified {
+			result.Warnings = append(result.Warnings,
+				fmt.Sprintf("%s: routable token %s has no denom trace on chain yet and could not be verified", chainID, denom))
+		}
+		for _, suggestion := range chain.DenomReport.Suggestions {
+			result.Warnings = append(result.Warnings, fmt.Sprintf("%s: %s", chainID, suggestion))
+		}
+	}
+}
+
 func (g *Generator) fetchIBCRegistry(inputConfigs map[string]*input.ChainInput) ([]registry.ChainIbcData, error) {
 	keywords := g.inputLoader.GetRegistryKeywords(inputConfigs)
 	log.Printf("Looking for IBC data matching: %v", keywords)
@@ -373,6 +437,34 @@ func (g *Generator) copyChainImages(inputConfigs map[string]*input.ChainInput, e
 	return nil
 }
 
+// loadPreviousPathfinderConfig loads the pathfinder config at the output path,
+// nil if none was generated there yet
+func (g *Generator) loadPreviousPathfinderConfig() (*output.PathfinderConfig, error) {
+	if g.config.PathfinderOutputPath == "" {
+		return nil, nil
+	}
+	config, err := output.LoadPathfinderConfig(g.config.PathfinderOutputPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("No pathfinder config at %s yet, everything is reported as added", g.config.PathfinderOutputPath)
+		return nil, nil
+	}
+	return config, err
+}
+
+// loadPreviousClientConfig loads the client config at the output path,
+// nil if none was generated there yet
+func (g *Generator) loadPreviousClientConfig() (*output.ClientConfig, error) {
+	if g.config.ClientOutputPath == "" {
+		return nil, nil
+	}
+	config, err := output.LoadClientConfig(g.config.ClientOutputPath)
+	if errors.Is(err, fs.ErrNotExist) {
+		log.Printf("No client config at %s yet, everything is reported as added", g.config.ClientOutputPath)
+		return nil, nil
+	}
+	return config, err
+}
+
 func (g *Generator) writePathfinderConfig(config *output.PathfinderConfig) error {
 	dir := filepath.Dir(g.config.PathfinderOutputPath)
 	if err := os.MkdirAll(dir, 0755); err != nil {
@@ -57,12 +57,12 @@ func (q *DenomQuerier) QueryAllDenomTraces() ([]DenomTraceInfo, error) {
 	nextKey := ""
 
 	for {
-		url := fmt.Sprintf("%s/ibc/apps/transfer/v1/denom_traces", q.baseURL)
+		tracesURL := fmt.Sprintf("%s/ibc/apps/transfer/v1/denom_traces", q.baseURL)
 		if nextKey != "" {
-			url = fmt.Sprintf("%s?pagination.key=%s", url, nextKey)
+			tracesURL = fmt.Sprintf("%s?pagination.key=%s", tracesURL, url.QueryEscape(nextKey))
 		}
 
-		body, err := q.doGetWithRetry(url)
+		body, err := q.doGetWithRetry(tracesURL)
 		if err != nil {
 			return nil, fmt.Errorf("failed to query denom traces: %w", err)
 		}
@@ -190,14 +190,19 @@ func FilterDirectTraces(traces []DenomTraceInfo, channelID string) []ParsedDenom
 }
 
 func (q *DenomQuerier) doGetWithRetry(url string) ([]byte, error) {
+	return getWithRetry(q.client, url, q.retryAttempts, q.retryDelay)
+}
+
+// getWithRetry gets url until it answers with 200 OK, at most retryAttempts+1 times
+func getWithRetry(client *http.Client, url string, retryAttempts int, retryDelay time.Duration) ([]byte, error) {
 	var lastErr error
 
-	for attempt := 0; attempt <= q.retryAttempts; attempt++ {
+	for attempt := 0; attempt <= retryAttempts; attempt++ {
 		if attempt > 0 {
-			time.Sleep(q.retryDelay)
+			time.Sleep(retryDelay)
 		}
 
-		resp, err := q.client.Get(url)
+		resp, err := client.Get(url)
 		if err != nil {
 			lastErr = err
 			continue
@@ -222,7 +227,7 @@ func (q *DenomQuerier) doGetWithRetry(url string) ([]byte, error) {
 		return body, nil
 	}
 
-	return nil, fmt.Errorf("request failed after %d attempts: %w", q.retryAttempts+1, lastErr)
+	return nil, fmt.Errorf("request failed after %d attempts: %w", retryAttempts+1, lastErr)
 }
 
 // IsHealthy checks if the REST endpoint is healthy.
@@ -0,0 +1,35 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query/querytest"
+)
+
+func TestQueryAllDenomTracesPaginated(t *testing.T) {
+	server := querytest.NewServer("osmosis-1")
+	defer server.Close()
+	server.SetPageSize(2)
+	server.AddDenomTrace("transfer/channel-0", "uatom")
+	server.AddDenomTrace("transfer/channel-1", "uatone")
+	server.AddDenomTrace("transfer/channel-2", "ujuno")
+	server.AddDenomTrace("transfer/channel-3/transfer/channel-4", "uatom")
+	server.AddDenomTrace("transfer/channel-5", "ustars")
+
+	querier := query.NewDenomQuerier(server.URL, 5*time.Second, 0, 0)
+	traces, err := querier.QueryAllDenomTraces()
+	if err != nil {
+		t.Fatalf("QueryAllDenomTraces() error = %v", err)
+	}
+	if len(traces) != 5 {
+		t.Fatalf("QueryAllDenomTraces() returned %d traces, want 5", len(traces))
+	}
+	if traces[3].Path != "transfer/channel-3/transfer/channel-4" || traces[3].BaseDenom != "uatom" {
+		t.Errorf("traces[3] = %+v, want the multi-hop uatom trace", traces[3])
+	}
+	if server.Requests() != 3 {
+		t.Errorf("server served %d requests, want 3 pages", server.Requests())
+	}
+}
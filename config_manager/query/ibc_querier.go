@@ -0,0 +1,102 @@
+package query
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+// Channel and connection state of an open IBC channel
+const ibcStateOpen = "STATE_OPEN"
+
+// Status of a light client that can still verify packets, the others are "Expired", "Frozen" and "Unknown"
+const ibcClientStatusActive = "Active"
+
+// IBCQuerier queries the live state of IBC channels, connections and light clients on a chain.
+// Like the DenomQuerier it uses a simple HTTP client against a single REST endpoint.
+type IBCQuerier struct {
+	baseURL       string
+	client        *http.Client
+	retryAttempts int
+	retryDelay    time.Duration
+}
+
+// NewIBCQuerier creates a querier for a single REST endpoint.
+func NewIBCQuerier(baseURL string, timeout time.Duration, retryAttempts int, retryDelay time.Duration) *IBCQuerier {
+	return &IBCQuerier{
+		baseURL: strings.TrimSuffix(baseURL, "/"),
+		client: &http.Client{
+			Timeout: timeout,
+		},
+		retryAttempts: retryAttempts,
+		retryDelay:    retryDelay,
+	}
+}
+
+// ChannelStatus is the live state of an IBC channel, its connection and the light client behind it.
+type ChannelStatus struct {
+	State           string // e.g., "STATE_OPEN", "STATE_CLOSED"
+	Ordering        string // e.g., "ORDER_UNORDERED"
+	Version         string // e.g., "ics20-1"
+	ConnectionID    string
+	ConnectionState string // e.g., "STATE_OPEN"
+	ClientID        string
+	ClientStatus    string // "Active", "Expired", "Frozen" or "Unknown"
+}
+
+// IsOpen reports whether packets can be sent over the channel:
+// the channel and its connection are open and the light client is active.
+func (s ChannelStatus) IsOpen() bool {
+	return s.State == ibcStateOpen && s.ConnectionState == ibcStateOpen && s.ClientStatus == ibcClientStatusActive
+}
+
+// QueryChannelStatus queries the channel, then its connection and the status of the connection's light client.
+func (q *IBCQuerier) QueryChannelStatus(portID, channelID string) (ChannelStatus, error) {
+	var channel IbcChannelDataResponse
+	channelURL := fmt.Sprintf("%s/ibc/core/channel/v1/channels/%s/ports/%s",
+		q.baseURL, url.PathEscape(channelID), url.PathEscape(portID))
+	if err := q.getJSON(channelURL, &channel); err != nil {
+		return ChannelStatus{}, fmt.Errorf("failed to query channel %s/%s: %w", portID, channelID, err)
+	}
+
+	status := ChannelStatus{
+		State:    channel.Channel.State,
+		Ordering: channel.Channel.Ordering,
+		Version:  channel.Channel.Version,
+	}
+	if len(channel.Channel.ConnectionHops) == 0 {
+		return ChannelStatus{}, fmt.Errorf("channel %s/%s has no connection", portID, channelID)
+	}
+	status.ConnectionID = channel.Channel.ConnectionHops[0]
+
+	var connection IbcConnectionResponse
+	connectionURL := fmt.Sprintf("%s/ibc/core/connection/v1/connections/%s", q.baseURL, url.PathEscape(status.ConnectionID))
+	if err := q.getJSON(connectionURL, &connection); err != nil {
+		return ChannelStatus{}, fmt.Errorf("failed to query connection %s: %w", status.ConnectionID, err)
+	}
+	status.ConnectionState = connection.Connection.State
+	status.ClientID = connection.Connection.ClientID
+
+	var client IbcClientStatusResponse
+	clientURL := fmt.Sprintf("%s/ibc/core/client/v1/client_status/%s", q.baseURL, url.PathEscape(status.ClientID))
+	if err := q.getJSON(clientURL, &client); err != nil {
+		return ChannelStatus{}, fmt.Errorf("failed to query client status of %s: %w", status.ClientID, err)
+	}
+	status.ClientStatus = client.Status
+
+	return status, nil
+}
+
+func (q *IBCQuerier) getJSON(url string, value any) error {
+	body, err := getWithRetry(q.client, url, q.retryAttempts, q.retryDelay)
+	if err != nil {
+		return err
+	}
+	if err := json.Unmarshal(body, value); err != nil {
+		return fmt.Errorf("failed to parse response: %w", err)
+	}
+	return nil
+}
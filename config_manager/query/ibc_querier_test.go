@@ -0,0 +1,61 @@
+package query_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query/querytest"
+)
+
+func TestQueryChannelStatus(t *testing.T) {
+	server := querytest.NewServer("atomone-1")
+	defer server.Close()
+	server.AddChannel("channel-2", "connection-2", "07-tendermint-2")
+	server.AddChannel("channel-3", "connection-3", "07-tendermint-3")
+	server.AddChannel("channel-4", "connection-4", "07-tendermint-4")
+	server.SetClientStatus("07-tendermint-3", querytest.ClientExpired)
+	server.SetChannelState("channel-4", querytest.StateClosed)
+
+	querier := query.NewIBCQuerier(server.URL, 5*time.Second, 0, 0)
+
+	tests := []struct {
+		name         string
+		channelID    string
+		wantOpen     bool
+		wantClientID string
+		wantStatus   string
+	}{
+		{name: "open channel", channelID: "channel-2", wantOpen: true, wantClientID: "07-tendermint-2", wantStatus: "Active"},
+		{name: "expired client", channelID: "channel-3", wantOpen: false, wantClientID: "07-tendermint-3", wantStatus: "Expired"},
+		{name: "closed channel", channelID: "channel-4", wantOpen: false, wantClientID: "07-tendermint-4", wantStatus: "Active"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status, err := querier.QueryChannelStatus("transfer", tt.channelID)
+			if err != nil {
+				t.Fatalf("QueryChannelStatus() error = %v", err)
+			}
+			if status.IsOpen() != tt.wantOpen {
+				t.Errorf("QueryChannelStatus() IsOpen = %v, want %v (%+v)", status.IsOpen(), tt.wantOpen, status)
+			}
+			if status.ClientID != tt.wantClientID {
+				t.Errorf("QueryChannelStatus() ClientID = %q, want %q", status.ClientID, tt.wantClientID)
+			}
+			if status.ClientStatus != tt.wantStatus {
+				t.Errorf("QueryChannelStatus() ClientStatus = %q, want %q", status.ClientStatus, tt.wantStatus)
+			}
+		})
+	}
+}
+
+func TestQueryChannelStatusUnknownChannel(t *testing.T) {
+	server := querytest.NewServer("atomone-1")
+	defer server.Close()
+
+	querier := query.NewIBCQuerier(server.URL, 5*time.Second, 0, 0)
+	if _, err := querier.QueryChannelStatus("transfer", "channel-404"); err == nil {
+		t.Error("QueryChannelStatus() should error for an unknown channel")
+	}
+}
@@ -0,0 +1,242 @@
+// Package querytest provides an in-memory stand-in for the REST endpoint of a Cosmos SDK chain.
+// It answers the node info query, the IBC core channel, connection and client status queries
+// for registered channels and the paginated denom traces query, so the config manager can be
+// exercised without network access.
+package querytest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+
+	"github.com/Cogwheel-Validator/spectra-portal/config_manager/query"
+)
+
+// Channel and connection states as reported by ibc-go
+const (
+	StateOpen   = "STATE_OPEN"
+	StateClosed = "STATE_CLOSED"
+)
+
+// Light client statuses as reported by ibc-go
+const (
+	ClientActive  = "Active"
+	ClientExpired = "Expired"
+	ClientFrozen  = "Frozen"
+)
+
+// PFMBuildDep is the build dependency the node info of a chain with PFM lists
+const PFMBuildDep = "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8"
+
+type channel struct {
+	state        string
+	connectionID string
+}
+
+type connection struct {
+	state    string
+	clientID string
+}
+
+// Server is a fake REST endpoint serving node info and IBC core queries
+type Server struct {
+	*httptest.Server
+
+	mu          sync.Mutex
+	nodeInfo    query.NodeInfoResponse
+	channels    map[string]channel // port/channel -> channel
+	connections map[string]connection
+	clients     map[string]string // client id -> status
+	denomTraces []query.DenomTraceInfo
+	pageSize    int
+	requests    int
+}
+
+// NewServer starts a fake REST endpoint of the given chain with no channels registered.
+// The node info reports PFM support. The caller should Close it when done.
+func NewServer(chainID string) *Server {
+	s := &Server{
+		channels:    make(map[string]channel),
+		connections: make(map[string]connection),
+		clients:     make(map[string]string),
+		denomTraces: make([]query.DenomTraceInfo, 0),
+		pageSize:    100,
+	}
+	s.nodeInfo.DefaultNodeInfo.Network = chainID
+	s.nodeInfo.ApplicationVersion.CosmosSdkVersion = "v0.50.13"
+	s.nodeInfo.ApplicationVersion.BuildDeps = []query.BuildDeps{{Path: PFMBuildDep, Version: "v8.2.0"}}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddChannel registers an open transfer channel with an open connection and an active client.
+// The state of each can be changed with SetChannelState, SetConnectionState and SetClientStatus.
+func (s *Server) AddChannel(channelID, connectionID, clientID string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.channels["transfer/"+channelID] = channel{state: StateOpen, connectionID: connectionID}
+	s.connections[connectionID] = connection{state: StateOpen, clientID: clientID}
+	s.clients[clientID] = ClientActive
+}
+
+// SetChannelState sets the state of a registered transfer channel
+func (s *Server) SetChannelState(channelID, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	key := "transfer/" + channelID
+	ch := s.channels[key]
+	ch.state = state
+	s.channels[key] = ch
+}
+
+// SetConnectionState sets the state of a registered connection
+func (s *Server) SetConnectionState(connectionID, state string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	conn := s.connections[connectionID]
+	conn.state = state
+	s.connections[connectionID] = conn
+}
+
+// SetClientStatus sets the status of a registered light client
+func (s *Server) SetClientStatus(clientID, status string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.clients[clientID] = status
+}
+
+// AddDenomTrace registers a denom trace, path is the full trace path, e.g. "transfer/channel-0"
+func (s *Server) AddDenomTrace(path, baseDenom string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.denomTraces = append(s.denomTraces, query.DenomTraceInfo{Path: path, BaseDenom: baseDenom})
+}
+
+// SetPageSize sets the number of denom traces served per page, 100 by default
+func (s *Server) SetPageSize(size int) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.pageSize = size
+}
+
+// Requests returns the number of queries served so far
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	path := r.URL.Path
+	switch {
+	case path == "/cosmos/base/tendermint/v1beta1/node_info":
+		writeJSON(w, s.nodeInfo)
+
+	case strings.HasPrefix(path, "/ibc/core/channel/v1/channels/"):
+		// /ibc/core/channel/v1/channels/{channel}/ports/{port}
+		parts := strings.Split(strings.TrimPrefix(path, "/ibc/core/channel/v1/channels/"), "/")
+		if len(parts) != 3 || parts[1] != "ports" {
+			writeNotFound(w)
+			return
+		}
+		ch, ok := s.channels[parts[2]+"/"+parts[0]]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var response query.IbcChannelDataResponse
+		response.Channel.State = ch.state
+		response.Channel.Ordering = "ORDER_UNORDERED"
+		response.Channel.Version = "ics20-1"
+		response.Channel.ConnectionHops = []string{ch.connectionID}
+		writeJSON(w, response)
+
+	case strings.HasPrefix(path, "/ibc/core/connection/v1/connections/"):
+		conn, ok := s.connections[strings.TrimPrefix(path, "/ibc/core/connection/v1/connections/")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		var response query.IbcConnectionResponse
+		response.Connection.State = conn.state
+		response.Connection.ClientID = conn.clientID
+		writeJSON(w, response)
+
+	case strings.HasPrefix(path, "/ibc/core/client/v1/client_status/"):
+		status, ok := s.clients[strings.TrimPrefix(path, "/ibc/core/client/v1/client_status/")]
+		if !ok {
+			writeNotFound(w)
+			return
+		}
+		writeJSON(w, query.IbcClientStatusResponse{Status: status})
+
+	case path == "/ibc/apps/transfer/v1/denom_traces":
+		s.writeDenomTraces(w, r.URL.Query().Get("pagination.key"))
+
+	default:
+		writeNotFound(w)
+	}
+}
+
+// writeDenomTraces writes the page of denom traces starting at key,
+// the keys are the base64 encoded offsets of the pages like the opaque keys of the SDK
+func (s *Server) writeDenomTraces(w http.ResponseWriter, key string) {
+	offset := 0
+	if key != "" {
+		decoded, err := base64.StdEncoding.DecodeString(key)
+		if err == nil {
+			offset, err = strconv.Atoi(string(decoded))
+		}
+		if err != nil || offset > len(s.denomTraces) {
+			w.WriteHeader(http.StatusBadRequest)
+			_ = json.NewEncoder(w).Encode(map[string]any{"code": 3, "message": "invalid pagination key", "details": []any{}})
+			return
+		}
+	}
+	end := min(offset+s.pageSize, len(s.denomTraces))
+
+	type denomTrace struct {
+		Path      string `json:"path"`
+		BaseDenom string `json:"base_denom"`
+	}
+	var response struct {
+		DenomTraces []denomTrace `json:"denom_traces"`
+		Pagination  struct {
+			NextKey *string `json:"next_key"`
+			Total   string  `json:"total"`
+		} `json:"pagination"`
+	}
+	response.DenomTraces = make([]denomTrace, 0, end-offset)
+	for _, trace := range s.denomTraces[offset:end] {
+		response.DenomTraces = append(response.DenomTraces, denomTrace{Path: trace.Path, BaseDenom: trace.BaseDenom})
+	}
+	if end < len(s.denomTraces) {
+		nextKey := base64.StdEncoding.EncodeToString([]byte(strconv.Itoa(end)))
+		response.Pagination.NextKey = &nextKey
+	}
+	response.Pagination.Total = strconv.Itoa(len(s.denomTraces))
+	writeJSON(w, response)
+}
+
+func writeJSON(w http.ResponseWriter, value any) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(value)
+}
+
+// writeNotFound answers like the gRPC gateway of a Cosmos SDK chain
+func writeNotFound(w http.ResponseWriter) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(http.StatusNotFound)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 5, "message": "not found", "details": []any{}})
+}
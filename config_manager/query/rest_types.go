@@ -43,6 +43,26 @@ type ProofHeight struct {
 	RevisionHeight string `json:"revision_height"`
 }
 
+// IbcConnectionResponse is the response from the REST API for an IBC connection
+type IbcConnectionResponse struct {
+	Connection struct {
+		ClientID     string `json:"client_id"`
+		State        string `json:"state"`
+		Counterparty struct {
+			ClientID     string `json:"client_id"`
+			ConnectionID string `json:"connection_id"`
+		} `json:"counterparty"`
+		DelayPeriod string `json:"delay_period"`
+	} `json:"connection"`
+	Proof       any         `json:"proof"`
+	ProofHeight ProofHeight `json:"proof_height"`
+}
+
+// IbcClientStatusResponse is the response from the REST API for the status of an IBC light client
+type IbcClientStatusResponse struct {
+	Status string `json:"status"`
+}
+
 // DenomTracesResponse type is the response from the REST API for the denom traces
 type DenomTracesResponse struct {
 	DenomTraces []DenomTrace `json:"denom_traces"`
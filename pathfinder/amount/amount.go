@@ -0,0 +1,151 @@
+// Package amount provides exact arithmetic on token amounts.
+//
+// Amounts are whole numbers of a token's base unit (e.g. uatom or wei) of any size, so tokens
+// with 18 decimals and large balances never overflow. Fractions such as fees or slippage are
+// decimals, multiplying an amount by one rounds the exact result in the stated direction.
+package amount
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/shopspring/decimal"
+)
+
+// Amount is a non-negative token amount in base units. The zero value is 0.
+type Amount struct {
+	value *big.Int
+}
+
+// Zero is the amount 0
+var Zero = Amount{}
+
+var (
+	one  = big.NewInt(1)
+	ten  = big.NewInt(10)
+	zero = big.NewInt(0)
+)
+
+// Parse parses an amount in base units, it must be a non-negative whole number without sign or exponent
+func Parse(s string) (Amount, error) {
+	if s == "" {
+		return Zero, fmt.Errorf("amount is empty")
+	}
+	for _, c := range s {
+		if c < '0' || c > '9' {
+			return Zero, fmt.Errorf("amount %q is not a whole number of base units", s)
+		}
+	}
+	value, ok := new(big.Int).SetString(s, 10)
+	if !ok {
+		return Zero, fmt.Errorf("amount %q is not a whole number of base units", s)
+	}
+	return Amount{value: value}, nil
+}
+
+// MustParse is like Parse but panics if s is not a valid amount, for constants and tests
+func MustParse(s string) Amount {
+	a, err := Parse(s)
+	if err != nil {
+		panic(err)
+	}
+	return a
+}
+
+// FromUint64 returns the amount of n base units
+func FromUint64(n uint64) Amount {
+	return Amount{value: new(big.Int).SetUint64(n)}
+}
+
+// int returns the value of the amount, never nil
+func (a Amount) int() *big.Int {
+	if a.value == nil {
+		return zero
+	}
+	return a.value
+}
+
+// String returns the amount in base units without leading zeros
+func (a Amount) String() string {
+	return a.int().String()
+}
+
+// Decimal returns the amount as a decimal, for ratios and prices
+func (a Amount) Decimal() decimal.Decimal {
+	return decimal.NewFromBigInt(a.int(), 0)
+}
+
+// IsZero reports whether the amount is 0
+func (a Amount) IsZero() bool {
+	return a.int().Sign() == 0
+}
+
+// Cmp compares a and b and returns -1, 0 or +1
+func (a Amount) Cmp(b Amount) int {
+	return a.int().Cmp(b.int())
+}
+
+// Add returns a + b
+func (a Amount) Add(b Amount) Amount {
+	return Amount{value: new(big.Int).Add(a.int(), b.int())}
+}
+
+// Sub returns a - b, or 0 if b is larger than a
+func (a Amount) Sub(b Amount) Amount {
+	if a.Cmp(b) <= 0 {
+		return Zero
+	}
+	return Amount{value: new(big.Int).Sub(a.int(), b.int())}
+}
+
+// MulDivFloor returns a * num / den rounded down, den must not be 0
+func (a Amount) MulDivFloor(num, den Amount) Amount {
+	product := new(big.Int).Mul(a.int(), num.int())
+	return Amount{value: product.Quo(product, den.int())}
+}
+
+// MulDivCeil returns a * num / den rounded up, den must not be 0
+func (a Amount) MulDivCeil(num, den Amount) Amount {
+	product := new(big.Int).Mul(a.int(), num.int())
+	quotient, remainder := new(big.Int).QuoRem(product, den.int(), new(big.Int))
+	if remainder.Sign() != 0 {
+		quotient.Add(quotient, one)
+	}
+	return Amount{value: quotient}
+}
+
+// MulFloor returns a * fraction rounded down, fraction must not be negative
+func (a Amount) MulFloor(fraction decimal.Decimal) Amount {
+	num, den := ratio(fraction)
+	return a.MulDivFloor(num, den)
+}
+
+// MulCeil returns a * fraction rounded up, fraction must not be negative
+func (a Amount) MulCeil(fraction decimal.Decimal) Amount {
+	num, den := ratio(fraction)
+	return a.MulDivCeil(num, den)
+}
+
+// DivCeil returns a / fraction rounded up, fraction must be positive
+func (a Amount) DivCeil(fraction decimal.Decimal) Amount {
+	num, den := ratio(fraction)
+	return a.MulDivCeil(den, num)
+}
+
+// ratio returns fraction as the exact ratio num / den of two amounts
+func ratio(fraction decimal.Decimal) (num, den Amount) {
+	coefficient := fraction.Coefficient()
+	exponent := fraction.Exponent()
+	scale := new(big.Int).Exp(ten, big.NewInt(int64(abs(exponent))), nil)
+	if exponent >= 0 {
+		return Amount{value: coefficient.Mul(coefficient, scale)}, Amount{value: big.NewInt(1)}
+	}
+	return Amount{value: coefficient}, Amount{value: scale}
+}
+
+func abs(n int32) int32 {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,59 @@
+package amount_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
+)
+
+func TestParse(t *testing.T) {
+	// 1000 tokens with 18 decimals and more is well above int64
+	a, err := amount.Parse("1000000000000000000000")
+	assert.NoError(t, err)
+	assert.Equal(t, a.String(), "1000000000000000000000")
+
+	a, err = amount.Parse("007")
+	assert.NoError(t, err)
+	assert.Equal(t, a.String(), "7")
+
+	for _, invalid := range []string{"", "-1", "+1", "1.5", "1e18", " 1", "0x10", "abc"} {
+		_, err := amount.Parse(invalid)
+		assert.Error(t, err)
+	}
+
+	assert.True(t, amount.Zero.IsZero())
+	assert.Equal(t, amount.Zero.String(), "0")
+}
+
+func TestAmount_Arithmetic(t *testing.T) {
+	a := amount.MustParse("123456789012345678901234567890")
+	b := amount.MustParse("10")
+
+	assert.Equal(t, a.Add(b).String(), "123456789012345678901234567900")
+	assert.Equal(t, a.Sub(b).String(), "123456789012345678901234567880")
+	assert.True(t, b.Sub(a).IsZero())
+	assert.Equal(t, a.Cmp(b), 1)
+	assert.Equal(t, b.Cmp(a), -1)
+	assert.Equal(t, a.Decimal().String(), "123456789012345678901234567890")
+
+	// 7 * 2 / 3 = 4.67
+	seven := amount.FromUint64(7)
+	assert.Equal(t, seven.MulDivFloor(amount.FromUint64(2), amount.FromUint64(3)).String(), "4")
+	assert.Equal(t, seven.MulDivCeil(amount.FromUint64(2), amount.FromUint64(3)).String(), "5")
+	assert.Equal(t, seven.MulDivCeil(amount.FromUint64(3), amount.FromUint64(3)).String(), "7")
+}
+
+func TestAmount_Fractions(t *testing.T) {
+	// The products are exact, a decimal division would round them at 16 places
+	a := amount.MustParse("999999999999999999999999")
+	fee := decimal.RequireFromString("0.001")
+	assert.Equal(t, a.MulFloor(fee).String(), "999999999999999999999")
+	assert.Equal(t, a.MulCeil(fee).String(), "1000000000000000000000")
+
+	assert.Equal(t, amount.MustParse("4995000").DivCeil(decimal.RequireFromString("0.999")).String(), "5000000")
+	assert.Equal(t, amount.MustParse("10").DivCeil(decimal.RequireFromString("3")).String(), "4")
+	assert.Equal(t, amount.MustParse("10").MulFloor(decimal.NewFromInt(25)).String(), "250")
+}
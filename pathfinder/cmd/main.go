@@ -3,15 +3,17 @@ package main
 import (
 	"context"
 	"flag"
+	"fmt"
 	"os"
 	"os/signal"
 	"syscall"
 	"time"
 
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/config"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
-	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/osmosis"
+	_ "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/all"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/rpc"
 	"github.com/rs/zerolog"
 )
@@ -31,6 +33,7 @@ func main() {
 	// Parse command line flags
 	configRpcPath := flag.String("config-rpc", "", "config file for the rpc server")
 	configChains := flag.String("config-chains", "generated_configs/pathfinder_config.toml", "config file for the chains")
+	watchChains := flag.Bool("watch-chains", true, "reload the chain config when the file changes, SIGHUP always reloads it")
 	flag.Parse()
 
 	cfg := ""
@@ -61,52 +64,47 @@ func main() {
 	log.Info().Int("count", len(chains)).Msg("Loaded chains")
 
 	// Build the route index
-	routeIndex := router.NewRouteIndex()
-	if err := routeIndex.BuildIndex(chains); err != nil {
+	routeIndex, err := buildRouteIndex(chains, rpcConfig)
+	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to build route index")
 	}
 
-	// Initialize broker clients
-	brokerClients := make(map[string]brokers.BrokerClient)
-
-	// Initialize Osmosis SQS broker if configured
-	if len(rpcConfig.SqsURLs) > 0 {
-		// Get Osmosis chain contract address
-		var osmosisContractAddress string
-		for _, chain := range chains {
-			if chain.BrokerId == "osmosis-sqs" {
-				osmosisContractAddress = chain.IBCHooksContract
-				break
-			}
+	// Initialize broker clients from the config, every implementation registers itself by type
+	brokerConfigs := buildBrokerConfigs(rpcConfig)
+	for i := range brokerConfigs {
+		if brokerConfigs[i].ContractAddress == "" {
+			brokerConfigs[i].ContractAddress = brokerContractAddress(chains, brokerConfigs[i].Id)
 		}
-
-		var osmosisBroker *osmosis.SqsBroker
-		if len(rpcConfig.SqsURLs) > 1 {
-			osmosisBroker = osmosis.NewSqsBrokerWithFailover(
-				rpcConfig.SqsURLs,
-				osmosisContractAddress,
-			)
-			log.Info().
-				Strs("urls", rpcConfig.SqsURLs).
-				Int("count", len(rpcConfig.SqsURLs)).
-				Msg("Osmosis SQS broker initialized with failover")
-		} else {
-			osmosisBroker = osmosis.NewSqsBroker(rpcConfig.SqsURLs, osmosisContractAddress)
-			log.Info().
-				Strs("urls", rpcConfig.SqsURLs).
-				Int("count", len(rpcConfig.SqsURLs)).
-				Msg("Osmosis SQS broker initialized")
-		}
-		// Register with broker ID from config (osmosis-sqs) to match chain config broker_id
-		brokerClients["osmosis-sqs"] = osmosisBroker
+	}
+	brokerClients, err := brokers.NewClients(brokerConfigs)
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize broker clients")
+	}
+	for _, brokerConfig := range brokerConfigs {
+		log.Info().
+			Str("broker", brokerConfig.Id).
+			Str("type", brokerConfig.Type).
+			Msg("Broker client registered")
 	}
 
 	// Create the pathfinder
 	pathfinder := router.NewPathfinder(chains, routeIndex, brokerClients)
 
-	// Create denom resolver for the RPC server
-	denomResolver := router.NewDenomResolver(routeIndex)
-	denomResolver.SetChains(chains)
+	// Reject routes that break the configured guards
+	pathfinder.SetRouteGuards(buildRouteGuards(rpcConfig.Guards, rpcConfig.Brokers))
+
+	// Give transfers through slow chains more time
+	pathfinder.SetIBCTimeouts(buildIBCTimeouts(rpcConfig.IBC))
+
+	// Value routes in USD if price providers are configured
+	priceProvider, err := prices.NewProvider(buildPriceConfig(rpcConfig))
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to initialize price providers")
+	}
+	if priceProvider != nil {
+		pathfinder.SetPriceProvider(priceProvider)
+		log.Info().Str("providers", priceProvider.Name()).Msg("USD valuation enabled")
+	}
 
 	// Create the RPC server configuration
 	serverConfig := buildServerConfig(rpcConfig)
@@ -116,11 +114,24 @@ func main() {
 	defer cancel()
 
 	// Create the RPC server
-	server, err := rpc.NewServer(ctx, serverConfig, pathfinder, denomResolver)
+	server, err := rpc.NewServer(ctx, serverConfig, pathfinder)
 	if err != nil {
 		log.Fatal().Err(err).Msg("Failed to create RPC server")
 	}
 
+	// Reload the chain config on file changes and SIGHUP, a broken config keeps the current routes
+	err = config.WatchChainConfig(ctx, *configChains, *watchChains, func(trigger string) {
+		log.Info().Str("trigger", trigger).Str("chains_config", *configChains).Msg("Reloading chain config")
+		err := reloadChains(*configChains, rpcConfig, pathfinder)
+		if err != nil {
+			log.Error().Err(err).Msg("Chain config reload failed, keeping the current config")
+		}
+		server.ReportConfigReload(err)
+	})
+	if err != nil {
+		log.Fatal().Err(err).Msg("Failed to watch chain config")
+	}
+
 	// Setup signal handling for graceful shutdown
 	sigCh := make(chan os.Signal, 1)
 	signal.Notify(sigCh, syscall.SIGINT, syscall.SIGTERM)
@@ -154,6 +165,181 @@ func main() {
 	}
 }
 
+// buildRouteIndex builds the route index of the chains with the routing settings of the RPC config
+func buildRouteIndex(chains []router.PathfinderChain, rpcConfig *config.RPCPathfinderConfig) (*router.RouteIndex, error) {
+	routeIndex := router.NewRouteIndex()
+	if err := routeIndex.BuildIndex(chains); err != nil {
+		return nil, err
+	}
+	routeIndex.SetEdgeCostConfig(buildEdgeCostConfig(rpcConfig.Routing))
+	return routeIndex, nil
+}
+
+// reloadChains loads the chain config again and swaps the new routes into the pathfinder.
+// Broker clients come from the RPC config and are kept as they are.
+func reloadChains(path string, rpcConfig *config.RPCPathfinderConfig, pathfinder *router.Pathfinder) error {
+	chains, err := config.NewChainConfigLoader().LoadFromFile(path)
+	if err != nil {
+		return err
+	}
+
+	routeIndex, err := buildRouteIndex(chains, rpcConfig)
+	if err != nil {
+		return fmt.Errorf("failed to build route index: %w", err)
+	}
+
+	return pathfinder.Reload(chains, routeIndex)
+}
+
+// brokerContractAddress returns the ibc-hooks contract of the chain using the given broker id
+func brokerContractAddress(chains []router.PathfinderChain, brokerId string) string {
+	for _, chain := range chains {
+		if chain.BrokerId == brokerId {
+			return chain.IBCHooksContract
+		}
+	}
+	return ""
+}
+
+// sqsBrokerId is the id and type of the broker created from the legacy sqs_urls setting
+const sqsBrokerId = "osmosis-sqs"
+
+// buildBrokerConfigs returns the configs of every broker client to create.
+// The legacy sqs_urls setting adds an "osmosis-sqs" broker unless [[brokers]] already defines one.
+func buildBrokerConfigs(cfg *config.RPCPathfinderConfig) []brokers.Config {
+	configs := make([]brokers.Config, 0, len(cfg.Brokers)+1)
+	hasSqs := false
+
+	for _, broker := range cfg.Brokers {
+		if broker.Id == sqsBrokerId {
+			hasSqs = true
+		}
+		configs = append(configs, brokers.Config{
+			Id:                  broker.Id,
+			Type:                broker.Type,
+			Endpoints:           broker.Endpoints,
+			ContractAddress:     broker.ContractAddress,
+			MaxRetries:          broker.MaxRetries,
+			RetryDelay:          broker.RetryDelay,
+			Timeout:             broker.Timeout,
+			HealthCheckInterval: broker.HealthCheckInterval,
+			Options:             broker.Options,
+			Cache:               buildCacheConfig(broker),
+		})
+	}
+
+	if len(cfg.SqsURLs) > 0 && !hasSqs {
+		configs = append(configs, brokers.Config{
+			Id:        sqsBrokerId,
+			Type:      sqsBrokerId,
+			Endpoints: cfg.SqsURLs,
+			Cache:     brokers.CacheConfig{TTL: brokers.DefaultCacheTTL},
+		})
+	}
+
+	return configs
+}
+
+// buildCacheConfig returns the quote cache settings of the broker, the TTL defaults to brokers.DefaultCacheTTL
+func buildCacheConfig(broker config.BrokerConfig) brokers.CacheConfig {
+	if broker.DisableCache {
+		return brokers.CacheConfig{}
+	}
+
+	ttl := broker.CacheTTL
+	if ttl == 0 {
+		ttl = brokers.DefaultCacheTTL
+	}
+	return brokers.CacheConfig{
+		TTL:          ttl,
+		AmountDigits: broker.CacheAmountDigits,
+	}
+}
+
+// buildPriceConfig returns the settings of the price providers.
+// SQS prices use the endpoints of the osmosis-sqs broker unless the prices section sets its own.
+func buildPriceConfig(cfg *config.RPCPathfinderConfig) prices.Config {
+	cacheTTL := cfg.Prices.CacheTTL
+	if cacheTTL == 0 {
+		cacheTTL = prices.DefaultCacheTTL
+	}
+
+	sqsURLs := cfg.Prices.SqsURLs
+	if len(sqsURLs) == 0 {
+		for _, broker := range buildBrokerConfigs(cfg) {
+			if broker.Type == sqsBrokerId {
+				sqsURLs = broker.Endpoints
+				break
+			}
+		}
+	}
+
+	return prices.Config{
+		Providers:       cfg.Prices.Providers,
+		CacheTTL:        cacheTTL,
+		SqsURLs:         sqsURLs,
+		SqsChainId:      cfg.Prices.SqsChainId,
+		CoinGeckoURL:    cfg.Prices.CoinGeckoURL,
+		CoinGeckoAPIKey: cfg.Prices.CoinGeckoAPIKey,
+	}
+}
+
+// buildEdgeCostConfig converts the routing config to the router edge cost weights
+func buildEdgeCostConfig(routing config.RoutingConfig) router.EdgeCostConfig {
+	penalties := make(map[string]float64, len(routing.ChannelPenalties))
+	for _, penalty := range routing.ChannelPenalties {
+		penalties[router.ChannelKey(penalty.ChainId, penalty.ChannelId)] += penalty.Penalty
+	}
+
+	failureRates := make(map[string]float64, len(routing.ChannelFailureRates))
+	for _, rate := range routing.ChannelFailureRates {
+		failureRates[router.ChannelKey(rate.ChainId, rate.ChannelId)] = rate.FailureRate
+	}
+
+	return router.EdgeCostConfig{
+		HopCost:             routing.HopCost,
+		NonPFMPenalty:       routing.NonPFMPenalty,
+		FailureRateWeight:   routing.FailureRateWeight,
+		ChannelPenalties:    penalties,
+		ChannelFailureRates: failureRates,
+	}
+}
+
+// buildIBCTimeouts converts the IBC config to the router IBC timeouts
+func buildIBCTimeouts(ibc config.IBCConfig) router.IBCTimeouts {
+	chains := make(map[string]router.ChainIBCTimeouts, len(ibc.Chains))
+	for _, chain := range ibc.Chains {
+		chains[chain.ChainId] = router.ChainIBCTimeouts{
+			Timeout: chain.Timeout,
+			Retries: chain.Retries,
+		}
+	}
+
+	return router.IBCTimeouts{
+		Timeout: ibc.Timeout,
+		Retries: ibc.Retries,
+		Chains:  chains,
+	}
+}
+
+// buildRouteGuards converts the guards config to the router route guards,
+// the brokers set to accept unknown liquidity are taken from the broker configs
+func buildRouteGuards(guards config.GuardsConfig, brokerConfigs []config.BrokerConfig) router.RouteGuards {
+	acceptUnknownLiquidity := make(map[string]bool)
+	for _, broker := range brokerConfigs {
+		if broker.AcceptUnknownLiquidity {
+			acceptUnknownLiquidity[broker.Id] = true
+		}
+	}
+
+	return router.RouteGuards{
+		MaxPriceImpact:         guards.MaxPriceImpact,
+		MinLiquidityUSD:        guards.MinLiquidityUSD,
+		MaxHops:                guards.MaxHops,
+		AcceptUnknownLiquidity: acceptUnknownLiquidity,
+	}
+}
+
 // buildServerConfig converts the loaded RPCPathfinderConfig to rpc.ServerConfig
 func buildServerConfig(cfg *config.RPCPathfinderConfig) *rpc.ServerConfig {
 	serverConfig := &rpc.ServerConfig{
@@ -170,6 +356,9 @@ func buildServerConfig(cfg *config.RPCPathfinderConfig) *rpc.ServerConfig {
 	if cfg.MaxConcurrentRequests > 0 {
 		serverConfig.MaxConcurrentRequests = &cfg.MaxConcurrentRequests
 	}
+	if cfg.MaxConcurrentStreams > 0 {
+		serverConfig.MaxConcurrentStreams = &cfg.MaxConcurrentStreams
+	}
 
 	// Set OpenTelemetry configuration if any telemetry is enabled
 	if cfg.EnableTracing || cfg.EnableMetrics || cfg.EnableLogs || cfg.UsePrometheus {
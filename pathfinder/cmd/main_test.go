@@ -0,0 +1,153 @@
+package main
+
+import (
+	"testing"
+	"time"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/config"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+func TestBuildBrokerConfigs(t *testing.T) {
+	cfg := &config.RPCPathfinderConfig{
+		Brokers: []config.BrokerConfig{
+			{
+				Id:                "osmosis-sqs",
+				Type:              "osmosis-sqs",
+				Endpoints:         []string{"https://sqs.example.com/q1", "https://sqs.example.com/q2"},
+				MaxRetries:        3,
+				RetryDelay:        250 * time.Millisecond,
+				CacheTTL:          2 * time.Second,
+				CacheAmountDigits: 3,
+			},
+			{
+				Id:           "neutron-astroport",
+				Type:         "astroport",
+				Endpoints:    []string{"https://lcd.example.com"},
+				DisableCache: true,
+				Options:      map[string]string{"factory_address": "neutron1factory", "hop_denoms": "untrn, ibc/USDC"},
+			},
+		},
+		// The osmosis-sqs broker is already defined, the legacy setting doesn't add another one
+		SqsURLs: []string{"https://sqs.example.com/legacy"},
+	}
+
+	brokerConfigs := buildBrokerConfigs(cfg)
+	if len(brokerConfigs) != 2 {
+		t.Fatalf("expected 2 brokers, got %+v", brokerConfigs)
+	}
+
+	sqs := brokerConfigs[0]
+	if len(sqs.Endpoints) != 2 || sqs.MaxRetries != 3 || sqs.RetryDelay != 250*time.Millisecond {
+		t.Errorf("unexpected sqs broker: %+v", sqs)
+	}
+	if sqs.Cache.TTL != 2*time.Second || sqs.Cache.AmountDigits != 3 {
+		t.Errorf("unexpected sqs cache config: %+v", sqs.Cache)
+	}
+
+	astroport := brokerConfigs[1]
+	if astroport.Option("factory_address", "") != "neutron1factory" {
+		t.Errorf("unexpected astroport broker: %+v", astroport)
+	}
+	if hops := astroport.OptionList("hop_denoms"); len(hops) != 2 || hops[1] != "ibc/USDC" {
+		t.Errorf("unexpected hop denoms: %v", hops)
+	}
+	if astroport.Cache.TTL != 0 {
+		t.Errorf("expected astroport cache to be disabled, got %+v", astroport.Cache)
+	}
+}
+
+func TestBuildBrokerConfigs_LegacySqsURLs(t *testing.T) {
+	cfg := &config.RPCPathfinderConfig{SqsURLs: []string{"https://sqs.example.com/q1"}}
+
+	brokerConfigs := buildBrokerConfigs(cfg)
+	if len(brokerConfigs) != 1 || brokerConfigs[0].Id != "osmosis-sqs" || brokerConfigs[0].Type != "osmosis-sqs" {
+		t.Fatalf("expected legacy osmosis-sqs broker, got %+v", brokerConfigs)
+	}
+	if brokerConfigs[0].Cache.TTL != brokers.DefaultCacheTTL {
+		t.Errorf("expected default cache ttl, got %+v", brokerConfigs[0].Cache)
+	}
+}
+
+func TestBuildPriceConfig(t *testing.T) {
+	cfg := &config.RPCPathfinderConfig{
+		Brokers: []config.BrokerConfig{
+			{Id: "osmosis-sqs", Type: "osmosis-sqs", Endpoints: []string{"https://sqs.example.com/q1"}},
+		},
+		Prices: config.PricesConfig{
+			Providers:       []string{"sqs", "coingecko"},
+			CoinGeckoAPIKey: "key",
+		},
+	}
+
+	// SQS prices are queried from the osmosis-sqs broker endpoints
+	priceConfig := buildPriceConfig(cfg)
+	if len(priceConfig.Providers) != 2 || priceConfig.CoinGeckoAPIKey != "key" {
+		t.Errorf("unexpected price config: %+v", priceConfig)
+	}
+	if len(priceConfig.SqsURLs) != 1 || priceConfig.SqsURLs[0] != "https://sqs.example.com/q1" {
+		t.Errorf("expected the broker sqs urls, got %v", priceConfig.SqsURLs)
+	}
+	if priceConfig.CacheTTL != prices.DefaultCacheTTL {
+		t.Errorf("expected default price cache ttl, got %v", priceConfig.CacheTTL)
+	}
+}
+
+func TestBuildEdgeCostConfig(t *testing.T) {
+	routing := config.RoutingConfig{
+		HopCost:           2,
+		NonPFMPenalty:     0.5,
+		FailureRateWeight: 8,
+		ChannelPenalties: []config.ChannelPenalty{
+			{ChainId: "osmosis-1", ChannelId: "channel-0", Penalty: 3},
+			{ChainId: "osmosis-1", ChannelId: "channel-0", Penalty: 0.5},
+		},
+		ChannelFailureRates: []config.ChannelFailureRate{
+			{ChainId: "cosmoshub-4", ChannelId: "channel-141", FailureRate: 0.2},
+		},
+	}
+
+	edgeCost := buildEdgeCostConfig(routing)
+	if edgeCost.HopCost != 2 || edgeCost.NonPFMPenalty != 0.5 || edgeCost.FailureRateWeight != 8 {
+		t.Errorf("unexpected routing weights: %+v", edgeCost)
+	}
+	if edgeCost.ChannelPenalties["osmosis-1/channel-0"] != 3.5 {
+		t.Errorf("unexpected channel penalties: %+v", edgeCost.ChannelPenalties)
+	}
+	if edgeCost.ChannelFailureRates["cosmoshub-4/channel-141"] != 0.2 {
+		t.Errorf("unexpected channel failure rates: %+v", edgeCost.ChannelFailureRates)
+	}
+}
+
+func TestBuildRouteGuards(t *testing.T) {
+	guards := config.GuardsConfig{MaxPriceImpact: 0.05, MinLiquidityUSD: 10000, MaxHops: 3}
+	brokerConfigs := []config.BrokerConfig{
+		{Id: "osmosis-sqs", Type: "osmosis-sqs"},
+		{Id: "neutron-astroport", Type: "astroport", AcceptUnknownLiquidity: true},
+	}
+
+	routeGuards := buildRouteGuards(guards, brokerConfigs)
+	if routeGuards.MaxPriceImpact != 0.05 || routeGuards.MinLiquidityUSD != 10000 || routeGuards.MaxHops != 3 {
+		t.Errorf("unexpected route guards: %+v", routeGuards)
+	}
+	if !routeGuards.AcceptUnknownLiquidity["neutron-astroport"] || routeGuards.AcceptUnknownLiquidity["osmosis-sqs"] {
+		t.Errorf("unexpected brokers accepting unknown liquidity: %+v", routeGuards.AcceptUnknownLiquidity)
+	}
+}
+
+func TestBuildIBCTimeouts(t *testing.T) {
+	ibc := config.IBCConfig{
+		Timeout: 5 * time.Minute,
+		Retries: 1,
+		Chains:  []config.ChainIBCConfig{{ChainId: "evmos_9001-2", Timeout: 30 * time.Minute, Retries: 4}},
+	}
+
+	timeouts := buildIBCTimeouts(ibc)
+	if timeouts.Timeout != 5*time.Minute || timeouts.Retries != 1 {
+		t.Errorf("unexpected ibc timeouts: %+v", timeouts)
+	}
+	if chain := timeouts.Chains["evmos_9001-2"]; chain.Timeout != 30*time.Minute || chain.Retries != 4 {
+		t.Errorf("unexpected chain ibc timeouts: %+v", chain)
+	}
+}
@@ -56,14 +56,25 @@ func (l *ChainConfigLoader) ConvertToRouterTypes(config *output.PathfinderConfig
 			Name:             pathfinderChain.Name,
 			Id:               pathfinderChain.ID,
 			HasPFM:           pathfinderChain.HasPFM,
+			PFMFeePercentage: pathfinderChain.PFMFeePercentage,
 			Broker:           pathfinderChain.Broker,
 			BrokerId:         pathfinderChain.BrokerID,
 			IBCHooksContract: pathfinderChain.IBCHooksContract,
 			Bech32Prefix:     pathfinderChain.Bech32Prefix,
+			FeeCurrencies:    make([]router.FeeCurrency, len(pathfinderChain.FeeCurrencies)),
 			NativeTokens:     make([]router.TokenInfo, len(pathfinderChain.NativeTokens)),
 			Routes:           make([]router.BasicRoute, len(pathfinderChain.Routes)),
 		}
 
+		for j, feeCurrency := range pathfinderChain.FeeCurrencies {
+			chains[i].FeeCurrencies[j] = router.FeeCurrency{
+				Denom:        feeCurrency.Denom,
+				Symbol:       feeCurrency.Symbol,
+				Decimals:     feeCurrency.Decimals,
+				GasPriceStep: router.GasPriceStep(feeCurrency.GasPriceStep),
+			}
+		}
+
 		// Convert native tokens
 		for j, nativeToken := range pathfinderChain.NativeTokens {
 			chains[i].NativeTokens[j] = router.TokenInfo{
@@ -73,6 +84,7 @@ func (l *ChainConfigLoader) ConvertToRouterTypes(config *output.PathfinderConfig
 				OriginChain: nativeToken.OriginChain,
 				Symbol:      nativeToken.Symbol,
 				Decimals:    nativeToken.Decimals,
+				CoinGeckoID: nativeToken.CoinGeckoID,
 			}
 		}
 
@@ -89,7 +101,9 @@ func (l *ChainConfigLoader) ConvertToRouterTypes(config *output.PathfinderConfig
 			for denom, tokenInfo := range route.AllowedTokens {
 				chains[i].Routes[j].AllowedTokens[denom] = router.TokenInfo{
 					ChainDenom:  tokenInfo.ChainDenom,
+					ChainPath:   tokenInfo.ChainPath,
 					IbcDenom:    tokenInfo.IBCDenom,
+					IbcPath:     tokenInfo.IBCPath,
 					BaseDenom:   tokenInfo.BaseDenom,
 					OriginChain: tokenInfo.OriginChain,
 					Symbol:      tokenInfo.Symbol,
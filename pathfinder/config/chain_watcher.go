@@ -0,0 +1,91 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/signal"
+	"path/filepath"
+	"syscall"
+	"time"
+
+	"github.com/fsnotify/fsnotify"
+)
+
+// chainConfigDebounce is how long the chain config file has to be quiet before it is reloaded.
+// Generating a config writes the file in several steps, only the final content should be loaded.
+const chainConfigDebounce = 500 * time.Millisecond
+
+// Reload triggers passed to the reload callback of WatchChainConfig
+const (
+	ReloadTriggerFile   = "file"
+	ReloadTriggerSignal = "SIGHUP"
+)
+
+/*
+WatchChainConfig calls reload whenever the chain config file at path changes or the process receives SIGHUP.
+The parent directory is watched, so files replaced by a rename (e.g. by editors or the config manager) are picked up too.
+When watchFile is false only SIGHUP triggers a reload.
+
+reload is called from a single goroutine with the trigger (ReloadTriggerFile or ReloadTriggerSignal)
+and is never called concurrently. Watching stops when ctx is done.
+*/
+func WatchChainConfig(ctx context.Context, path string, watchFile bool, reload func(trigger string)) error {
+	var events chan fsnotify.Event
+	var watchErrors chan error
+	target := filepath.Clean(path)
+
+	if watchFile {
+		watcher, err := fsnotify.NewWatcher()
+		if err != nil {
+			return fmt.Errorf("failed to create chain config watcher: %w", err)
+		}
+		if err := watcher.Add(filepath.Dir(target)); err != nil {
+			_ = watcher.Close()
+			return fmt.Errorf("failed to watch chain config %s: %w", path, err)
+		}
+		events, watchErrors = watcher.Events, watcher.Errors
+
+		go func() {
+			<-ctx.Done()
+			_ = watcher.Close()
+		}()
+	}
+
+	sighup := make(chan os.Signal, 1)
+	signal.Notify(sighup, syscall.SIGHUP)
+
+	go func() {
+		defer signal.Stop(sighup)
+
+		var debounce <-chan time.Time
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-sighup:
+				reload(ReloadTriggerSignal)
+			case event, ok := <-events:
+				if !ok {
+					events = nil
+					continue
+				}
+				if filepath.Clean(event.Name) == target && event.Has(fsnotify.Write|fsnotify.Create) {
+					debounce = time.After(chainConfigDebounce)
+				}
+			case _, ok := <-watchErrors:
+				if !ok {
+					watchErrors = nil
+					continue
+				}
+				// Events may have been dropped, reload to be sure the latest config is used
+				debounce = time.After(chainConfigDebounce)
+			case <-debounce:
+				debounce = nil
+				reload(ReloadTriggerFile)
+			}
+		}
+	}()
+
+	return nil
+}
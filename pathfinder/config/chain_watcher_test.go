@@ -0,0 +1,92 @@
+package config_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"syscall"
+	"testing"
+	"time"
+
+	. "github.com/Cogwheel-Validator/spectra-portal/pathfinder/config"
+)
+
+// watchTriggers starts watching path and returns the channel the reload triggers are sent to
+func watchTriggers(t *testing.T, path string, watchFile bool) <-chan string {
+	t.Helper()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+
+	triggers := make(chan string, 10)
+	if err := WatchChainConfig(ctx, path, watchFile, func(trigger string) {
+		triggers <- trigger
+	}); err != nil {
+		t.Fatalf("failed to watch chain config: %v", err)
+	}
+	return triggers
+}
+
+func expectTrigger(t *testing.T, triggers <-chan string, expected string) {
+	t.Helper()
+
+	select {
+	case trigger := <-triggers:
+		if trigger != expected {
+			t.Fatalf("expected %s trigger, got %s", expected, trigger)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("expected %s trigger, got none", expected)
+	}
+}
+
+func TestWatchChainConfig_FileChange(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "pathfinder_config.toml")
+	if err := os.WriteFile(path, []byte("# v1\n"), 0o600); err != nil {
+		t.Fatalf("failed writing config: %v", err)
+	}
+
+	triggers := watchTriggers(t, path, true)
+
+	// Other files in the directory are ignored
+	if err := os.WriteFile(filepath.Join(dir, "other.toml"), []byte("# other\n"), 0o600); err != nil {
+		t.Fatalf("failed writing other file: %v", err)
+	}
+
+	// A burst of writes reloads once
+	for i := 0; i < 3; i++ {
+		if err := os.WriteFile(path, []byte("# v2\n"), 0o600); err != nil {
+			t.Fatalf("failed writing config: %v", err)
+		}
+	}
+	expectTrigger(t, triggers, ReloadTriggerFile)
+
+	select {
+	case trigger := <-triggers:
+		t.Fatalf("expected a single reload, got another %s trigger", trigger)
+	case <-time.After(time.Second):
+	}
+
+	// Replacing the file with a rename is picked up too
+	tmp := filepath.Join(dir, "pathfinder_config.toml.tmp")
+	if err := os.WriteFile(tmp, []byte("# v3\n"), 0o600); err != nil {
+		t.Fatalf("failed writing config: %v", err)
+	}
+	if err := os.Rename(tmp, path); err != nil {
+		t.Fatalf("failed replacing config: %v", err)
+	}
+	expectTrigger(t, triggers, ReloadTriggerFile)
+}
+
+func TestWatchChainConfig_SIGHUP(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "pathfinder_config.toml")
+
+	// Signals reload even when the file isn't watched
+	triggers := watchTriggers(t, path, false)
+
+	if err := syscall.Kill(os.Getpid(), syscall.SIGHUP); err != nil {
+		t.Fatalf("failed to send SIGHUP: %v", err)
+	}
+	expectTrigger(t, triggers, ReloadTriggerSignal)
+}
@@ -36,6 +36,7 @@ func loadEnv(v *viper.Viper) (*RPCPathfinderConfig, error) {
 	v.SetEnvKeyReplacer(strings.NewReplacer(".", "_"))
 	v.AutomaticEnv()
 	bindEnvKeys(v)
+	setDefaults(v)
 
 	var config RPCPathfinderConfig
 	if err := v.Unmarshal(&config); err != nil {
@@ -52,18 +53,30 @@ func loadEnv(v *viper.Viper) (*RPCPathfinderConfig, error) {
 func bindEnvKeys(v *viper.Viper) {
 	keys := []string{
 		"port", "host", "allowed_origins", "enable_reflection",
-		"rate_per_minute", "max_concurrent_requests",
+		"rate_per_minute", "max_concurrent_requests", "max_concurrent_streams",
 		"service_name", "service_version", "environment",
 		"enable_tracing", "use_otlp_traces", "otlp_traces_url",
 		"enable_metrics", "use_prometheus", "use_otlp_metrics", "otlp_metrics_url",
 		"enable_logs", "use_otlp_logs", "otlp_logs_url",
 		"insecure_otlp", "development_mode", "sqs_urls",
+		"routing.hop_cost", "routing.non_pfm_penalty", "routing.failure_rate_weight",
+		"prices.providers", "prices.cache_ttl", "prices.coingecko_api_key",
+		"guards.max_price_impact", "guards.min_liquidity_usd", "guards.max_hops",
+		"ibc.timeout", "ibc.retries",
 	}
 	for _, k := range keys {
 		_ = v.BindEnv(k)
 	}
 }
 
+// setDefaults sets the values of optional keys that don't default to their zero value,
+// the routing weights match the router defaults
+func setDefaults(v *viper.Viper) {
+	v.SetDefault("routing.hop_cost", 1.0)
+	v.SetDefault("routing.non_pfm_penalty", 0.5)
+	v.SetDefault("routing.failure_rate_weight", 10.0)
+}
+
 func loadFile(v *viper.Viper, configPath string) (*RPCPathfinderConfig, error) {
 	if !strings.HasSuffix(configPath, ".toml") {
 		return nil, fmt.Errorf("config file must be a toml file")
@@ -71,6 +84,7 @@ func loadFile(v *viper.Viper, configPath string) (*RPCPathfinderConfig, error) {
 
 	v.SetConfigFile(configPath)
 	v.SetConfigType("toml")
+	setDefaults(v)
 
 	if err := v.ReadInConfig(); err != nil {
 		return nil, fmt.Errorf("failed to read config file: %w", err)
@@ -100,8 +114,8 @@ func verifyConfig(config *RPCPathfinderConfig) error {
 		return fmt.Errorf("allowed_origins is required")
 	}
 
-	if len(config.SqsURLs) == 0 {
-		return fmt.Errorf("sqs_urls is required")
+	if len(config.SqsURLs) == 0 && len(config.Brokers) == 0 {
+		return fmt.Errorf("at least one broker is required, set [[brokers]] or sqs_urls")
 	}
 
 	for _, url := range config.SqsURLs {
@@ -110,5 +124,121 @@ func verifyConfig(config *RPCPathfinderConfig) error {
 		}
 	}
 
+	if err := verifyBrokers(config.Brokers); err != nil {
+		return err
+	}
+
+	if config.Routing.HopCost < 0 || config.Routing.NonPFMPenalty < 0 || config.Routing.FailureRateWeight < 0 {
+		return fmt.Errorf("routing weights must not be negative")
+	}
+
+	for _, penalty := range config.Routing.ChannelPenalties {
+		if penalty.ChainId == "" || penalty.ChannelId == "" {
+			return fmt.Errorf("routing channel penalties require chain_id and channel_id")
+		}
+		if penalty.Penalty < 0 {
+			return fmt.Errorf("routing channel penalty for %s/%s must not be negative",
+				penalty.ChainId, penalty.ChannelId)
+		}
+	}
+
+	for _, rate := range config.Routing.ChannelFailureRates {
+		if rate.ChainId == "" || rate.ChannelId == "" {
+			return fmt.Errorf("routing channel failure rates require chain_id and channel_id")
+		}
+		if rate.FailureRate < 0 || rate.FailureRate > 1 {
+			return fmt.Errorf("routing channel failure rate for %s/%s must be between 0 and 1",
+				rate.ChainId, rate.ChannelId)
+		}
+	}
+
+	if err := verifyPrices(config.Prices); err != nil {
+		return err
+	}
+
+	if err := verifyGuards(config.Guards); err != nil {
+		return err
+	}
+
+	if err := verifyIBC(config.IBC); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func verifyIBC(ibc IBCConfig) error {
+	if ibc.Timeout < 0 || ibc.Retries < 0 {
+		return fmt.Errorf("ibc timeout and retries must not be negative")
+	}
+	chainIds := make(map[string]bool, len(ibc.Chains))
+	for _, chain := range ibc.Chains {
+		if chain.ChainId == "" {
+			return fmt.Errorf("ibc chains require chain_id")
+		}
+		if chainIds[chain.ChainId] {
+			return fmt.Errorf("duplicate ibc settings for chain %s", chain.ChainId)
+		}
+		chainIds[chain.ChainId] = true
+		if chain.Timeout < 0 || chain.Retries < 0 {
+			return fmt.Errorf("ibc timeout and retries of chain %s must not be negative", chain.ChainId)
+		}
+	}
+	return nil
+}
+
+func verifyGuards(guards GuardsConfig) error {
+	if guards.MaxPriceImpact < 0 || guards.MaxPriceImpact > 1 {
+		return fmt.Errorf("guards max_price_impact must be a fraction between 0 and 1")
+	}
+	if guards.MinLiquidityUSD < 0 {
+		return fmt.Errorf("guards min_liquidity_usd must not be negative")
+	}
+	if guards.MaxHops < 0 {
+		return fmt.Errorf("guards max_hops must not be negative")
+	}
+	return nil
+}
+
+func verifyPrices(pricesConfig PricesConfig) error {
+	for _, provider := range pricesConfig.Providers {
+		if provider != "sqs" && provider != "coingecko" {
+			return fmt.Errorf("unknown price provider %q, use \"sqs\" or \"coingecko\"", provider)
+		}
+	}
+	if pricesConfig.CacheTTL < 0 {
+		return fmt.Errorf("prices cache_ttl must not be negative")
+	}
+	return nil
+}
+
+func verifyBrokers(brokerConfigs []BrokerConfig) error {
+	ids := make(map[string]bool, len(brokerConfigs))
+	for _, broker := range brokerConfigs {
+		if broker.Id == "" || broker.Type == "" {
+			return fmt.Errorf("brokers require id and type")
+		}
+		if ids[broker.Id] {
+			return fmt.Errorf("duplicate broker id %s", broker.Id)
+		}
+		ids[broker.Id] = true
+
+		if len(broker.Endpoints) == 0 {
+			return fmt.Errorf("broker %s requires at least one endpoint", broker.Id)
+		}
+		for _, url := range broker.Endpoints {
+			if url == "" {
+				return fmt.Errorf("broker %s endpoints must not be empty", broker.Id)
+			}
+		}
+
+		if broker.MaxRetries < 0 || broker.RetryDelay < 0 || broker.Timeout < 0 || broker.HealthCheckInterval < 0 {
+			return fmt.Errorf("broker %s failover settings must not be negative", broker.Id)
+		}
+
+		if broker.CacheTTL < 0 || broker.CacheAmountDigits < 0 {
+			return fmt.Errorf("broker %s cache settings must not be negative", broker.Id)
+		}
+	}
 	return nil
 }
@@ -5,6 +5,7 @@ import (
 	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 
 	. "github.com/Cogwheel-Validator/spectra-portal/pathfinder/config"
 )
@@ -133,3 +134,334 @@ sqs_urls = ["https://b.com/q"]
 		t.Errorf("expected file values to be used, got: %+v", cfg)
 	}
 }
+
+func TestLoadRPCPathfinderConfig_Routing(t *testing.T) {
+	unsetPathfinderEnv()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+sqs_urls = ["https://sqs.example.com/q1"]
+
+[routing]
+hop_cost = 2.0
+
+[[routing.channel_penalties]]
+chain_id = "osmosis-1"
+channel_id = "channel-0"
+penalty = 3.5
+
+[[routing.channel_failure_rates]]
+chain_id = "osmosis-1"
+channel_id = "channel-1"
+failure_rate = 0.25
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	cfg, err := LoadRPCPathfinderConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cfg.Routing.HopCost != 2.0 {
+		t.Errorf("unexpected hop cost: %v", cfg.Routing.HopCost)
+	}
+	// not set in the file, must fall back to the router default
+	if cfg.Routing.NonPFMPenalty != 0.5 || cfg.Routing.FailureRateWeight != 10 {
+		t.Errorf("expected default routing weights, got %+v", cfg.Routing)
+	}
+
+	penalties := cfg.Routing.ChannelPenalties
+	if len(penalties) != 1 || penalties[0].ChainId != "osmosis-1" || penalties[0].Penalty != 3.5 {
+		t.Errorf("unexpected channel penalties: %+v", penalties)
+	}
+
+	rates := cfg.Routing.ChannelFailureRates
+	if len(rates) != 1 || rates[0].ChannelId != "channel-1" || rates[0].FailureRate != 0.25 {
+		t.Errorf("unexpected channel failure rates: %+v", rates)
+	}
+}
+
+func TestLoadRPCPathfinderConfig_Routing_NegativePenalty(t *testing.T) {
+	unsetPathfinderEnv()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+sqs_urls = ["https://sqs.example.com/q1"]
+
+[[routing.channel_penalties]]
+chain_id = "osmosis-1"
+channel_id = "channel-0"
+penalty = -1
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	if _, err := LoadRPCPathfinderConfig(&path); err == nil {
+		t.Fatalf("expected error for negative channel penalty")
+	}
+}
+
+func TestLoadRPCPathfinderConfig_Brokers(t *testing.T) {
+	unsetPathfinderEnv()
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q1", "https://sqs.example.com/q2"]
+max_retries = 3
+retry_delay = "250ms"
+timeout = "5s"
+cache_ttl = "2s"
+cache_amount_digits = 3
+
+[[brokers]]
+id = "neutron-astroport"
+type = "astroport"
+endpoints = ["https://lcd.example.com"]
+disable_cache = true
+[brokers.options]
+factory_address = "neutron1factory"
+hop_denoms = "untrn, ibc/USDC"
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	cfg, err := LoadRPCPathfinderConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(cfg.Brokers) != 2 {
+		t.Fatalf("expected 2 brokers, got %+v", cfg.Brokers)
+	}
+
+	sqs := cfg.Brokers[0]
+	if sqs.Id != "osmosis-sqs" || len(sqs.Endpoints) != 2 || sqs.MaxRetries != 3 {
+		t.Errorf("unexpected sqs broker: %+v", sqs)
+	}
+	if sqs.RetryDelay != 250*time.Millisecond || sqs.Timeout != 5*time.Second {
+		t.Errorf("unexpected sqs failover durations: %+v", sqs)
+	}
+	if sqs.CacheTTL != 2*time.Second || sqs.CacheAmountDigits != 3 {
+		t.Errorf("unexpected sqs cache settings: %+v", sqs)
+	}
+
+	astroport := cfg.Brokers[1]
+	if astroport.Type != "astroport" || astroport.Options["factory_address"] != "neutron1factory" {
+		t.Errorf("unexpected astroport broker: %+v", astroport)
+	}
+	if astroport.Options["hop_denoms"] != "untrn, ibc/USDC" {
+		t.Errorf("unexpected hop denoms: %v", astroport.Options["hop_denoms"])
+	}
+	if !astroport.DisableCache {
+		t.Errorf("expected astroport cache to be disabled, got %+v", astroport)
+	}
+}
+
+func TestLoadRPCPathfinderConfig_SqsURLsAddLegacyBroker(t *testing.T) {
+	unsetPathfinderEnv()
+	_ = os.Setenv("PATHFINDER_PORT", "8080")
+	_ = os.Setenv("PATHFINDER_HOST", "0.0.0.0")
+	_ = os.Setenv("PATHFINDER_ALLOWED_ORIGINS", "*")
+	_ = os.Setenv("PATHFINDER_SQS_URLS", "https://sqs.example.com/q1")
+	defer unsetPathfinderEnv()
+
+	cfg, err := LoadRPCPathfinderConfig(nil)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(cfg.SqsURLs) != 1 || len(cfg.Brokers) != 0 {
+		t.Errorf("expected only the legacy sqs urls, got %v and %+v", cfg.SqsURLs, cfg.Brokers)
+	}
+}
+
+func TestLoadRPCPathfinderConfig_Brokers_Invalid(t *testing.T) {
+	cases := map[string]string{
+		"no brokers": ``,
+		"missing type": `
+[[brokers]]
+id = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q1"]
+`,
+		"no endpoints": `
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+`,
+		"duplicate id": `
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q1"]
+
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q2"]
+`,
+		"negative cache ttl": `
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q1"]
+cache_ttl = "-1s"
+`,
+	}
+
+	for name, brokers := range cases {
+		t.Run(name, func(t *testing.T) {
+			unsetPathfinderEnv()
+
+			path := filepath.Join(t.TempDir(), "rpc_config.toml")
+			content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+` + brokers
+			if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+				t.Fatalf("failed writing temp config: %v", err)
+			}
+
+			if _, err := LoadRPCPathfinderConfig(&path); err == nil {
+				t.Fatalf("expected error for %s", name)
+			}
+		})
+	}
+}
+
+func TestLoadRPCPathfinderConfig_Prices(t *testing.T) {
+	unsetPathfinderEnv()
+
+	path := filepath.Join(t.TempDir(), "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+
+[[brokers]]
+id = "osmosis-sqs"
+type = "osmosis-sqs"
+endpoints = ["https://sqs.example.com/q1"]
+
+[prices]
+providers = ["sqs", "coingecko"]
+coingecko_api_key = "key"
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	cfg, err := LoadRPCPathfinderConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+
+	if len(cfg.Prices.Providers) != 2 || cfg.Prices.CoinGeckoAPIKey != "key" {
+		t.Errorf("unexpected price config: %+v", cfg.Prices)
+	}
+
+	if err := os.WriteFile(path, []byte(strings.Replace(content, `"sqs", "coingecko"`, `"binance"`, 1)), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+	if _, err := LoadRPCPathfinderConfig(&path); err == nil {
+		t.Fatalf("expected error for an unknown price provider")
+	}
+}
+
+func TestLoadRPCPathfinderConfig_Guards(t *testing.T) {
+	unsetPathfinderEnv()
+
+	path := filepath.Join(t.TempDir(), "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+sqs_urls = ["https://sqs.example.com/q1"]
+
+[guards]
+max_price_impact = 0.05
+min_liquidity_usd = 10000
+max_hops = 4
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	cfg, err := LoadRPCPathfinderConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	guards := cfg.Guards
+	if guards.MaxPriceImpact != 0.05 || guards.MinLiquidityUSD != 10000 || guards.MaxHops != 4 {
+		t.Errorf("unexpected route guards: %+v", guards)
+	}
+
+	// The price impact is a fraction, 5 is not 5%
+	if err := os.WriteFile(path, []byte(strings.Replace(content, "0.05", "5", 1)), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+	if _, err := LoadRPCPathfinderConfig(&path); err == nil {
+		t.Fatalf("expected error for a price impact above 1")
+	}
+}
+
+func TestLoadRPCPathfinderConfig_IBC(t *testing.T) {
+	unsetPathfinderEnv()
+
+	path := filepath.Join(t.TempDir(), "rpc_config.toml")
+	content := `
+port = 9090
+host = "127.0.0.1"
+allowed_origins = ["https://example.com"]
+sqs_urls = ["https://sqs.example.com/q1"]
+
+[ibc]
+timeout = "5m"
+retries = 1
+
+[[ibc.chains]]
+chain_id = "evmos_9001-2"
+timeout = "30m"
+retries = 4
+`
+	if err := os.WriteFile(path, []byte(content), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+
+	cfg, err := LoadRPCPathfinderConfig(&path)
+	if err != nil {
+		t.Fatalf("expected no error, got %v", err)
+	}
+	if cfg.IBC.Timeout != 5*time.Minute || cfg.IBC.Retries != 1 {
+		t.Errorf("unexpected ibc timeouts: %+v", cfg.IBC)
+	}
+	if len(cfg.IBC.Chains) != 1 || cfg.IBC.Chains[0].Timeout != 30*time.Minute || cfg.IBC.Chains[0].Retries != 4 {
+		t.Errorf("unexpected chain ibc timeouts: %+v", cfg.IBC.Chains)
+	}
+
+	if err := os.WriteFile(path, []byte(strings.Replace(content, `chain_id = "evmos_9001-2"`, "", 1)), 0o600); err != nil {
+		t.Fatalf("failed writing temp config: %v", err)
+	}
+	if _, err := LoadRPCPathfinderConfig(&path); err == nil {
+		t.Fatalf("expected error for ibc chain settings without chain_id")
+	}
+}
@@ -1,5 +1,7 @@
 package config
 
+import "time"
+
 type RPCPathfinderConfig struct {
 	// rpc configs
 	Port int    `toml:"port" mapstructure:"port"`
@@ -12,6 +14,7 @@ type RPCPathfinderConfig struct {
 	// rate limiting configs
 	RatePerMinute         int `toml:"rate_per_minute" mapstructure:"rate_per_minute"`
 	MaxConcurrentRequests int `toml:"max_concurrent_requests" mapstructure:"max_concurrent_requests"`
+	MaxConcurrentStreams  int `toml:"max_concurrent_streams" mapstructure:"max_concurrent_streams"`
 
 	// OpenTelemetry configs
 	ServiceName    string `toml:"service_name" mapstructure:"service_name"`
@@ -33,6 +36,126 @@ type RPCPathfinderConfig struct {
 	// Development mode uses stdout exporters
 	DevelopmentMode bool `toml:"development_mode" mapstructure:"development_mode"`
 
-	// Osmosis SQS config
+	// Osmosis SQS config, kept for env only setups, prefer [[brokers]]
 	SqsURLs []string `toml:"sqs_urls" mapstructure:"sqs_urls"`
+
+	// Broker client configs
+	Brokers []BrokerConfig `toml:"brokers" mapstructure:"brokers"`
+
+	// Route search configs
+	Routing RoutingConfig `toml:"routing" mapstructure:"routing"`
+
+	// USD price configs
+	Prices PricesConfig `toml:"prices" mapstructure:"prices"`
+
+	// Route safety configs
+	Guards GuardsConfig `toml:"guards" mapstructure:"guards"`
+
+	// IBC transfer configs
+	IBC IBCConfig `toml:"ibc" mapstructure:"ibc"`
+}
+
+// IBCConfig holds the timeouts and PFM retries of the IBC transfers of a route.
+// Requests can override them with their own.
+type IBCConfig struct {
+	// Time a transfer has to be received, 10m when unset
+	Timeout time.Duration `toml:"timeout" mapstructure:"timeout"`
+	// Times PFM retries a forwarded transfer that failed, 2 when unset
+	Retries int `toml:"retries" mapstructure:"retries"`
+	// Settings of slow or unreliable chains
+	Chains []ChainIBCConfig `toml:"chains" mapstructure:"chains"`
+}
+
+// ChainIBCConfig raises the timeout and retries of every transfer from or to the chain
+type ChainIBCConfig struct {
+	ChainId string        `toml:"chain_id" mapstructure:"chain_id"`
+	Timeout time.Duration `toml:"timeout" mapstructure:"timeout"`
+	Retries int           `toml:"retries" mapstructure:"retries"`
+}
+
+// GuardsConfig holds the limits routes are rejected at, unset limits are not checked.
+// Requests can override every limit with their own.
+type GuardsConfig struct {
+	// Largest accepted price impact of a swap as a fraction, e.g. 0.05 for 5%
+	MaxPriceImpact float64 `toml:"max_price_impact" mapstructure:"max_price_impact"`
+	// Smallest accepted USD liquidity of a pool a swap trades through
+	MinLiquidityUSD float64 `toml:"min_liquidity_usd" mapstructure:"min_liquidity_usd"`
+	// Largest accepted number of IBC transfers in a route
+	MaxHops int `toml:"max_hops" mapstructure:"max_hops"`
+}
+
+// PricesConfig configures the USD valuation of routes, it is off unless providers are set
+type PricesConfig struct {
+	// Price providers in the order they are asked, "sqs" and "coingecko"
+	Providers []string `toml:"providers" mapstructure:"providers"`
+	// How long a price is reused, 1m when unset
+	CacheTTL time.Duration `toml:"cache_ttl" mapstructure:"cache_ttl"`
+
+	// SQS endpoints, default to the endpoints of the osmosis-sqs broker
+	SqsURLs []string `toml:"sqs_urls" mapstructure:"sqs_urls"`
+	// Chain the SQS denoms belong to, "osmosis-1" when unset
+	SqsChainId string `toml:"sqs_chain_id" mapstructure:"sqs_chain_id"`
+
+	// CoinGecko API, the public API when unset
+	CoinGeckoURL    string `toml:"coingecko_url" mapstructure:"coingecko_url"`
+	CoinGeckoAPIKey string `toml:"coingecko_api_key" mapstructure:"coingecko_api_key"`
+}
+
+// RoutingConfig holds the weights of the weighted route search
+type RoutingConfig struct {
+	// Base cost of every IBC hop
+	HopCost float64 `toml:"hop_cost" mapstructure:"hop_cost"`
+	// Added when a token has to be forwarded from a chain without PFM
+	NonPFMPenalty float64 `toml:"non_pfm_penalty" mapstructure:"non_pfm_penalty"`
+	// Multiplied by the observed failure rate (0-1) of a channel
+	FailureRateWeight float64 `toml:"failure_rate_weight" mapstructure:"failure_rate_weight"`
+	// Fixed penalties for specific channels
+	ChannelPenalties []ChannelPenalty `toml:"channel_penalties" mapstructure:"channel_penalties"`
+	// Failure rates observed on specific channels, e.g. from relayer metrics
+	ChannelFailureRates []ChannelFailureRate `toml:"channel_failure_rates" mapstructure:"channel_failure_rates"`
+}
+
+// ChannelPenalty is an extra cost added to every route that uses the channel
+type ChannelPenalty struct {
+	ChainId   string  `toml:"chain_id" mapstructure:"chain_id"`
+	ChannelId string  `toml:"channel_id" mapstructure:"channel_id"`
+	Penalty   float64 `toml:"penalty" mapstructure:"penalty"`
+}
+
+// ChannelFailureRate is the share (0-1) of transfers over the channel that fail or time out
+type ChannelFailureRate struct {
+	ChainId     string  `toml:"chain_id" mapstructure:"chain_id"`
+	ChannelId   string  `toml:"channel_id" mapstructure:"channel_id"`
+	FailureRate float64 `toml:"failure_rate" mapstructure:"failure_rate"`
+}
+
+// BrokerConfig configures a single broker client
+type BrokerConfig struct {
+	// Broker id, must match the broker_id of the broker chain in the chain config
+	Id string `toml:"id" mapstructure:"id"`
+	// Registered broker implementation, e.g. "osmosis-sqs" or "astroport"
+	Type string `toml:"type" mapstructure:"type"`
+	// API or LCD URLs, the first one is the primary
+	Endpoints []string `toml:"endpoints" mapstructure:"endpoints"`
+	// ibc-hooks entry point contract, defaults to the ibc_hooks_contract of the broker chain
+	ContractAddress string `toml:"contract_address" mapstructure:"contract_address"`
+
+	// Failover settings, unset values use the broker defaults
+	MaxRetries          int           `toml:"max_retries" mapstructure:"max_retries"`
+	RetryDelay          time.Duration `toml:"retry_delay" mapstructure:"retry_delay"`
+	Timeout             time.Duration `toml:"timeout" mapstructure:"timeout"`
+	HealthCheckInterval time.Duration `toml:"health_check_interval" mapstructure:"health_check_interval"`
+
+	// Quote cache, quotes are reused for cache_ttl (5s when unset).
+	// Amounts are bucketed by their first cache_amount_digits digits, 0 caches exact amounts only.
+	CacheTTL          time.Duration `toml:"cache_ttl" mapstructure:"cache_ttl"`
+	CacheAmountDigits int           `toml:"cache_amount_digits" mapstructure:"cache_amount_digits"`
+	DisableCache      bool          `toml:"disable_cache" mapstructure:"disable_cache"`
+
+	// Swaps the broker reports no pool liquidity for pass guards.min_liquidity_usd with a warning
+	// instead of being rejected
+	AcceptUnknownLiquidity bool `toml:"accept_unknown_liquidity" mapstructure:"accept_unknown_liquidity"`
+
+	// Implementation specific settings
+	Options map[string]string `toml:"options" mapstructure:"options"`
 }
@@ -7,6 +7,7 @@ type RouteRequest struct {
 	ChainFrom       string // e.g., "juno"
 	TokenFromDenom  string // e.g., "ujuno"
 	AmountIn        string // e.g., "1000000"
+	AmountOut       string // Exact-out mode if set: receiver gets exactly this, AmountIn is computed
 	ChainTo         string // e.g., "cosmoshub"
 	TokenToDenom    string // e.g., "uatom"
 	SenderAddress   string // For validation
@@ -15,6 +16,18 @@ type RouteRequest struct {
 	// If false the route will query the data with the single route off and provide the best trade route.
 	SmartRoute  *bool
 	SlippageBps *uint32
+	// Route guards, tighten the server-level guards when set
+	MaxPriceImpact  *float64 // Largest accepted price impact of the swap as a fraction (e.g., 0.05 for 5%)
+	MinLiquidityUSD *float64 // Smallest accepted USD liquidity of a pool the swap trades through
+	MaxHops         *uint32  // Largest accepted number of IBC transfers
+	// IBC settings, override the server-level and per-chain settings when set
+	IBCTimeoutSeconds *uint32 // Time every IBC transfer of the route has to be received
+	PFMRetries        *uint32 // Times PFM retries a forwarded transfer that failed
+}
+
+// IsExactOut reports whether the request asks for an exact output amount
+func (r RouteRequest) IsExactOut() bool {
+	return r.AmountOut != ""
 }
 
 // TokenMapping represents how a token transforms between chains
@@ -33,6 +46,52 @@ type IBCLeg struct {
 	Port      string        `json:"port"`          // Source port (usually "transfer")
 	Token     *TokenMapping `json:"token_mapping"` // Token info on source chain
 	Amount    string        `json:"amount"`        // Amount to transfer
+	// Fee of the transaction the sender signs for this leg, nil if the leg is forwarded
+	Fee *FeeEstimate `json:"fee,omitempty"`
+}
+
+// FeeEstimate is the estimated gas and fee of a transaction the sender signs
+type FeeEstimate struct {
+	ChainID  string `json:"chain_id"`  // Chain the transaction is signed on
+	Gas      uint64 `json:"gas"`       // Estimated gas limit
+	Denom    string `json:"denom"`     // Fee denom, empty if the chain has no fee currency configured
+	Amount   string `json:"amount"`    // Fee at the average gas price
+	GasPrice string `json:"gas_price"` // Average gas price of the fee denom
+}
+
+// RouteFees is what executing a route costs the sender on top of the transferred amount
+type RouteFees struct {
+	Total []*FeeEstimate `json:"total"`           // Transaction fees summed up per chain and denom
+	Notes []string       `json:"notes,omitempty"` // Costs that are not part of the total, e.g. relayer and PFM fees
+}
+
+// RouteValuation contains the USD values of a route, amounts without a price are left empty
+type RouteValuation struct {
+	AmountInUSD      string   `json:"amount_in_usd,omitempty"`      // Value of the amount the sender spends
+	AmountOutUSD     string   `json:"amount_out_usd,omitempty"`     // Value of the amount the receiver gets
+	FeesUSD          string   `json:"fees_usd,omitempty"`           // Value of the transaction fees in the fee total
+	ValueLossPercent string   `json:"value_loss_percent,omitempty"` // Share of the input and fees that doesn't reach the receiver
+	Notes            []string `json:"notes,omitempty"`              // Tokens that could not be priced
+}
+
+// Codes of the route guards, routes breaking a guard or that a guard could not be checked for fail with an error code
+// and routes that came close to a guard are reported with a warning
+const (
+	ErrorCodePriceImpactTooHigh   = "PRICE_IMPACT_TOO_HIGH"
+	ErrorCodeLiquidityTooLow      = "LIQUIDITY_TOO_LOW"
+	ErrorCodeTooManyHops          = "TOO_MANY_HOPS"
+	ErrorCodePriceImpactUnknown   = "PRICE_IMPACT_UNKNOWN" // Broker did not report the price impact
+	ErrorCodeLiquidityUnknown     = "LIQUIDITY_UNKNOWN"    // Broker did not report the pool liquidity
+	ErrorCodeNonCanonicalDenom    = "NON_CANONICAL_DENOM"  // Every path delivers the token with another trace than requested
+	WarningCodeHighPriceImpact    = "HIGH_PRICE_IMPACT"    // Price impact is above half the limit
+	WarningCodeNonCanonicalDenom  = "NON_CANONICAL_DENOM"  // The requested token is not the one that came the shortest way from its origin
+	WarningCodeLiquidityUnchecked = "LIQUIDITY_UNCHECKED"  // Broker did not report the pool liquidity and accepts unknown liquidity
+)
+
+// RouteWarning is a problem with a route that did not stop it from being returned
+type RouteWarning struct {
+	Code    string `json:"code"`    // Machine-readable code, e.g. "HIGH_PRICE_IMPACT"
+	Message string `json:"message"` // Human-readable description
 }
 
 // DirectRoute represents a simple IBC transfer
@@ -49,6 +108,8 @@ type SwapQuote struct {
 	AmountOut    string        `json:"amount_out"`        // Estimated output amount
 	PriceImpact  string        `json:"price_impact"`      // Price impact (e.g., "0.02" for 2%)
 	EffectiveFee string        `json:"effective_fee"`     // Total fees
+	TakerFee     string        `json:"taker_fee"`         // Taker fees charged on top of the quote as a fraction, "0" if none
+	NetAmountOut string        `json:"net_amount_out"`    // Amount delivered to the receiver after taker and PFM fees
 	RouteData    any           `json:"route_data"`        // Broker-specific route data (pools, hops, etc.)
 }
 
@@ -86,6 +147,9 @@ type BrokerRoute struct {
 
 	// Execution data - ready-to-use for transaction building
 	Execution *BrokerExecutionData `json:"execution,omitempty"`
+
+	// Quotes from the other brokers that can do this swap, best first
+	AlternativeQuotes []*SwapQuote `json:"alternative_quotes,omitempty"`
 }
 
 // BrokerExecutionData contains ready-to-use transaction data for broker swap routes
@@ -106,23 +170,134 @@ type BrokerExecutionData struct {
 	RecoverAddress *string `json:"recover_address"`
 
 	// Minimum output amount after slippage (default 1% slippage)
+	// For exact-out routes this is the exact amount the receiver gets
 	MinOutputAmount string `json:"min_output_amount"`
 
+	// Minimum amount delivered to the receiver, MinOutputAmount after the PFM fees of the outbound hops
+	MinReceivedAmount string `json:"min_received_amount"`
+
+	// Maximum input amount after slippage, only set for exact-out routes.
+	// This is the amount to send, the input not used by the swap is refunded to RecoverAddress
+	MaxInputAmount string `json:"max_input_amount,omitempty"`
+
 	// Whether this uses wasm ibc-hooks (vs simple PFM)
 	UsesWasm bool `json:"uses_wasm"`
 
 	// Human-readable description of the execution
 	Description string `json:"description"`
+
+	// Fee of the transaction that starts the execution
+	Fee *FeeEstimate `json:"fee,omitempty"`
 }
 
 // RouteResponse - unified response for all route types (informative, not prescriptive)
 type RouteResponse struct {
-	Success      bool           `json:"success"`
-	RouteType    string         `json:"route_type"` // "direct" | "indirect" | "broker_swap" | "impossible"
-	ErrorMessage string         `json:"error_message,omitempty"`
-	Direct       *DirectRoute   `json:"direct_route,omitempty"`
-	Indirect     *IndirectRoute `json:"indirect_route,omitempty"`
-	BrokerSwap   *BrokerRoute   `json:"broker_swap,omitempty"`
+	Success      bool            `json:"success"`
+	RouteType    string          `json:"route_type"` // "direct" | "indirect" | "broker_swap" | "impossible"
+	ErrorMessage string          `json:"error_message,omitempty"`
+	ErrorCode    string          `json:"error_code,omitempty"` // Set if a route guard rejected the route, e.g. "PRICE_IMPACT_TOO_HIGH"
+	Direct       *DirectRoute    `json:"direct_route,omitempty"`
+	Indirect     *IndirectRoute  `json:"indirect_route,omitempty"`
+	BrokerSwap   *BrokerRoute    `json:"broker_swap,omitempty"`
+	Fees         *RouteFees      `json:"fees,omitempty"`      // Estimated cost of executing the route
+	Valuation    *RouteValuation `json:"valuation,omitempty"` // USD values of the route, if prices are configured
+	Warnings     []RouteWarning  `json:"warnings,omitempty"`  // Route guards the route came close to or that could not be checked
+}
+
+// RankedRoute is a single evaluated route candidate with the metrics used to rank it
+type RankedRoute struct {
+	Route          RouteResponse `json:"route"`
+	Rank           int           `json:"rank"`            // 1 is the best scored route
+	Score          float64       `json:"score"`           // Higher is better
+	ExpectedOutput string        `json:"expected_output"` // Amount of the requested token delivered to the receiver
+	HopCount       int           `json:"hop_count"`       // Number of IBC transfers in the route
+	EffectiveFee   string        `json:"effective_fee"`   // Swap fee as a fraction (e.g., "0.003"), "0" if no swap
+	IsBestPrice    bool          `json:"is_best_price"`   // True if this route delivers the highest output
+	IsFastest      bool          `json:"is_fastest"`      // True if this route has the fewest hops
+}
+
+// RankedRoutesResponse - response for FindPaths, every viable route ordered by score
+type RankedRoutesResponse struct {
+	Success      bool          `json:"success"`
+	ErrorMessage string        `json:"error_message,omitempty"`
+	Routes       []RankedRoute `json:"routes"`
+}
+
+// ConsolidationRequest - request to plan moving balances on several chains into one token on one chain
+type ConsolidationRequest struct {
+	Balances        []Balance // Balances to move, the denoms are chain denoms
+	ChainTo         string    // Chain the balances are moved to
+	TokenToDenom    string    // Token the balances end up as on ChainTo
+	SenderAddress   string    // Address of the owner on any chain, the senders on the source chains are derived from it
+	ReceiverAddress string    // Receiver on ChainTo
+	SlippageBps     *uint32
+}
+
+// Balance is an amount of a token on a chain
+type Balance struct {
+	ChainID string `json:"chain_id"`
+	Denom   string `json:"denom"`
+	Amount  string `json:"amount"` // In base units
+}
+
+// ConsolidationPlan - the routes of a consolidation and the transactions that execute them
+type ConsolidationPlan struct {
+	Routes       []ConsolidationRoute `json:"routes"`       // A route per balance, in the order of the balances
+	Transactions []PlannedTransaction `json:"transactions"` // In the order they have to be broadcast
+}
+
+// ConsolidationRoute is the route of one balance of a consolidation.
+// Route is nil if the balance already is the target token or could not be routed, Error tells them apart.
+type ConsolidationRoute struct {
+	Balance Balance        `json:"balance"`
+	Route   *RouteResponse `json:"route,omitempty"`
+	Error   string         `json:"error,omitempty"`
+}
+
+// PlannedTransaction is one transaction of a consolidation, it sends the transfers of several routes at once
+type PlannedTransaction struct {
+	// Transactions of a step can be broadcast together, once the transfers of the step before were received
+	Step    int    `json:"step"`
+	ChainID string `json:"chain_id"`
+	Signer  string `json:"signer"`
+	Routes  []int  `json:"routes"` // Indexes of the routes whose transfer this transaction sends
+	// Indexes of the transactions whose transfers have to be received before this one is broadcast,
+	// they send the legs before the later legs of indirect routes without PFM
+	DependsOn []int `json:"depends_on,omitempty"`
+}
+
+// TransactionRequest - request to build the unsigned transactions that execute a route
+type TransactionRequest struct {
+	Route           RouteResponse // Route found by FindPath or FindPaths
+	SenderAddress   string        // Signer on the source chain, signers on other chains are derived from it
+	ReceiverAddress string        // Receiver of direct and indirect routes, broker swaps carry it in the execution data
+	Memo            string        // Transaction memo, not the IBC memo
+	// IBC timeout of the transfers in unix nanoseconds.
+	// If neither the timestamp nor the height is set the transfers time out in 15 minutes.
+	TimeoutTimestamp uint64
+	TimeoutHeight    *IBCHeight
+}
+
+// IBCHeight is the height of a chain as seen by IBC light clients
+type IBCHeight struct {
+	RevisionNumber uint64 `json:"revision_number"`
+	RevisionHeight uint64 `json:"revision_height"`
+}
+
+// UnsignedMessage is a Cosmos SDK message ready to be signed
+type UnsignedMessage struct {
+	TypeURL   string `json:"type_url"`   // e.g. "/ibc.applications.transfer.v1.MsgTransfer"
+	Value     []byte `json:"value"`      // Protobuf encoded message, the value of its google.protobuf.Any
+	AminoJSON string `json:"amino_json"` // The message in a legacy Amino JSON sign doc
+}
+
+// UnsignedTransaction contains the messages one signer broadcasts on one chain
+type UnsignedTransaction struct {
+	ChainID   string            `json:"chain_id"`
+	Signer    string            `json:"signer"`
+	Messages  []UnsignedMessage `json:"messages"`
+	Memo      string            `json:"memo"`
+	BodyBytes []byte            `json:"body_bytes"` // Encoded TxBody, the body_bytes of a SIGN_MODE_DIRECT sign doc
 }
 
 // DenomLookupRequest - request to lookup denom information
@@ -165,3 +340,20 @@ type TokenDetails struct {
 	Decimals    int    `json:"decimals"`     // Number of decimals
 	IsNative    bool   `json:"is_native"`    // True if native to this chain
 }
+
+// TokenPriceQuery - a token to price, the denom can be human-readable like in a route request
+type TokenPriceQuery struct {
+	ChainID string `json:"chain_id"`
+	Denom   string `json:"denom"`
+}
+
+// TokenPrice is the USD price of one whole token
+type TokenPrice struct {
+	ChainID  string `json:"chain_id"`
+	Denom    string `json:"denom"`               // Resolved denom on the chain
+	Symbol   string `json:"symbol,omitempty"`    // Human-readable symbol (e.g., "ATONE", "OSMO")
+	Decimals int    `json:"decimals"`            // Number of decimals of the base unit
+	PriceUSD string `json:"price_usd,omitempty"` // Empty if no provider has a price
+	Source   string `json:"source,omitempty"`    // Provider the price came from
+	Error    string `json:"error,omitempty"`     // Why the token has no price
+}
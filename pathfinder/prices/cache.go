@@ -0,0 +1,123 @@
+package prices
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultCacheTTL is how long a price is reused when the config doesn't set cache_ttl
+	DefaultCacheTTL = time.Minute
+
+	// cacheSweepSize is the number of entries after which expired prices are swept on insert
+	cacheSweepSize = 1024
+
+	meterName = "github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
+)
+
+// CachedProvider is a Provider decorator that reuses prices for its TTL.
+// Concurrent lookups of the same token are sent to the provider once and share the price.
+// Failed lookups are never cached.
+type CachedProvider struct {
+	Provider
+
+	ttl   time.Duration
+	group singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+}
+
+type cacheEntry struct {
+	price     Price
+	expiresAt time.Time
+}
+
+// Ensure CachedProvider implements Provider
+var _ Provider = (*CachedProvider)(nil)
+
+// NewCachedProvider wraps provider with a price cache.
+// Hit and miss counters are reported through the global OTel meter provider.
+func NewCachedProvider(provider Provider, ttl time.Duration) *CachedProvider {
+	meter := otel.GetMeterProvider().Meter(meterName)
+	// The instruments fall back to no-ops if they can't be created
+	hits, _ := meter.Int64Counter("pathfinder.price_cache.hits",
+		metric.WithDescription("Token prices served from the cache"))
+	misses, _ := meter.Int64Counter("pathfinder.price_cache.misses",
+		metric.WithDescription("Token prices that had to be queried"))
+
+	return &CachedProvider{
+		Provider: provider,
+		ttl:      ttl,
+		entries:  make(map[string]cacheEntry),
+		hits:     hits,
+		misses:   misses,
+	}
+}
+
+// Price returns the cached price of token or looks it up with the wrapped provider.
+// A caller whose context is done stops waiting, the shared lookup runs with the context of the first caller.
+func (c *CachedProvider) Price(ctx context.Context, token Token) (Price, error) {
+	key := token.Key()
+	if price, ok := c.lookup(key); ok {
+		c.hits.Add(ctx, 1)
+		return price, nil
+	}
+	c.misses.Add(ctx, 1)
+
+	select {
+	case <-ctx.Done():
+		return Price{}, ctx.Err()
+	case shared := <-c.group.DoChan(key, func() (interface{}, error) {
+		price, err := c.Provider.Price(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, price)
+		return price, nil
+	}):
+		if shared.Err != nil {
+			return Price{}, shared.Err
+		}
+		return shared.Val.(Price), nil
+	}
+}
+
+func (c *CachedProvider) lookup(key string) (Price, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	entry, ok := c.entries[key]
+	if !ok {
+		return Price{}, false
+	}
+	if time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		return Price{}, false
+	}
+	return entry.price, true
+}
+
+func (c *CachedProvider) store(key string, price Price) {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= cacheSweepSize {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = cacheEntry{price: price, expiresAt: now.Add(c.ttl)}
+}
@@ -0,0 +1,101 @@
+package prices
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+const (
+	// CoinGeckoProviderName is the name of the CoinGecko price provider
+	CoinGeckoProviderName = "coingecko"
+
+	// DefaultCoinGeckoURL is the public CoinGecko API
+	DefaultCoinGeckoURL = "https://api.coingecko.com/api/v3"
+
+	// coinGeckoTimeout is the HTTP request timeout of the CoinGecko provider
+	coinGeckoTimeout = 10 * time.Second
+)
+
+// CoinGeckoProvider prices tokens with the CoinGecko simple price API, only tokens with a CoinGecko id have a price
+type CoinGeckoProvider struct {
+	httpClient *http.Client
+	baseURL    string
+	apiKey     string
+}
+
+// Ensure CoinGeckoProvider implements Provider
+var _ Provider = (*CoinGeckoProvider)(nil)
+
+// NewCoinGeckoProvider creates a CoinGecko provider for the API at baseURL.
+// The API key is sent as a demo API key and can be empty.
+func NewCoinGeckoProvider(baseURL, apiKey string) *CoinGeckoProvider {
+	return &CoinGeckoProvider{
+		httpClient: &http.Client{
+			Timeout:   coinGeckoTimeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+		baseURL: baseURL,
+		apiKey:  apiKey,
+	}
+}
+
+// Name returns CoinGeckoProviderName
+func (p *CoinGeckoProvider) Name() string {
+	return CoinGeckoProviderName
+}
+
+// Price queries the USD price of the token's CoinGecko id
+func (p *CoinGeckoProvider) Price(ctx context.Context, token Token) (Price, error) {
+	if token.CoinGeckoID == "" {
+		return Price{}, fmt.Errorf("%w %s, it has no coingecko id", ErrNoPrice, token.Key())
+	}
+
+	endpoint := fmt.Sprintf("%s/simple/price?ids=%s&vs_currencies=usd", p.baseURL, url.QueryEscape(token.CoinGeckoID))
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint, nil)
+	if err != nil {
+		return Price{}, fmt.Errorf("failed to create coingecko request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+	if p.apiKey != "" {
+		req.Header.Set("x-cg-demo-api-key", p.apiKey)
+	}
+
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return Price{}, fmt.Errorf("coingecko request failed: %w", err)
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return Price{}, fmt.Errorf("failed to read coingecko response: %w", err)
+	}
+	if resp.StatusCode != http.StatusOK {
+		return Price{}, fmt.Errorf("coingecko returned status %d: %s", resp.StatusCode, body)
+	}
+
+	// {"cosmos": {"usd": 4.52}}, unknown ids are left out
+	var prices map[string]map[string]json.Number
+	if err := json.Unmarshal(body, &prices); err != nil {
+		return Price{}, fmt.Errorf("failed to parse coingecko response: %w", err)
+	}
+	usd, ok := prices[token.CoinGeckoID]["usd"]
+	if !ok {
+		return Price{}, fmt.Errorf("%w %s, coingecko doesn't know %s", ErrNoPrice, token.Key(), token.CoinGeckoID)
+	}
+	price, err := decimal.NewFromString(usd.String())
+	if err != nil {
+		return Price{}, fmt.Errorf("invalid coingecko price %s: %w", usd, err)
+	}
+	return Price{USD: price, Source: CoinGeckoProviderName}, nil
+}
@@ -0,0 +1,66 @@
+package prices
+
+import (
+	"fmt"
+	"time"
+
+	sqsquery "github.com/Cogwheel-Validator/spectra-portal/pathfinder/sqs_query"
+)
+
+// Config configures the price providers
+type Config struct {
+	// Providers in the order they are asked, SqsProviderName and CoinGeckoProviderName
+	Providers []string
+	// How long a price is reused, zero disables the cache
+	CacheTTL time.Duration
+
+	// SQS API URLs, the first one is the primary
+	SqsURLs []string
+	// Chain whose denoms SQS prices, DefaultSqsChainId when empty
+	SqsChainId string
+
+	// CoinGecko API URL, DefaultCoinGeckoURL when empty
+	CoinGeckoURL    string
+	CoinGeckoAPIKey string
+}
+
+// NewProvider creates the configured providers, asked in order and behind a cache.
+// It returns nil if no provider is configured.
+func NewProvider(cfg Config) (Provider, error) {
+	if len(cfg.Providers) == 0 {
+		return nil, nil
+	}
+
+	providers := make(FallbackProvider, 0, len(cfg.Providers))
+	for _, name := range cfg.Providers {
+		switch name {
+		case SqsProviderName:
+			if len(cfg.SqsURLs) == 0 {
+				return nil, fmt.Errorf("the %s price provider requires sqs urls", name)
+			}
+			chainId := cfg.SqsChainId
+			if chainId == "" {
+				chainId = DefaultSqsChainId
+			}
+			client := sqsquery.NewSqsQueryClientWithFailover(cfg.SqsURLs, sqsquery.DefaultFailoverConfig())
+			providers = append(providers, NewSqsProvider(client, chainId))
+		case CoinGeckoProviderName:
+			baseURL := cfg.CoinGeckoURL
+			if baseURL == "" {
+				baseURL = DefaultCoinGeckoURL
+			}
+			providers = append(providers, NewCoinGeckoProvider(baseURL, cfg.CoinGeckoAPIKey))
+		default:
+			return nil, fmt.Errorf("unknown price provider %q", name)
+		}
+	}
+
+	var provider Provider = providers
+	if len(providers) == 1 {
+		provider = providers[0]
+	}
+	if cfg.CacheTTL > 0 {
+		provider = NewCachedProvider(provider, cfg.CacheTTL)
+	}
+	return provider, nil
+}
@@ -0,0 +1,161 @@
+package prices_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
+)
+
+var atom = prices.Token{
+	BaseDenom:   "uatom",
+	OriginChain: "cosmoshub-4",
+	Symbol:      "ATOM",
+	CoinGeckoID: "cosmos",
+	Denoms: map[string]string{
+		"cosmoshub-4": "uatom",
+		"osmosis-1":   "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	},
+}
+
+// mockProvider returns price for every token, or err if it is set
+type mockProvider struct {
+	name  string
+	price string
+	err   error
+	calls atomic.Int32
+}
+
+func (m *mockProvider) Name() string {
+	return m.name
+}
+
+func (m *mockProvider) Price(ctx context.Context, token prices.Token) (prices.Price, error) {
+	m.calls.Add(1)
+	if m.err != nil {
+		return prices.Price{}, m.err
+	}
+	return prices.Price{USD: decimal.RequireFromString(m.price), Source: m.name}, nil
+}
+
+// mockPriceClient is an SQS client with fixed prices per denom
+type mockPriceClient map[string]string
+
+func (m mockPriceClient) GetTokenPrice(ctx context.Context, tokenDenom string) (decimal.Decimal, error) {
+	price, ok := m[tokenDenom]
+	if !ok {
+		return decimal.Decimal{}, errors.New("token price not found")
+	}
+	return decimal.RequireFromString(price), nil
+}
+
+func TestSqsProvider_Price(t *testing.T) {
+	client := mockPriceClient{atom.Denoms["osmosis-1"]: "4.52"}
+	provider := prices.NewSqsProvider(client, prices.DefaultSqsChainId)
+
+	price, err := provider.Price(t.Context(), atom)
+	assert.NoError(t, err)
+	assert.Equal(t, price.USD.String(), "4.52")
+	assert.Equal(t, price.Source, "sqs")
+
+	// Tokens that are not on Osmosis have no SQS price
+	_, err = provider.Price(t.Context(), prices.Token{BaseDenom: "ujuno", OriginChain: "juno-1", Denoms: map[string]string{"juno-1": "ujuno"}})
+	assert.True(t, errors.Is(err, prices.ErrNoPrice))
+}
+
+func TestCoinGeckoProvider_Price(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, r.URL.Path, "/simple/price")
+		assert.Equal(t, r.URL.Query().Get("vs_currencies"), "usd")
+		assert.Equal(t, r.Header.Get("x-cg-demo-api-key"), "key")
+		if r.URL.Query().Get("ids") == "cosmos" {
+			_, _ = w.Write([]byte(`{"cosmos":{"usd":4.52}}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{}`))
+	}))
+	defer server.Close()
+
+	provider := prices.NewCoinGeckoProvider(server.URL, "key")
+	price, err := provider.Price(t.Context(), atom)
+	assert.NoError(t, err)
+	assert.Equal(t, price.USD.String(), "4.52")
+	assert.Equal(t, price.Source, "coingecko")
+
+	unknown := atom
+	unknown.CoinGeckoID = "unknown"
+	_, err = provider.Price(t.Context(), unknown)
+	assert.True(t, errors.Is(err, prices.ErrNoPrice))
+
+	// Tokens without an id are not queried
+	unknown.CoinGeckoID = ""
+	_, err = provider.Price(t.Context(), unknown)
+	assert.True(t, errors.Is(err, prices.ErrNoPrice))
+}
+
+func TestFallbackProvider_Price(t *testing.T) {
+	missing := &mockProvider{name: "sqs", err: prices.ErrNoPrice}
+	coingecko := &mockProvider{name: "coingecko", price: "4.5"}
+
+	price, err := prices.FallbackProvider{missing, coingecko}.Price(t.Context(), atom)
+	assert.NoError(t, err)
+	assert.Equal(t, price.Source, "coingecko")
+
+	_, err = prices.FallbackProvider{missing}.Price(t.Context(), atom)
+	assert.True(t, errors.Is(err, prices.ErrNoPrice))
+	assert.Equal(t, prices.FallbackProvider{missing, coingecko}.Name(), "sqs,coingecko")
+}
+
+func TestCachedProvider_Price(t *testing.T) {
+	provider := &mockProvider{name: "sqs", price: "4.52"}
+	cached := prices.NewCachedProvider(provider, 50*time.Millisecond)
+
+	for range 3 {
+		price, err := cached.Price(t.Context(), atom)
+		assert.NoError(t, err)
+		assert.Equal(t, price.USD.String(), "4.52")
+	}
+	assert.Equal(t, provider.calls.Load(), int32(1))
+
+	// Expired prices are looked up again
+	time.Sleep(60 * time.Millisecond)
+	_, err := cached.Price(t.Context(), atom)
+	assert.NoError(t, err)
+	assert.Equal(t, provider.calls.Load(), int32(2))
+
+	// Failures are not cached
+	failing := &mockProvider{name: "sqs", err: errors.New("sqs is down")}
+	cached = prices.NewCachedProvider(failing, time.Minute)
+	_, err = cached.Price(t.Context(), atom)
+	assert.Error(t, err)
+	_, err = cached.Price(t.Context(), atom)
+	assert.Error(t, err)
+	assert.Equal(t, failing.calls.Load(), int32(2))
+}
+
+func TestNewProvider(t *testing.T) {
+	provider, err := prices.NewProvider(prices.Config{})
+	assert.NoError(t, err)
+	assert.Nil(t, provider)
+
+	provider, err = prices.NewProvider(prices.Config{
+		Providers: []string{"sqs", "coingecko"},
+		CacheTTL:  time.Minute,
+		SqsURLs:   []string{"https://sqs.example.com"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, provider.Name(), "sqs,coingecko")
+
+	_, err = prices.NewProvider(prices.Config{Providers: []string{"sqs"}})
+	assert.Error(t, err)
+	_, err = prices.NewProvider(prices.Config{Providers: []string{"binance"}})
+	assert.Error(t, err)
+}
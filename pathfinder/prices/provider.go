@@ -0,0 +1,80 @@
+// Package prices provides the USD prices used to value routes.
+// Prices come from a Provider, the SQS and CoinGecko providers are built in
+// and are usually combined with a FallbackProvider behind a CachedProvider.
+package prices
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// ErrNoPrice is returned by a provider that has no price for the token
+var ErrNoPrice = errors.New("no price for the token")
+
+// Token is a token to price, identified by its base denom and origin chain
+type Token struct {
+	BaseDenom   string
+	OriginChain string
+	Symbol      string
+	// CoinGecko API id, empty if the token has none
+	CoinGeckoID string
+	// Denom of the token on every chain it is available on, chainId -> denom
+	Denoms map[string]string
+}
+
+// Key returns the key the token is cached under
+func (t Token) Key() string {
+	return t.BaseDenom + "@" + t.OriginChain
+}
+
+// Price is the USD price of one whole token, that is 10^decimals of its base unit
+type Price struct {
+	USD decimal.Decimal
+	// Name of the provider the price came from
+	Source string
+}
+
+// Provider looks up the USD price of tokens
+type Provider interface {
+	// Name identifies the provider in prices and errors
+	Name() string
+	// Price returns the price of token, an error wrapping ErrNoPrice if the provider doesn't know the token
+	Price(ctx context.Context, token Token) (Price, error)
+}
+
+// FallbackProvider asks its providers in order and returns the first price found
+type FallbackProvider []Provider
+
+// Ensure FallbackProvider implements Provider
+var _ Provider = FallbackProvider(nil)
+
+// Name returns the names of the providers
+func (f FallbackProvider) Name() string {
+	name := ""
+	for i, provider := range f {
+		if i > 0 {
+			name += ","
+		}
+		name += provider.Name()
+	}
+	return name
+}
+
+// Price returns the price of the first provider that has one.
+// If none of them has a price the error of the last provider is returned.
+func (f FallbackProvider) Price(ctx context.Context, token Token) (Price, error) {
+	err := fmt.Errorf("%w %s", ErrNoPrice, token.Key())
+	for _, provider := range f {
+		var price Price
+		if price, err = provider.Price(ctx, token); err == nil {
+			return price, nil
+		}
+		if ctx.Err() != nil {
+			return Price{}, ctx.Err()
+		}
+	}
+	return Price{}, err
+}
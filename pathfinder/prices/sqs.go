@@ -0,0 +1,55 @@
+package prices
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/shopspring/decimal"
+)
+
+// SqsProviderName is the name of the SQS price provider
+const SqsProviderName = "sqs"
+
+// DefaultSqsChainId is the chain the SQS prices are quoted on
+const DefaultSqsChainId = "osmosis-1"
+
+// TokenPriceClient is the part of sqsquery.SqsQueryClient the SQS provider uses
+type TokenPriceClient interface {
+	GetTokenPrice(ctx context.Context, tokenDenom string) (decimal.Decimal, error)
+}
+
+// SqsProvider prices tokens with the Osmosis SQS API, only tokens available on Osmosis have a price
+type SqsProvider struct {
+	client  TokenPriceClient
+	chainId string
+}
+
+// Ensure SqsProvider implements Provider
+var _ Provider = (*SqsProvider)(nil)
+
+// NewSqsProvider creates an SQS provider, chainId is the chain whose denoms SQS knows
+func NewSqsProvider(client TokenPriceClient, chainId string) *SqsProvider {
+	return &SqsProvider{client: client, chainId: chainId}
+}
+
+// Name returns SqsProviderName
+func (p *SqsProvider) Name() string {
+	return SqsProviderName
+}
+
+// Price queries the price of the token's denom on the SQS chain
+func (p *SqsProvider) Price(ctx context.Context, token Token) (Price, error) {
+	denom, ok := token.Denoms[p.chainId]
+	if !ok {
+		return Price{}, fmt.Errorf("%w %s, it is not available on %s", ErrNoPrice, token.Key(), p.chainId)
+	}
+
+	price, err := p.client.GetTokenPrice(ctx, denom)
+	if err != nil {
+		return Price{}, fmt.Errorf("sqs price of %s: %w", denom, err)
+	}
+	if !price.IsPositive() {
+		return Price{}, fmt.Errorf("%w %s, sqs returned %s", ErrNoPrice, token.Key(), price)
+	}
+	return Price{USD: price, Source: SqsProviderName}, nil
+}
@@ -0,0 +1,67 @@
+package router
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+// DefaultBatchWorkers is the number of requests of a batch that are routed at the same time
+const DefaultBatchWorkers = 8
+
+// batchQuoteTTL is how long a quote is shared between the requests of a batch,
+// long enough to cover the whole batch since the shared quotes are dropped with it
+const batchQuoteTTL = time.Minute
+
+/*
+BatchFindPath finds the routes of every request with at most workers requests routed at the same time.
+
+The requests of a batch share their broker quotes: identical swaps are quoted once, also when the
+broker's own quote cache is disabled. Each request gets its own response, in the order of the requests.
+Requests that were not routed yet when ctx is done fail with the context error.
+*/
+func (s *Pathfinder) BatchFindPath(ctx context.Context, reqs []models.RouteRequest, workers int) []models.RouteResponse {
+	if workers <= 0 {
+		workers = DefaultBatchWorkers
+	}
+	batch := s.withSharedQuotes()
+
+	responses := make([]models.RouteResponse, len(reqs))
+	jobs := make(chan int)
+	var wg sync.WaitGroup
+	for range min(workers, len(reqs)) {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				if err := ctx.Err(); err != nil {
+					responses[i] = models.RouteResponse{Success: false, ErrorMessage: err.Error()}
+					continue
+				}
+				responses[i] = batch.FindPath(ctx, reqs[i])
+			}
+		}()
+	}
+
+	for i := range reqs {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+
+	return responses
+}
+
+// withSharedQuotes returns a view of the pathfinder whose broker clients share their quotes
+// for as long as the view is used
+func (s *Pathfinder) withSharedQuotes() *Pathfinder {
+	view := *s
+	view.brokerClients = make(map[string]brokers.BrokerClient, len(s.brokerClients))
+	for brokerId, client := range s.brokerClients {
+		view.brokerClients[brokerId] = brokers.NewCachedClient(client, brokers.CacheConfig{TTL: batchQuoteTTL})
+	}
+	return &view
+}
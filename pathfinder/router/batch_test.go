@@ -0,0 +1,55 @@
+package router_test
+
+import (
+	"context"
+	"sync/atomic"
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+func TestPathfinder_BatchFindPath(t *testing.T) {
+	var queries atomic.Int32
+	pathfinder := newFeePathfinder(t, nil, &MockBrokerClient{
+		brokerType:      "osmosis-sqs",
+		contractAddress: entryPointContract,
+		swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			queries.Add(1)
+			return &brokers.SwapResult{AmountIn: amountIn, AmountOut: "990000", PriceImpact: "0.001", RouteData: &MockRouteData{
+				operations: guardOperations, swapVenueName: "osmosis-poolmanager",
+			}}, nil
+		},
+	})
+
+	unknownToken := guardRequest(t)
+	unknownToken.TokenToDenom = "unknown"
+	reqs := []models.RouteRequest{guardRequest(t), hubToOsmosis, guardRequest(t), unknownToken, guardRequest(t)}
+
+	responses := pathfinder.BatchFindPath(t.Context(), reqs, 2)
+	assert.Equal(t, len(responses), len(reqs))
+	assert.Equal(t, responses[0].RouteType, "broker_swap")
+	assert.Equal(t, responses[1].RouteType, "direct")
+	assert.Equal(t, responses[2].RouteType, "broker_swap")
+	assert.False(t, responses[3].Success)
+	assert.Equal(t, responses[4].BrokerSwap.Swap.AmountOut, "990000")
+
+	// The three identical swaps are quoted once
+	assert.Equal(t, queries.Load(), int32(1))
+}
+
+func TestPathfinder_BatchFindPathCancelled(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	responses := pathfinder.BatchFindPath(ctx, []models.RouteRequest{hubToOsmosis, guardRequest(t)}, 0)
+	assert.Equal(t, len(responses), 2)
+	for _, response := range responses {
+		assert.False(t, response.Success)
+		assert.Equal(t, response.ErrorMessage, context.Canceled.Error())
+	}
+}
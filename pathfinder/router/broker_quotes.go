@@ -0,0 +1,147 @@
+package router
+
+import (
+	"context"
+	"sort"
+	"sync"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/shopspring/decimal"
+)
+
+// quoteBrokerRoutes queries every broker route candidate concurrently and returns the
+// successful broker swap responses ordered from the best quote to the worst, see compareBrokerQuotes,
+// together with the candidate each response was quoted for.
+// If no broker returned a quote the last broker error is returned, route guard rejections are preferred.
+func (s *Pathfinder) quoteBrokerRoutes(
+	ctx context.Context,
+	req models.RouteRequest,
+	candidates []*MultiHopInfo,
+) ([]models.RouteResponse, []*MultiHopInfo, error) {
+	responses := make([]*models.RouteResponse, len(candidates))
+	errs := make([]error, len(candidates))
+
+	var wg sync.WaitGroup
+	for i, hopInfo := range candidates {
+		wg.Add(1)
+		go func(i int, hopInfo *MultiHopInfo) {
+			defer wg.Done()
+			pathfinderLog.Debug().
+				Str("broker", hopInfo.BrokerChain).
+				Bool("swapOnly", hopInfo.SwapOnly).
+				Msg("Quoting broker route")
+
+			response, err := s.buildBrokerSwapResponse(ctx, req, hopInfo)
+			if err != nil {
+				errs[i] = err
+				pathfinderLog.Debug().Err(err).Str("broker", hopInfo.BrokerChain).Msg("Broker route failed")
+				return
+			}
+			responses[i] = &response
+		}(i, hopInfo)
+	}
+	wg.Wait()
+
+	succeeded := []int{}
+	var lastErr error
+	for i, response := range responses {
+		if response != nil {
+			succeeded = append(succeeded, i)
+		} else if errs[i] != nil && (lastErr == nil || guardErrorCode(lastErr) == "") {
+			// A route guard rejection says more than a failed query, keep it over the other errors
+			lastErr = errs[i]
+		}
+	}
+
+	if len(succeeded) == 0 {
+		return nil, nil, lastErr
+	}
+
+	sort.SliceStable(succeeded, func(a, b int) bool {
+		return compareBrokerQuotes(responses[succeeded[a]].BrokerSwap, responses[succeeded[b]].BrokerSwap) < 0
+	})
+
+	quoted := make([]models.RouteResponse, len(succeeded))
+	quotedCandidates := make([]*MultiHopInfo, len(succeeded))
+	for i, index := range succeeded {
+		quoted[i] = *responses[index]
+		quotedCandidates[i] = candidates[index]
+	}
+
+	return quoted, quotedCandidates, nil
+}
+
+// compareBrokerQuotes orders two broker routes, returning a negative number if a is better than b.
+// Routes are compared by the amount delivered to the receiver after the outbound hops,
+// then by the swap input (lowest first), then by the number of outbound hops, then by broker name so the order is stable.
+func compareBrokerQuotes(a, b *models.BrokerRoute) int {
+	outputA, outputB := brokerNetOutput(a), brokerNetOutput(b)
+	if cmp := outputB.Cmp(outputA); cmp != 0 {
+		return cmp
+	}
+	// Exact out quotes deliver the same output, the one asking for less input wins
+	if cmp := brokerInput(a).Cmp(brokerInput(b)); cmp != 0 {
+		return cmp
+	}
+	if len(a.OutboundLegs) != len(b.OutboundLegs) {
+		return len(a.OutboundLegs) - len(b.OutboundLegs)
+	}
+	switch {
+	case a.Swap.Broker < b.Swap.Broker:
+		return -1
+	case a.Swap.Broker > b.Swap.Broker:
+		return 1
+	}
+	return 0
+}
+
+// brokerNetOutput returns the amount the receiver gets from a broker route.
+// That is the net output of the swap after taker and PFM fees, or without it
+// the amount of the last outbound leg or the swap output if there are no outbound legs.
+func brokerNetOutput(route *models.BrokerRoute) decimal.Decimal {
+	amount := ""
+	if route.Swap != nil && route.Swap.NetAmountOut != "" {
+		amount = route.Swap.NetAmountOut
+	} else if len(route.OutboundLegs) > 0 {
+		amount = route.OutboundLegs[len(route.OutboundLegs)-1].Amount
+	} else if route.Swap != nil {
+		amount = route.Swap.AmountOut
+	}
+
+	output, err := decimal.NewFromString(amount)
+	if err != nil {
+		return decimal.Zero
+	}
+	return output
+}
+
+// withAlternativeQuotes returns the best broker response with the swap quotes
+// of the other brokers attached as alternatives
+func withAlternativeQuotes(quoted []models.RouteResponse) models.RouteResponse {
+	best := quoted[0]
+	if len(quoted) == 1 {
+		return best
+	}
+
+	// Copy the route so the alternatives don't leak into the shared candidate slice
+	brokerRoute := *best.BrokerSwap
+	brokerRoute.AlternativeQuotes = make([]*models.SwapQuote, 0, len(quoted)-1)
+	for _, alternative := range quoted[1:] {
+		brokerRoute.AlternativeQuotes = append(brokerRoute.AlternativeQuotes, alternative.BrokerSwap.Swap)
+	}
+	best.BrokerSwap = &brokerRoute
+
+	return best
+}
+
+// brokerInput returns the expected swap input of a broker route
+func brokerInput(route *models.BrokerRoute) decimal.Decimal {
+	if route.Swap == nil {
+		return decimal.Zero
+	}
+	input, err := decimal.NewFromString(route.Swap.AmountIn)
+	if err != nil {
+		return decimal.Zero
+	}
+	return input
+}
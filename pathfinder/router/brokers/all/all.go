@@ -0,0 +1,9 @@
+// Package all registers every broker implementation with the brokers registry.
+// Import it for its side effects; a new broker implementation only has to be added here
+// to become available to the [[brokers]] section of the RPC config.
+package all
+
+import (
+	_ "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport"
+	_ "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/osmosis"
+)
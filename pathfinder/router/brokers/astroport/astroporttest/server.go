@@ -0,0 +1,285 @@
+// Package astroporttest provides an in-memory stand-in for the LCD of an Astroport chain.
+// It answers the factory "pair" and "config", the pair "pool" and the router "simulate_swap_operations" and
+// "reverse_simulate_swap_operations" smart queries for registered constant product pairs, so the Astroport broker
+// can be exercised without network access.
+package astroporttest
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+
+	"github.com/shopspring/decimal"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport"
+)
+
+// FactoryAddress is the factory contract address served by the fake LCD
+const FactoryAddress = "neutron1factory"
+
+// RouterAddress is the router contract address served by the fake LCD
+const RouterAddress = "neutron1router"
+
+// Pair is a constant product (xyk) pair registered on the fake LCD
+type Pair struct {
+	Address string
+	// Reserves of the two assets, keyed by denom
+	Reserves map[string]decimal.Decimal
+	// Commission is the fee rate taken from the output, e.g. 0.003
+	Commission decimal.Decimal
+}
+
+// Server is a fake LCD serving Astroport smart queries
+type Server struct {
+	*httptest.Server
+
+	mu       sync.Mutex
+	pairs    map[string]*Pair
+	requests int
+}
+
+// NewServer starts a fake LCD with no pairs registered.
+// The caller should Close it when done.
+func NewServer() *Server {
+	s := &Server{pairs: make(map[string]*Pair)}
+	s.Server = httptest.NewServer(http.HandlerFunc(s.handle))
+	return s
+}
+
+// AddPair registers an xyk pair between two denoms with the given reserves and commission rate.
+// The factory reports the commission as the fee of the xyk pair type, so every pair should use the same one.
+func (s *Server) AddPair(address, denomA, reserveA, denomB, reserveB, commission string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.pairs[address] = &Pair{
+		Address: address,
+		Reserves: map[string]decimal.Decimal{
+			denomA: decimal.RequireFromString(reserveA),
+			denomB: decimal.RequireFromString(reserveB),
+		},
+		Commission: decimal.RequireFromString(commission),
+	}
+}
+
+// Requests returns the number of smart queries served so far
+func (s *Server) Requests() int {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.requests
+}
+
+func (s *Server) handle(w http.ResponseWriter, r *http.Request) {
+	// /cosmwasm/wasm/v1/contract/{address}/smart/{base64 query}
+	parts := strings.Split(strings.TrimPrefix(r.URL.Path, "/cosmwasm/wasm/v1/contract/"), "/")
+	if len(parts) != 3 || parts[1] != "smart" {
+		http.NotFound(w, r)
+		return
+	}
+
+	queryBytes, err := base64.StdEncoding.DecodeString(parts[2])
+	if err != nil {
+		writeError(w, http.StatusBadRequest, "invalid query encoding")
+		return
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.requests++
+
+	var data any
+	switch parts[0] {
+	case FactoryAddress:
+		data, err = s.factoryQuery(queryBytes)
+	case RouterAddress:
+		data, err = s.routerQuery(queryBytes)
+	default:
+		data, err = s.pairContractQuery(parts[0], queryBytes)
+	}
+	if err != nil {
+		// wasmd reports contract errors as an internal error with the message in the body
+		writeError(w, http.StatusInternalServerError, err.Error())
+		return
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(map[string]any{"data": data})
+}
+
+func (s *Server) factoryQuery(queryBytes []byte) (any, error) {
+	var queries map[string]json.RawMessage
+	if err := json.Unmarshal(queryBytes, &queries); err != nil {
+		return nil, fmt.Errorf("unknown factory query")
+	}
+	if _, ok := queries["config"]; ok {
+		feeBps := 0
+		for _, pair := range s.pairs {
+			feeBps = int(pair.Commission.Mul(decimal.NewFromInt(10000)).IntPart())
+		}
+		return astroport.FactoryConfigResponse{
+			PairConfigs: []astroport.PairConfig{
+				{PairType: map[string]map[string]any{"xyk": {}}, TotalFeeBps: feeBps},
+			},
+		}, nil
+	}
+
+	var query astroport.PairQuery
+	if err := json.Unmarshal(queryBytes, &query); err != nil || len(query.Pair.AssetInfos) != 2 {
+		return nil, fmt.Errorf("unknown factory query")
+	}
+	denomA := query.Pair.AssetInfos[0].NativeToken.Denom
+	denomB := query.Pair.AssetInfos[1].NativeToken.Denom
+
+	pair, err := s.findPair(denomA, denomB)
+	if err != nil {
+		return nil, err
+	}
+	return astroport.PairInfo{
+		AssetInfos:     query.Pair.AssetInfos,
+		ContractAddr:   pair.Address,
+		LiquidityToken: "factory/" + pair.Address + "/astroport/share",
+		PairType:       map[string]map[string]any{"xyk": {}},
+	}, nil
+}
+
+// findPair returns the pair trading the two denoms
+func (s *Server) findPair(denomA, denomB string) (*Pair, error) {
+	for _, pair := range s.pairs {
+		_, hasA := pair.Reserves[denomA]
+		_, hasB := pair.Reserves[denomB]
+		if hasA && hasB && denomA != denomB {
+			return pair, nil
+		}
+	}
+	return nil, fmt.Errorf("pair not found: %s-%s", denomA, denomB)
+}
+
+func (s *Server) pairContractQuery(address string, queryBytes []byte) (any, error) {
+	pair, ok := s.pairs[address]
+	if !ok {
+		return nil, fmt.Errorf("contract %s not found", address)
+	}
+	var queries map[string]json.RawMessage
+	if err := json.Unmarshal(queryBytes, &queries); err != nil {
+		return nil, fmt.Errorf("unknown pair query")
+	}
+	if _, ok := queries["pool"]; !ok {
+		return nil, fmt.Errorf("unknown pair query")
+	}
+
+	assets := make([]astroport.Asset, 0, len(pair.Reserves))
+	for denom, reserve := range pair.Reserves {
+		assets = append(assets, astroport.Asset{Info: astroport.NewNativeAssetInfo(denom), Amount: reserve.String()})
+	}
+	return astroport.PoolResponse{Assets: assets, TotalShare: "1000000"}, nil
+}
+
+// routerQuery runs the operations of a simulation through the pairs in order, and those of a reverse
+// simulation backwards so the ask of every pair is the offer of the next one
+func (s *Server) routerQuery(queryBytes []byte) (any, error) {
+	var simulation astroport.SimulateSwapOperationsQuery
+	if err := json.Unmarshal(queryBytes, &simulation); err == nil && simulation.SimulateSwapOperations.OfferAmount != "" {
+		amount, err := decimal.NewFromString(simulation.SimulateSwapOperations.OfferAmount)
+		if err != nil || !amount.IsPositive() {
+			return nil, fmt.Errorf("invalid offer amount")
+		}
+		for _, operation := range simulation.SimulateSwapOperations.Operations {
+			pair, offerDenom, err := s.operationPair(operation)
+			if err != nil {
+				return nil, err
+			}
+			if amount, err = pair.simulate(offerDenom, amount); err != nil {
+				return nil, err
+			}
+		}
+		return astroport.SimulateSwapOperationsResponse{Amount: amount.String()}, nil
+	}
+
+	var reverse astroport.ReverseSimulateSwapOperationsQuery
+	if err := json.Unmarshal(queryBytes, &reverse); err == nil && reverse.ReverseSimulateSwapOperations.AskAmount != "" {
+		amount, err := decimal.NewFromString(reverse.ReverseSimulateSwapOperations.AskAmount)
+		if err != nil || !amount.IsPositive() {
+			return nil, fmt.Errorf("invalid ask amount")
+		}
+		operations := reverse.ReverseSimulateSwapOperations.Operations
+		for i := len(operations) - 1; i >= 0; i-- {
+			pair, _, err := s.operationPair(operations[i])
+			if err != nil {
+				return nil, err
+			}
+			if amount, err = pair.reverseSimulate(operations[i].AstroSwap.AskAssetInfo.NativeToken.Denom, amount); err != nil {
+				return nil, err
+			}
+		}
+		return astroport.SimulateSwapOperationsResponse{Amount: amount.String()}, nil
+	}
+
+	return nil, fmt.Errorf("unknown router query")
+}
+
+// operationPair returns the pair a router operation swaps through and the offered denom
+func (s *Server) operationPair(operation astroport.SwapOperation) (*Pair, string, error) {
+	offer, ask := operation.AstroSwap.OfferAssetInfo.NativeToken, operation.AstroSwap.AskAssetInfo.NativeToken
+	if offer == nil || ask == nil {
+		return nil, "", fmt.Errorf("only native token operations are supported")
+	}
+	pair, err := s.findPair(offer.Denom, ask.Denom)
+	if err != nil {
+		return nil, "", err
+	}
+	return pair, offer.Denom, nil
+}
+
+// pools returns the reserve of the given denom and of the other asset of the pair
+func (p *Pair) pools(denom string) (decimal.Decimal, decimal.Decimal, error) {
+	pool, ok := p.Reserves[denom]
+	if !ok {
+		return decimal.Zero, decimal.Zero, fmt.Errorf("asset %s is not in the pool", denom)
+	}
+
+	var other decimal.Decimal
+	for otherDenom, reserve := range p.Reserves {
+		if otherDenom != denom {
+			other = reserve
+		}
+	}
+	return pool, other, nil
+}
+
+// simulate follows the xyk pair math: the constant product output minus the commission taken from it
+func (p *Pair) simulate(offerDenom string, offerAmount decimal.Decimal) (decimal.Decimal, error) {
+	offerPool, askPool, err := p.pools(offerDenom)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	returnAmount := askPool.Mul(offerAmount).Div(offerPool.Add(offerAmount)).Floor()
+	commissionAmount := returnAmount.Mul(p.Commission).Floor()
+	return returnAmount.Sub(commissionAmount), nil
+}
+
+// reverseSimulate is the inverse of simulate: the output before commission is grossed up
+// from the asked amount and the offer needed for it is rounded up
+func (p *Pair) reverseSimulate(askDenom string, askAmount decimal.Decimal) (decimal.Decimal, error) {
+	askPool, offerPool, err := p.pools(askDenom)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	beforeCommission := askAmount.Div(decimal.NewFromInt(1).Sub(p.Commission)).Ceil()
+	if beforeCommission.GreaterThanOrEqual(askPool) {
+		return decimal.Zero, fmt.Errorf("ask amount exceeds pool liquidity")
+	}
+
+	return offerPool.Mul(beforeCommission).Div(askPool.Sub(beforeCommission)).Ceil(), nil
+}
+
+func writeError(w http.ResponseWriter, status int, message string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	_ = json.NewEncoder(w).Encode(map[string]any{"code": 2, "message": message})
+}
@@ -0,0 +1,348 @@
+package astroport
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/shopspring/decimal"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+var log zerolog.Logger
+
+func init() {
+	out := zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}
+	log = zerolog.New(out).With().Timestamp().Str("component", "astroport-broker").Logger()
+
+	brokers.Register(BrokerType, newFromConfig)
+}
+
+// BrokerType is the broker type the Astroport broker is registered under
+const BrokerType = "astroport"
+
+// Ensure Broker implements brokers.BrokerClient
+var _ brokers.BrokerClient = (*Broker)(nil)
+
+// Broker implements brokers.BrokerClient for Astroport.
+// Swaps are quoted through a direct pair if one exists, or through one of the
+// configured hop denoms (e.g. "untrn") when there is no direct pair or it gives a worse price.
+// Every path is simulated with a single query to the Astroport router.
+type Broker struct {
+	client               *Client
+	hopDenoms            []string
+	memoBuilder          *MemoBuilder
+	smartContractBuilder *SmartContractBuilder
+}
+
+// NewBroker creates a new Astroport broker client.
+// lcdURLs are the LCD endpoints of the chain, factoryAddress and routerAddress are the Astroport factory and
+// router contracts, contractAddress is the ibc-hooks entry point contract and hopDenoms are the denoms
+// that can be used as an intermediate step in two pair routes.
+func NewBroker(lcdURLs []string, factoryAddress, routerAddress, contractAddress string, hopDenoms []string) *Broker {
+	return &Broker{
+		client:               NewClient(lcdURLs, factoryAddress, routerAddress),
+		hopDenoms:            hopDenoms,
+		memoBuilder:          NewMemoBuilder(contractAddress),
+		smartContractBuilder: NewSmartContractBuilder(contractAddress),
+	}
+}
+
+// newFromConfig is the brokers.Factory for the Astroport broker.
+// Endpoints are LCD URLs, the "factory_address" and "router_address" options are required and "hop_denoms"
+// is an optional comma separated list of denoms to route through.
+func newFromConfig(cfg brokers.Config) (brokers.BrokerClient, error) {
+	factoryAddress := cfg.Option("factory_address", "")
+	if factoryAddress == "" {
+		return nil, fmt.Errorf("astroport broker requires the factory_address option")
+	}
+	routerAddress := cfg.Option("router_address", "")
+	if routerAddress == "" {
+		return nil, fmt.Errorf("astroport broker requires the router_address option")
+	}
+
+	broker := NewBroker(cfg.Endpoints, factoryAddress, routerAddress, cfg.ContractAddress, cfg.OptionList("hop_denoms"))
+	if cfg.Timeout > 0 {
+		broker.client.httpClient.Timeout = cfg.Timeout
+	}
+
+	log.Info().
+		Str("broker", cfg.Id).
+		Strs("urls", cfg.Endpoints).
+		Str("factory", factoryAddress).
+		Str("router", routerAddress).
+		Msg("Astroport broker initialized")
+
+	return broker, nil
+}
+
+// QuerySwap implements brokers.BrokerClient interface for Astroport.
+// Astroport quotes are always a single route, so singleRoute is ignored.
+func (b *Broker) QuerySwap(
+	ctx context.Context,
+	tokenInDenom, tokenInAmount, tokenOutDenom string,
+	singleRoute *bool,
+) (*brokers.SwapResult, error) {
+	log.Debug().
+		Str("tokenIn", tokenInDenom).
+		Str("amount", tokenInAmount).
+		Str("tokenOut", tokenOutDenom).
+		Msg("Querying Astroport for swap route")
+
+	best, err := b.bestPath(ctx, b.candidatePaths(tokenInDenom, tokenOutDenom), tokenInAmount, false)
+	if err != nil {
+		log.Error().Err(err).
+			Str("tokenIn", tokenInDenom).
+			Str("tokenOut", tokenOutDenom).
+			Msg("Astroport query failed")
+		return nil, fmt.Errorf("no astroport route from %s to %s: %w", tokenInDenom, tokenOutDenom, err)
+	}
+	priceImpact, effectiveFee := b.priceImpactAndFee(ctx, best)
+
+	log.Debug().
+		Str("amountOut", best.AmountOut).
+		Int("hops", len(best.Hops)).
+		Str("priceImpact", priceImpact).
+		Msg("Astroport query successful")
+
+	return &brokers.SwapResult{
+		AmountIn:     tokenInAmount,
+		AmountOut:    best.AmountOut,
+		PriceImpact:  priceImpact,
+		EffectiveFee: effectiveFee,
+		RouteData:    best,
+	}, nil
+}
+
+// QuerySwapExactOut implements brokers.BrokerClient interface for Astroport.
+// Every candidate path is reverse simulated and the one needing the least input wins.
+func (b *Broker) QuerySwapExactOut(
+	ctx context.Context,
+	tokenInDenom, tokenOutDenom, tokenOutAmount string,
+	singleRoute *bool,
+) (*brokers.SwapResult, error) {
+	log.Debug().
+		Str("tokenIn", tokenInDenom).
+		Str("tokenOut", tokenOutDenom).
+		Str("amountOut", tokenOutAmount).
+		Msg("Querying Astroport for exact out swap route")
+
+	best, err := b.bestPath(ctx, b.candidatePaths(tokenInDenom, tokenOutDenom), tokenOutAmount, true)
+	if err != nil {
+		log.Error().Err(err).
+			Str("tokenIn", tokenInDenom).
+			Str("tokenOut", tokenOutDenom).
+			Msg("Astroport exact out query failed")
+		return nil, fmt.Errorf("no astroport route from %s to %s: %w", tokenInDenom, tokenOutDenom, err)
+	}
+	priceImpact, effectiveFee := b.priceImpactAndFee(ctx, best)
+
+	log.Debug().
+		Str("amountIn", best.AmountIn).
+		Int("hops", len(best.Hops)).
+		Str("priceImpact", priceImpact).
+		Msg("Astroport exact out query successful")
+
+	return &brokers.SwapResult{
+		AmountIn:     best.AmountIn,
+		AmountOut:    tokenOutAmount,
+		PriceImpact:  priceImpact,
+		EffectiveFee: effectiveFee,
+		RouteData:    best,
+	}, nil
+}
+
+// candidatePaths returns the direct path and the paths through every usable hop denom
+func (b *Broker) candidatePaths(tokenInDenom, tokenOutDenom string) [][]string {
+	paths := [][]string{{tokenInDenom, tokenOutDenom}}
+	for _, hopDenom := range b.hopDenoms {
+		if hopDenom != tokenInDenom && hopDenom != tokenOutDenom {
+			paths = append(paths, []string{tokenInDenom, hopDenom, tokenOutDenom})
+		}
+	}
+	return paths
+}
+
+// bestPath quotes every path and returns the route with the highest output,
+// or with the lowest input when exactOut is set. Paths without pairs are skipped.
+// The remaining paths aren't quoted once ctx is done.
+func (b *Broker) bestPath(ctx context.Context, paths [][]string, amount string, exactOut bool) (*RouteData, error) {
+	var best *RouteData
+	var bestAmount decimal.Decimal
+	var lastErr error
+
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		routeData, err := b.quotePath(ctx, path, amount, exactOut)
+		if err != nil {
+			if !errors.Is(err, ErrPairNotFound) {
+				lastErr = err
+			}
+			continue
+		}
+
+		simulated := routeData.AmountOut
+		if exactOut {
+			simulated = routeData.AmountIn
+		}
+		simulatedAmount, err := decimal.NewFromString(simulated)
+		if err != nil {
+			lastErr = fmt.Errorf("invalid simulated amount: %w", err)
+			continue
+		}
+
+		if best == nil || (!exactOut && simulatedAmount.GreaterThan(bestAmount)) ||
+			(exactOut && simulatedAmount.LessThan(bestAmount)) {
+			best = routeData
+			bestAmount = simulatedAmount
+		}
+	}
+
+	if best == nil {
+		if lastErr == nil {
+			lastErr = ErrPairNotFound
+		}
+		return nil, lastErr
+	}
+	return best, nil
+}
+
+// quotePath simulates a swap through every consecutive denom pair of the path with a single router query.
+// amount is the input, or the output to receive when exactOut is set and the needed input is simulated.
+func (b *Broker) quotePath(ctx context.Context, path []string, amount string, exactOut bool) (*RouteData, error) {
+	routeData := &RouteData{Hops: make([]Hop, 0, len(path)-1)}
+	for i := 0; i < len(path)-1; i++ {
+		pair, err := b.client.GetPair(ctx, path[i], path[i+1])
+		if err != nil {
+			return nil, err
+		}
+		routeData.Hops = append(routeData.Hops, Hop{
+			PairAddress: pair.ContractAddr,
+			PairType:    pair.Type(),
+			DenomIn:     path[i],
+			DenomOut:    path[i+1],
+		})
+	}
+
+	if exactOut {
+		amountIn, err := b.client.ReverseSimulateSwapOperations(ctx, path, amount)
+		if err != nil {
+			return nil, fmt.Errorf("reverse simulation on the router failed: %w", err)
+		}
+		routeData.AmountIn, routeData.AmountOut = amountIn, amount
+		return routeData, nil
+	}
+
+	amountOut, err := b.client.SimulateSwapOperations(ctx, path, amount)
+	if err != nil {
+		return nil, fmt.Errorf("simulation on the router failed: %w", err)
+	}
+	routeData.AmountIn, routeData.AmountOut = amount, amountOut
+	return routeData, nil
+}
+
+// priceImpactAndFee returns the price impact and effective fee of the route, both as fractions (e.g. "0.003"),
+// and sets the fee rate of every hop. The fees of consecutive pairs compound: total = 1 - (1 - a) * (1 - b).
+// The price impact is what the route loses against the pool prices beyond the fees. Values that can't be
+// computed are left empty.
+func (b *Broker) priceImpactAndFee(ctx context.Context, routeData *RouteData) (string, string) {
+	feeRates, err := b.client.FeeRates(ctx)
+	if err != nil {
+		log.Debug().Err(err).Msg("Could not query the fees of the Astroport pairs")
+		return "", ""
+	}
+
+	one := decimal.NewFromInt(1)
+	keptAfterFee := one
+	for i := range routeData.Hops {
+		feeRate, ok := feeRates[routeData.Hops[i].PairType]
+		if !ok {
+			return "", ""
+		}
+		routeData.Hops[i].FeeRate = feeRate.String()
+		keptAfterFee = keptAfterFee.Mul(one.Sub(feeRate))
+	}
+	effectiveFee := one.Sub(keptAfterFee).Round(6).String()
+
+	spotOutput, ok := b.spotOutput(ctx, routeData)
+	amountOut, err := decimal.NewFromString(routeData.AmountOut)
+	if !ok || err != nil || !spotOutput.IsPositive() {
+		return "", effectiveFee
+	}
+
+	priceImpact := decimal.Max(one.Sub(amountOut.Div(spotOutput.Mul(keptAfterFee))), decimal.Zero)
+	return priceImpact.Round(6).String(), effectiveFee
+}
+
+// spotOutput returns what the input of the route would buy at the current pool prices, the pools are queried
+// concurrently. The pool price is only known for constant product (xyk) pairs, false for other pairs or if a pool
+// can't be queried.
+func (b *Broker) spotOutput(ctx context.Context, routeData *RouteData) (decimal.Decimal, bool) {
+	for _, hop := range routeData.Hops {
+		if hop.PairType != "xyk" {
+			return decimal.Zero, false
+		}
+	}
+
+	pools := make([]PoolResponse, len(routeData.Hops))
+	errs := make([]error, len(routeData.Hops))
+	var wg sync.WaitGroup
+	for i, hop := range routeData.Hops {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			pools[i], errs[i] = b.client.GetPool(ctx, hop.PairAddress)
+		}()
+	}
+	wg.Wait()
+
+	amount, err := decimal.NewFromString(routeData.AmountIn)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	for i, hop := range routeData.Hops {
+		if errs[i] != nil {
+			log.Debug().Err(errs[i]).Str("pair", hop.PairAddress).Msg("Could not query the Astroport pool")
+			return decimal.Zero, false
+		}
+		reserveIn, okIn := pools[i].Reserve(hop.DenomIn)
+		reserveOut, okOut := pools[i].Reserve(hop.DenomOut)
+		if !okIn || !okOut {
+			return decimal.Zero, false
+		}
+		in, errIn := decimal.NewFromString(reserveIn)
+		out, errOut := decimal.NewFromString(reserveOut)
+		if errIn != nil || errOut != nil || !in.IsPositive() {
+			return decimal.Zero, false
+		}
+		amount = amount.Mul(out).Div(in)
+	}
+	return amount, true
+}
+
+// GetBrokerType returns the broker type identifier
+func (b *Broker) GetBrokerType() string {
+	return BrokerType
+}
+
+// GetMemoBuilder returns the memo builder for Astroport
+func (b *Broker) GetMemoBuilder() ibcmemo.MemoBuilder {
+	return b.memoBuilder
+}
+
+// GetSmartContractBuilder returns the smart contract builder for Astroport
+func (b *Broker) GetSmartContractBuilder() brokers.SmartContractBuilder {
+	return b.smartContractBuilder
+}
+
+// Close cleans up resources used by the broker client
+func (b *Broker) Close() {}
@@ -0,0 +1,240 @@
+package astroport_test
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport/astroporttest"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+const entryPoint = "neutron1entrypoint"
+
+func setupBroker(t *testing.T) (*astroport.Broker, *astroporttest.Server) {
+	t.Helper()
+
+	server := astroporttest.NewServer()
+	t.Cleanup(server.Close)
+
+	// ATOM/NTRN and NTRN/USDC are deep, ATOM/USDC is shallow so routing through NTRN wins
+	server.AddPair("neutron1atomntrn", "ibc/ATOM", "1000000000000", "untrn", "10000000000000", "0.003")
+	server.AddPair("neutron1ntrnusdc", "untrn", "10000000000000", "ibc/USDC", "5000000000000", "0.003")
+	server.AddPair("neutron1atomusdc", "ibc/ATOM", "1000000", "ibc/USDC", "5000000", "0.003")
+
+	broker := astroport.NewBroker(
+		[]string{server.URL}, astroporttest.FactoryAddress, astroporttest.RouterAddress, entryPoint, []string{"untrn"},
+	)
+	return broker, server
+}
+
+func TestBroker_QuerySwapDirectPair(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwap(t.Context(), "ibc/ATOM", "1000000", "untrn", nil)
+	assert.NoError(t, err)
+
+	// 10000000000000 * 1000000 / 1000001000000 = 9999990, minus 0.3% commission
+	assert.Equal(t, result.AmountOut, "9969991")
+	assert.Equal(t, result.EffectiveFee, "0.003")
+	// 1000000 buys 10000000 at the pool price, 9970000 after the fee
+	assert.Equal(t, result.PriceImpact, "0.000001")
+
+	routeData, ok := result.RouteData.(*astroport.RouteData)
+	assert.True(t, ok)
+	assert.Equal(t, len(routeData.Hops), 1)
+	assert.Equal(t, routeData.Hops[0].PairAddress, "neutron1atomntrn")
+	assert.Equal(t, routeData.Hops[0].PairType, "xyk")
+	assert.Equal(t, routeData.Hops[0].FeeRate, "0.003")
+	assert.Equal(t, routeData.AmountOut, result.AmountOut)
+	assert.Equal(t, routeData.GetSwapVenueName(), astroport.SwapVenueName)
+}
+
+func TestBroker_QuerySwapThroughHopDenom(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwap(t.Context(), "ibc/ATOM", "1000000", "ibc/USDC", nil)
+	assert.NoError(t, err)
+
+	routeData := result.RouteData.(*astroport.RouteData)
+	assert.Equal(t, len(routeData.Hops), 2)
+
+	operations := routeData.GetOperations()
+	assert.Equal(t, operations[0], ibcmemo.NewSwapOperation("neutron1atomntrn", "ibc/ATOM", "untrn"))
+	assert.Equal(t, operations[1], ibcmemo.NewSwapOperation("neutron1ntrnusdc", "untrn", "ibc/USDC"))
+	assert.Equal(t, result.AmountOut, routeData.AmountOut)
+	assert.Equal(t, result.EffectiveFee, "0.005991")
+}
+
+func TestBroker_QuerySwapSimulatesOnTheRouter(t *testing.T) {
+	broker, server := setupBroker(t)
+
+	_, err := broker.QuerySwap(t.Context(), "ibc/ATOM", "1000000", "ibc/USDC", nil)
+	assert.NoError(t, err)
+
+	// Pairs and fees are cached, every path is one router query and the pools of the best path are queried once
+	before := server.Requests()
+	_, err = broker.QuerySwap(t.Context(), "ibc/ATOM", "2000000", "ibc/USDC", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, server.Requests()-before, 2+2)
+}
+
+func TestBroker_QuerySwapNoPair(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	_, err := broker.QuerySwap(t.Context(), "ibc/OSMO", "1000000", "ibc/USDC", nil)
+	assert.Error(t, err)
+	assert.True(t, errors.Is(err, astroport.ErrPairNotFound))
+}
+
+func TestClient_OnlyPairQueriesReportPairNotFound(t *testing.T) {
+	_, server := setupBroker(t)
+	client := astroport.NewClient([]string{server.URL}, astroporttest.FactoryAddress, astroporttest.RouterAddress)
+
+	_, err := client.GetPair(t.Context(), "ibc/OSMO", "ibc/USDC")
+	assert.True(t, errors.Is(err, astroport.ErrPairNotFound))
+
+	// The pool query of a missing contract fails with "not found" as well, it is returned as it is
+	_, err = client.GetPool(t.Context(), "neutron1missing")
+	assert.Error(t, err)
+	assert.False(t, errors.Is(err, astroport.ErrPairNotFound))
+	assert.True(t, strings.Contains(err.Error(), "contract neutron1missing not found"))
+}
+
+func TestBroker_QuerySwapFailsOverToNextEndpoint(t *testing.T) {
+	server := astroporttest.NewServer()
+	defer server.Close()
+	server.AddPair("neutron1atomntrn", "ibc/ATOM", "1000000000000", "untrn", "10000000000000", "0.003")
+
+	broker := astroport.NewBroker(
+		[]string{"http://127.0.0.1:1", server.URL},
+		astroporttest.FactoryAddress, astroporttest.RouterAddress, entryPoint, nil,
+	)
+
+	result, err := broker.QuerySwap(t.Context(), "ibc/ATOM", "1000000", "untrn", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, result.AmountOut, "9969991")
+}
+
+func TestBroker_QuerySwapCancelled(t *testing.T) {
+	broker, server := setupBroker(t)
+
+	ctx, cancel := context.WithCancel(t.Context())
+	cancel()
+
+	_, err := broker.QuerySwap(ctx, "ibc/ATOM", "1000000", "ibc/USDC", nil)
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, server.Requests(), 0)
+}
+
+func TestBroker_QuerySwapExactOut(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwapExactOut(t.Context(), "ibc/ATOM", "untrn", "9969991", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, result.AmountOut, "9969991")
+	assert.Equal(t, result.EffectiveFee, "0.003")
+
+	routeData := result.RouteData.(*astroport.RouteData)
+	assert.Equal(t, len(routeData.Hops), 1)
+	assert.Equal(t, routeData.AmountIn, result.AmountIn)
+
+	// Offering the reverse quoted input must deliver at least the asked output
+	forward, err := broker.QuerySwap(t.Context(), "ibc/ATOM", result.AmountIn, "untrn", nil)
+	assert.NoError(t, err)
+	assert.True(t, decimal.RequireFromString(forward.AmountOut).GreaterThanOrEqual(decimal.RequireFromString("9969991")))
+}
+
+func TestBroker_QuerySwapExactOutThroughHopDenom(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwapExactOut(t.Context(), "ibc/ATOM", "ibc/USDC", "4000000", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, result.AmountOut, "4000000")
+
+	routeData := result.RouteData.(*astroport.RouteData)
+	assert.Equal(t, len(routeData.Hops), 2)
+	assert.Equal(t, routeData.Hops[0].DenomOut, "untrn")
+	assert.Equal(t, routeData.AmountIn, result.AmountIn)
+	assert.Equal(t, routeData.AmountOut, "4000000")
+
+	// Offering the reverse quoted input must deliver at least the asked output
+	forward, err := broker.QuerySwap(t.Context(), "ibc/ATOM", result.AmountIn, "ibc/USDC", nil)
+	assert.NoError(t, err)
+	assert.True(t, decimal.RequireFromString(forward.AmountOut).GreaterThanOrEqual(decimal.RequireFromString("4000000")))
+}
+
+func TestMemoBuilder_BuildSwapAndForwardMemo(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwap(t.Context(), "ibc/ATOM", "1000000", "ibc/USDC", nil)
+	assert.NoError(t, err)
+
+	memo, err := broker.GetMemoBuilder().BuildSwapAndForwardMemo(ibcmemo.SwapAndForwardParams{
+		SwapMemoParams: ibcmemo.SwapMemoParams{
+			TokenInDenom:     "ibc/ATOM",
+			TokenOutDenom:    "ibc/USDC",
+			MinOutputAmount:  "1",
+			RouteData:        result.RouteData,
+			TimeoutTimestamp: 1,
+			RecoverAddress:   "neutron1recover",
+		},
+		SourceChannel:   "channel-30",
+		ForwardReceiver: "noble1receiver",
+	})
+	assert.NoError(t, err)
+
+	var parsed ibcmemo.WasmMemo
+	assert.NoError(t, json.Unmarshal([]byte(memo), &parsed))
+	assert.Equal(t, parsed.Wasm.Contract, entryPoint)
+
+	swapAndAction := parsed.Wasm.Msg.SwapAndAction
+	assert.Equal(t, swapAndAction.UserSwap.SwapExactAssetIn.SwapVenueName, astroport.SwapVenueName)
+	assert.Equal(t, len(swapAndAction.UserSwap.SwapExactAssetIn.Operations), 2)
+	assert.Equal(t, swapAndAction.PostSwapAction.IBCTransfer.IBCInfo.SourceChannel, "channel-30")
+}
+
+func TestMemoBuilder_BuildExactOutSwapMemo(t *testing.T) {
+	broker, _ := setupBroker(t)
+
+	result, err := broker.QuerySwapExactOut(t.Context(), "ibc/ATOM", "untrn", "9969991", nil)
+	assert.NoError(t, err)
+
+	memo, err := broker.GetMemoBuilder().BuildSwapMemo(ibcmemo.SwapMemoParams{
+		TokenInDenom:     "ibc/ATOM",
+		TokenOutDenom:    "untrn",
+		MinOutputAmount:  result.AmountOut,
+		RouteData:        result.RouteData,
+		TimeoutTimestamp: 1,
+		RecoverAddress:   "neutron1recover",
+		ReceiverAddress:  "neutron1receiver",
+		ExactOutput:      true,
+	})
+	assert.NoError(t, err)
+
+	var parsed ibcmemo.WasmMemo
+	assert.NoError(t, json.Unmarshal([]byte(memo), &parsed))
+
+	userSwap := parsed.Wasm.Msg.SwapAndAction.UserSwap
+	assert.Nil(t, userSwap.SwapExactAssetIn)
+	assert.NotNil(t, userSwap.SwapExactAssetOut)
+	assert.Equal(t, userSwap.SwapExactAssetOut.SwapVenueName, astroport.SwapVenueName)
+	assert.Equal(t, userSwap.SwapExactAssetOut.RefundAddress, "neutron1recover")
+	assert.Equal(t, parsed.Wasm.Msg.SwapAndAction.MinAsset.Native.Amount, "9969991")
+}
+
+func TestMemoBuilder_RejectsForeignRouteData(t *testing.T) {
+	memoBuilder := astroport.NewMemoBuilder(entryPoint)
+
+	_, err := memoBuilder.BuildSwapMemo(ibcmemo.SwapMemoParams{
+		TokenOutDenom: "untrn",
+		RouteData:     struct{}{},
+	})
+	assert.Error(t, err)
+}
@@ -0,0 +1,238 @@
+package astroport
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+)
+
+// ErrPairNotFound is returned when the factory has no pair for the requested assets
+var ErrPairNotFound = errors.New("astroport pair not found")
+
+// queryError is returned when the LCD answers a smart query with an error, e.g. because the contract rejected it
+type queryError struct {
+	status int
+	body   string
+}
+
+func (e *queryError) Error() string {
+	return fmt.Sprintf("HTTP %d: %s", e.status, e.body)
+}
+
+// isPairNotFound reports whether err is the factory failing a pair query because the pair doesn't exist
+func isPairNotFound(err error) bool {
+	var queryErr *queryError
+	return errors.As(err, &queryErr) && strings.Contains(strings.ToLower(queryErr.body), "not found")
+}
+
+// DefaultTimeout is the HTTP request timeout used by the client
+const DefaultTimeout = 10 * time.Second
+
+// Client queries Astroport contracts through the cosmwasm smart query endpoint of a chain LCD.
+// The LCD endpoints are tried in order, a failing endpoint falls over to the next one
+// unless the context of the query is done.
+// Pair addresses and the fees of the pair types are cached, they don't change once they are set.
+type Client struct {
+	httpClient     *http.Client
+	lcdURLs        []string
+	factoryAddress string
+	routerAddress  string
+
+	mu       sync.RWMutex
+	pairs    map[string]PairInfo        // "denomA|denomB" -> pair
+	feeRates map[string]decimal.Decimal // pair type -> fee as a fraction
+}
+
+// NewClient creates a new Astroport query client
+func NewClient(lcdURLs []string, factoryAddress, routerAddress string) *Client {
+	urls := make([]string, 0, len(lcdURLs))
+	for _, u := range lcdURLs {
+		urls = append(urls, strings.TrimRight(u, "/"))
+	}
+
+	return &Client{
+		httpClient: &http.Client{
+			Timeout:   DefaultTimeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
+		},
+		lcdURLs:        urls,
+		factoryAddress: factoryAddress,
+		routerAddress:  routerAddress,
+		pairs:          make(map[string]PairInfo),
+	}
+}
+
+// GetPair looks up the pair contract for two native denoms on the factory.
+// Returns ErrPairNotFound if the pair doesn't exist, missing pairs are not cached as they can be created.
+func (c *Client) GetPair(ctx context.Context, denomA, denomB string) (PairInfo, error) {
+	key := denomA + "|" + denomB
+	if denomB < denomA {
+		key = denomB + "|" + denomA
+	}
+
+	c.mu.RLock()
+	pair, ok := c.pairs[key]
+	c.mu.RUnlock()
+	if ok {
+		return pair, nil
+	}
+
+	query := PairQuery{
+		Pair: PairQueryAssets{
+			AssetInfos: []AssetInfo{NewNativeAssetInfo(denomA), NewNativeAssetInfo(denomB)},
+		},
+	}
+
+	var response smartQueryResponse[PairInfo]
+	if err := c.smartQuery(ctx, c.factoryAddress, query, &response); err != nil {
+		if isPairNotFound(err) {
+			return PairInfo{}, ErrPairNotFound
+		}
+		return PairInfo{}, err
+	}
+	if response.Data.ContractAddr == "" {
+		return PairInfo{}, ErrPairNotFound
+	}
+
+	c.mu.Lock()
+	c.pairs[key] = response.Data
+	c.mu.Unlock()
+	return response.Data, nil
+}
+
+// FeeRates returns the fee of every pair type as a fraction, e.g. 0.003 for "xyk"
+func (c *Client) FeeRates(ctx context.Context) (map[string]decimal.Decimal, error) {
+	c.mu.RLock()
+	feeRates := c.feeRates
+	c.mu.RUnlock()
+	if feeRates != nil {
+		return feeRates, nil
+	}
+
+	var response smartQueryResponse[FactoryConfigResponse]
+	if err := c.smartQuery(ctx, c.factoryAddress, FactoryConfigQuery{}, &response); err != nil {
+		return nil, err
+	}
+
+	feeRates = make(map[string]decimal.Decimal, len(response.Data.PairConfigs))
+	for _, pairConfig := range response.Data.PairConfigs {
+		feeRates[pairConfig.Type()] = decimal.New(int64(pairConfig.TotalFeeBps), -4)
+	}
+
+	c.mu.Lock()
+	c.feeRates = feeRates
+	c.mu.Unlock()
+	return feeRates, nil
+}
+
+// GetPool returns the reserves of the pair
+func (c *Client) GetPool(ctx context.Context, pairAddress string) (PoolResponse, error) {
+	var response smartQueryResponse[PoolResponse]
+	if err := c.smartQuery(ctx, pairAddress, PoolQuery{}, &response); err != nil {
+		return PoolResponse{}, err
+	}
+	return response.Data, nil
+}
+
+// SimulateSwapOperations returns the output of offering amount to the swaps through every denom pair of the path
+func (c *Client) SimulateSwapOperations(ctx context.Context, path []string, amount string) (string, error) {
+	query := SimulateSwapOperationsQuery{
+		SimulateSwapOperations: SimulateSwapOperationsParams{
+			OfferAmount: amount,
+			Operations:  NewSwapOperations(path),
+		},
+	}
+
+	var response smartQueryResponse[SimulateSwapOperationsResponse]
+	if err := c.smartQuery(ctx, c.routerAddress, query, &response); err != nil {
+		return "", err
+	}
+	return response.Data.Amount, nil
+}
+
+// ReverseSimulateSwapOperations returns the input the swaps through every denom pair of the path need
+// to deliver askAmount
+func (c *Client) ReverseSimulateSwapOperations(ctx context.Context, path []string, askAmount string) (string, error) {
+	query := ReverseSimulateSwapOperationsQuery{
+		ReverseSimulateSwapOperations: ReverseSimulateSwapOperationsParams{
+			AskAmount:  askAmount,
+			Operations: NewSwapOperations(path),
+		},
+	}
+
+	var response smartQueryResponse[SimulateSwapOperationsResponse]
+	if err := c.smartQuery(ctx, c.routerAddress, query, &response); err != nil {
+		return "", err
+	}
+	return response.Data.Amount, nil
+}
+
+// smartQuery runs a cosmwasm smart query against a contract and decodes the response into out
+func (c *Client) smartQuery(ctx context.Context, contractAddress string, query any, out any) error {
+	if len(c.lcdURLs) == 0 {
+		return fmt.Errorf("no LCD endpoints configured")
+	}
+
+	queryBytes, err := json.Marshal(query)
+	if err != nil {
+		return fmt.Errorf("failed to marshal query: %w", err)
+	}
+	path := fmt.Sprintf("/cosmwasm/wasm/v1/contract/%s/smart/%s",
+		contractAddress, base64.StdEncoding.EncodeToString(queryBytes))
+
+	var lastErr error
+	for _, lcdURL := range c.lcdURLs {
+		body, status, err := c.get(ctx, lcdURL+path)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			lastErr = err
+			log.Debug().Err(err).Str("url", lcdURL).Msg("LCD request failed, trying next endpoint")
+			continue
+		}
+
+		if status != http.StatusOK {
+			lastErr = &queryError{status: status, body: string(body)}
+			continue
+		}
+
+		if err := json.Unmarshal(body, out); err != nil {
+			return fmt.Errorf("failed to parse smart query response: %w", err)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("smart query failed on all %d endpoints: %w", len(c.lcdURLs), lastErr)
+}
+
+func (c *Client) get(ctx context.Context, url string) ([]byte, int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, 0, err
+	}
+	defer func() {
+		_ = resp.Body.Close()
+	}()
+
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, 0, err
+	}
+	return body, resp.StatusCode, nil
+}
@@ -0,0 +1,253 @@
+package astroport
+
+import (
+	"fmt"
+
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// Ensure MemoBuilder implements ibcmemo.MemoBuilder
+var _ ibcmemo.MemoBuilder = (*MemoBuilder)(nil)
+
+// MemoBuilder builds IBC memo structures for Astroport swap operations on Neutron
+// using the Skip Go ibc-hooks entry point contract.
+// The memo layout is the same as on Osmosis, only the swap venue and the pools
+// (Astroport pair contracts instead of pool IDs) differ.
+// Implements ibcmemo.MemoBuilder interface.
+type MemoBuilder struct {
+	contractAddress string
+}
+
+// NewMemoBuilder creates a new Astroport memo builder for the given entry point contract
+func NewMemoBuilder(contractAddress string) *MemoBuilder {
+	return &MemoBuilder{
+		contractAddress: contractAddress,
+	}
+}
+
+// GetContractAddress returns the ibc-hooks contract address
+func (b *MemoBuilder) GetContractAddress() string {
+	return b.contractAddress
+}
+
+// BuildSwapMemo creates a wasm memo for swap operations (case 2 from doc.go: Transfer and Swap).
+// Used when: Source -> Broker (swap) -> stays on Broker
+func (b *MemoBuilder) BuildSwapMemo(params ibcmemo.SwapMemoParams) (string, error) {
+	memo, err := buildSwapAndAction(b.contractAddress, params, ibcmemo.NewTransferAction(params.ReceiverAddress))
+	if err != nil {
+		return "", err
+	}
+	return memo.ToJSON()
+}
+
+// BuildSwapAndForwardMemo creates a wasm memo for swap + IBC forward (case 5.1 from doc.go).
+// Used when: Source -> Broker (swap) -> Destination (single hop)
+func (b *MemoBuilder) BuildSwapAndForwardMemo(params ibcmemo.SwapAndForwardParams) (string, error) {
+	memo, err := buildSwapAndAction(b.contractAddress, params.SwapMemoParams, ibcmemo.NewIBCTransferAction(
+		params.SourceChannel,
+		params.ForwardReceiver,
+		params.ForwardMemo,
+		params.RecoverAddress,
+	))
+	if err != nil {
+		return "", err
+	}
+	return memo.ToJSON()
+}
+
+// BuildSwapAndMultiHopMemo creates a wasm memo for swap + multi-hop forward (case 5.3 from doc.go).
+// Used when: Source -> Broker (swap) -> Intermediate -> Destination
+func (b *MemoBuilder) BuildSwapAndMultiHopMemo(params ibcmemo.SwapAndMultiHopParams) (string, error) {
+	action, err := multiHopTransferAction(params)
+	if err != nil {
+		return "", err
+	}
+
+	memo, err := buildSwapAndAction(b.contractAddress, params.SwapMemoParams, action)
+	if err != nil {
+		return "", err
+	}
+	return memo.ToJSON()
+}
+
+// BuildForwardSwapMemo creates a forward memo wrapping wasm (case 5.2 from doc.go).
+// Used when: Source -> Intermediate(s) (forward) -> Broker (swap) -> Destination
+func (b *MemoBuilder) BuildForwardSwapMemo(params ibcmemo.ForwardSwapParams) (string, error) {
+	if len(params.InboundHops) == 0 {
+		return "", fmt.Errorf("no inbound hops provided")
+	}
+
+	swap := params.SwapParams
+	wasmMemo, err := buildSwapAndAction(b.contractAddress, swap.SwapMemoParams, ibcmemo.NewIBCTransferAction(
+		swap.SourceChannel,
+		swap.ForwardReceiver,
+		swap.ForwardMemo,
+		swap.RecoverAddress,
+	))
+	if err != nil {
+		return "", err
+	}
+
+	return wrapInboundForwards(b.contractAddress, params.InboundHops, wasmMemo)
+}
+
+// BuildForwardSwapForwardMemo creates a forward memo wrapping wasm with nested forward (case 5.4).
+// Used when: Source -> Intermediate(s) (forward) -> Broker (swap) -> Intermediate(s) -> Destination
+func (b *MemoBuilder) BuildForwardSwapForwardMemo(params ibcmemo.ForwardSwapForwardParams) (string, error) {
+	if len(params.InboundHops) == 0 {
+		return "", fmt.Errorf("no inbound hops provided")
+	}
+
+	action, err := multiHopTransferAction(params.SwapParams)
+	if err != nil {
+		return "", err
+	}
+
+	wasmMemo, err := buildSwapAndAction(b.contractAddress, params.SwapParams.SwapMemoParams, action)
+	if err != nil {
+		return "", err
+	}
+
+	return wrapInboundForwards(b.contractAddress, params.InboundHops, wasmMemo)
+}
+
+// BuildHopAndSwapMemo creates a memo for a hop and swap (case 6.1 from doc.go).
+// Used when: Source -> Intermediate -> Broker (swap) -> stays on Broker
+// The memo is attached to the first transfer, it forwards from the intermediate chain into the swap.
+func (b *MemoBuilder) BuildHopAndSwapMemo(params ibcmemo.HopAndSwapParams) (string, error) {
+	if len(params.InboundHops) != 2 {
+		return "", fmt.Errorf("hop and swap requires exactly two inbound hops, got %d", len(params.InboundHops))
+	}
+
+	receiverAddr := params.SwapParams.ReceiverAddress
+	if receiverAddr == "" {
+		receiverAddr = params.SwapParams.ForwardReceiver
+	}
+
+	wasmMemo, err := buildSwapAndAction(
+		b.contractAddress,
+		params.SwapParams.SwapMemoParams,
+		ibcmemo.NewTransferAction(receiverAddr),
+	)
+	if err != nil {
+		return "", err
+	}
+
+	hop := params.InboundHops[1] // Second leg: intermediate -> broker (in forward memo)
+	memoForward := ibcmemo.NewNestedForward(
+		hop.Channel,
+		hop.Port,
+		b.contractAddress,
+		hop.Retries,
+		hop.Timeout,
+		ibcmemo.NewPFMNextWithWasm(wasmMemo),
+	)
+
+	return memoForward.ToJSON()
+}
+
+// buildSwapAndAction builds the swap_and_action wasm message for the entry point contract
+func buildSwapAndAction(
+	contractAddress string,
+	params ibcmemo.SwapMemoParams,
+	action *ibcmemo.PostSwapAction,
+) (*ibcmemo.WasmMemo, error) {
+	if contractAddress == "" {
+		return nil, fmt.Errorf("ibc-hooks contract address not configured")
+	}
+
+	routeData, ok := params.RouteData.(*RouteData)
+	if !ok {
+		return nil, fmt.Errorf("route data is not Astroport RouteData type")
+	}
+
+	operations := routeData.GetOperations()
+	if len(operations) == 0 {
+		return nil, fmt.Errorf("no swap operations available")
+	}
+
+	return ibcmemo.NewWasmMemo(
+		contractAddress,
+		ibcmemo.NewWasmMsg(
+			ibcmemo.NewSwapAndAction(
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params),
+				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
+				params.TimeoutTimestamp,
+				action,
+			),
+		),
+	), nil
+}
+
+// multiHopTransferAction builds the post swap IBC transfer for the first outbound hop,
+// with the remaining hops nested in its PFM memo
+func multiHopTransferAction(params ibcmemo.SwapAndMultiHopParams) (*ibcmemo.PostSwapAction, error) {
+	if len(params.OutboundHops) == 0 {
+		return nil, fmt.Errorf("no outbound hops provided")
+	}
+
+	forwardMemo := ""
+	if len(params.OutboundHops) > 1 {
+		nestedForward := ibcmemo.BuildNestedForwardMemo(params.OutboundHops[1:], params.FinalReceiver)
+		var err error
+		forwardMemo, err = nestedForward.ToJSON()
+		if err != nil {
+			return nil, fmt.Errorf("failed to build nested forward memo: %w", err)
+		}
+	}
+
+	firstHopReceiver := params.FinalReceiver
+	if params.OutboundHops[0].Receiver != "" {
+		firstHopReceiver = params.OutboundHops[0].Receiver
+	}
+
+	return ibcmemo.NewIBCTransferAction(
+		params.OutboundHops[0].Channel,
+		firstHopReceiver,
+		forwardMemo,
+		params.RecoverAddress,
+	), nil
+}
+
+// wrapInboundForwards wraps the wasm memo in PFM forwards for every inbound hop,
+// from the hop closest to the broker out to the hop leaving the source chain
+func wrapInboundForwards(contractAddress string, hops []ibcmemo.IBCHop, wasmMemo *ibcmemo.WasmMemo) (string, error) {
+	currentNext := ibcmemo.NewPFMNextWithWasm(wasmMemo)
+
+	timeouts := ibcmemo.ForwardTimeouts(hops)
+	for i := len(hops) - 1; i >= 0; i-- {
+		hop := hops[i]
+		// Last hop goes to the contract, intermediate hops use the converted address or "pfm"
+		receiver := hop.Receiver
+		if receiver == "" && i == len(hops)-1 {
+			receiver = contractAddress
+		}
+		if receiver == "" {
+			receiver = ibcmemo.PFMIntermediateReceiver
+		}
+
+		if i == 0 {
+			forwardMemo := ibcmemo.NewForwardMemoWithNext(
+				hop.Channel,
+				hop.Port,
+				receiver,
+				hop.Retries,
+				timeouts[i],
+				currentNext,
+			)
+			return forwardMemo.ToJSON()
+		}
+
+		nestedForward := ibcmemo.NewNestedForward(
+			hop.Channel,
+			hop.Port,
+			receiver,
+			hop.Retries,
+			timeouts[i],
+			currentNext,
+		)
+		currentNext = ibcmemo.NewPFMNextWithForward(nestedForward)
+	}
+
+	return "", fmt.Errorf("no inbound hops provided")
+}
@@ -0,0 +1,205 @@
+// Package astroport provides Astroport-specific implementations for the broker interface.
+// Quotes are computed by simulating the swap operations on the Astroport router contract through
+// the chain's LCD (cosmwasm smart queries), and swaps are executed through the Skip Go
+// entry point contract with the "neutron-astroport" swap venue.
+//
+// Astroport doesn't report the USD liquidity of its pairs, routes through Astroport can't be
+// checked against a minimum liquidity unless the broker is set to accept unknown liquidity.
+package astroport
+
+import (
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+const (
+	// SwapVenueName is the swap venue identifier for Astroport on Neutron
+	SwapVenueName = "neutron-astroport"
+)
+
+// RouteData contains Astroport-specific routing information.
+// The router simulates the whole route at once, so only the amounts going in and out of the route are known.
+// Implements the brokers.RouteData interface.
+type RouteData struct {
+	Hops      []Hop  `json:"hops"`
+	AmountIn  string `json:"amount_in"`
+	AmountOut string `json:"amount_out"`
+}
+
+// Hop is a single swap through an Astroport pair contract
+type Hop struct {
+	PairAddress string `json:"pair_address"`
+	PairType    string `json:"pair_type"`
+	DenomIn     string `json:"denom_in"`
+	DenomOut    string `json:"denom_out"`
+	// Fee of the pair as a fraction (e.g. "0.003"), empty if the factory doesn't report it
+	FeeRate string `json:"fee_rate"`
+}
+
+// GetOperations implements brokers.RouteData interface
+// Every hop maps to one swap operation with the pair contract as the pool
+func (r *RouteData) GetOperations() []ibcmemo.SwapOperation {
+	if len(r.Hops) == 0 {
+		return nil
+	}
+
+	operations := make([]ibcmemo.SwapOperation, len(r.Hops))
+	for i, hop := range r.Hops {
+		operations[i] = ibcmemo.NewSwapOperation(hop.PairAddress, hop.DenomIn, hop.DenomOut)
+	}
+	return operations
+}
+
+// GetSwapVenueName implements brokers.RouteData interface
+func (r *RouteData) GetSwapVenueName() string {
+	return SwapVenueName
+}
+
+// AssetInfo identifies a token in Astroport queries.
+// Only native tokens (including IBC denoms) are supported, CW20 tokens are not routed.
+type AssetInfo struct {
+	NativeToken *NativeToken `json:"native_token,omitempty"`
+}
+
+// NativeToken is a bank module denom
+type NativeToken struct {
+	Denom string `json:"denom"`
+}
+
+// Asset is an amount of a token
+type Asset struct {
+	Info   AssetInfo `json:"info"`
+	Amount string    `json:"amount"`
+}
+
+// NewNativeAssetInfo creates an AssetInfo for a native denom
+func NewNativeAssetInfo(denom string) AssetInfo {
+	return AssetInfo{NativeToken: &NativeToken{Denom: denom}}
+}
+
+// PairQuery is the factory query used to look up a pair by its assets
+type PairQuery struct {
+	Pair PairQueryAssets `json:"pair"`
+}
+
+// PairQueryAssets holds the two assets of the pair
+type PairQueryAssets struct {
+	AssetInfos []AssetInfo `json:"asset_infos"`
+}
+
+// PairInfo is the factory response for a pair
+type PairInfo struct {
+	AssetInfos     []AssetInfo               `json:"asset_infos"`
+	ContractAddr   string                    `json:"contract_addr"`
+	LiquidityToken string                    `json:"liquidity_token"`
+	PairType       map[string]map[string]any `json:"pair_type"`
+}
+
+// Type returns the pair type name, e.g. "xyk" or "stable"
+func (p PairInfo) Type() string {
+	for pairType := range p.PairType {
+		return pairType
+	}
+	return ""
+}
+
+// FactoryConfigQuery is the factory query for the settings of every pair type
+type FactoryConfigQuery struct {
+	Config struct{} `json:"config"`
+}
+
+// FactoryConfigResponse is the factory response with the settings of every pair type
+type FactoryConfigResponse struct {
+	PairConfigs []PairConfig `json:"pair_configs"`
+}
+
+// PairConfig holds the settings of a pair type
+type PairConfig struct {
+	PairType map[string]map[string]any `json:"pair_type"`
+	// Fee taken by pairs of the type in basis points
+	TotalFeeBps int `json:"total_fee_bps"`
+}
+
+// Type returns the pair type name the config is for
+func (p PairConfig) Type() string {
+	for pairType := range p.PairType {
+		return pairType
+	}
+	return ""
+}
+
+// PoolQuery is the pair query for the reserves of the pair
+type PoolQuery struct {
+	Pool struct{} `json:"pool"`
+}
+
+// PoolResponse is the pair response with its reserves
+type PoolResponse struct {
+	Assets     []Asset `json:"assets"`
+	TotalShare string  `json:"total_share"`
+}
+
+// Reserve returns the reserve of the denom, false if the denom isn't an asset of the pair
+func (p PoolResponse) Reserve(denom string) (string, bool) {
+	for _, asset := range p.Assets {
+		if asset.Info.NativeToken != nil && asset.Info.NativeToken.Denom == denom {
+			return asset.Amount, true
+		}
+	}
+	return "", false
+}
+
+// SwapOperation is a single swap of the router, through the pair of the two assets
+type SwapOperation struct {
+	AstroSwap AstroSwap `json:"astro_swap"`
+}
+
+// AstroSwap holds the assets of a swap through an Astroport pair
+type AstroSwap struct {
+	OfferAssetInfo AssetInfo `json:"offer_asset_info"`
+	AskAssetInfo   AssetInfo `json:"ask_asset_info"`
+}
+
+// NewSwapOperations returns the router operations swapping through every consecutive denom pair of the path
+func NewSwapOperations(path []string) []SwapOperation {
+	operations := make([]SwapOperation, 0, len(path)-1)
+	for i := 0; i < len(path)-1; i++ {
+		operations = append(operations, SwapOperation{AstroSwap: AstroSwap{
+			OfferAssetInfo: NewNativeAssetInfo(path[i]),
+			AskAssetInfo:   NewNativeAssetInfo(path[i+1]),
+		}})
+	}
+	return operations
+}
+
+// SimulateSwapOperationsQuery is the router query for the output of offering an amount to the operations
+type SimulateSwapOperationsQuery struct {
+	SimulateSwapOperations SimulateSwapOperationsParams `json:"simulate_swap_operations"`
+}
+
+// SimulateSwapOperationsParams holds the offered amount and the operations it is swapped through
+type SimulateSwapOperationsParams struct {
+	OfferAmount string          `json:"offer_amount"`
+	Operations  []SwapOperation `json:"operations"`
+}
+
+// ReverseSimulateSwapOperationsQuery is the router query for the input the operations need for an exact output
+type ReverseSimulateSwapOperationsQuery struct {
+	ReverseSimulateSwapOperations ReverseSimulateSwapOperationsParams `json:"reverse_simulate_swap_operations"`
+}
+
+// ReverseSimulateSwapOperationsParams holds the asked amount and the operations it is swapped through
+type ReverseSimulateSwapOperationsParams struct {
+	AskAmount  string          `json:"ask_amount"`
+	Operations []SwapOperation `json:"operations"`
+}
+
+// SimulateSwapOperationsResponse is the router response to both simulations,
+// the output for a simulation and the needed input for a reverse simulation
+type SimulateSwapOperationsResponse struct {
+	Amount string `json:"amount"`
+}
+
+// smartQueryResponse wraps every cosmwasm smart query response returned by the LCD
+type smartQueryResponse[T any] struct {
+	Data T `json:"data"`
+}
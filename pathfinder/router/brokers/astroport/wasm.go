@@ -0,0 +1,38 @@
+package astroport
+
+import (
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// Ensure SmartContractBuilder implements brokers.SmartContractBuilder
+var _ brokers.SmartContractBuilder = (*SmartContractBuilder)(nil)
+
+// SmartContractBuilder builds entry point contract calls for swaps that start on Neutron
+type SmartContractBuilder struct {
+	contractAddress string
+}
+
+// NewSmartContractBuilder creates a new Astroport smart contract builder for the given entry point contract
+func NewSmartContractBuilder(contractAddress string) *SmartContractBuilder {
+	return &SmartContractBuilder{
+		contractAddress: contractAddress,
+	}
+}
+
+// BuildSwapAndTransfer builds the contract call for a swap that stays on the broker chain
+// ( check ibc_memo doc.go case 3 ), returned as data instead of a stringified memo.
+func (b *SmartContractBuilder) BuildSwapAndTransfer(params ibcmemo.SwapMemoParams) (*ibcmemo.WasmMemo, error) {
+	return buildSwapAndAction(b.contractAddress, params, ibcmemo.NewTransferAction(params.ReceiverAddress))
+}
+
+// BuildSwapAndForward builds the contract call for a swap followed by an IBC transfer
+// ( check ibc_memo doc.go case 4 ), returned as data instead of a stringified memo.
+func (b *SmartContractBuilder) BuildSwapAndForward(params ibcmemo.SwapAndForwardParams) (*ibcmemo.WasmMemo, error) {
+	return buildSwapAndAction(b.contractAddress, params.SwapMemoParams, ibcmemo.NewIBCTransferAction(
+		params.SourceChannel,
+		params.ForwardReceiver,
+		params.ForwardMemo,
+		params.RecoverAddress,
+	))
+}
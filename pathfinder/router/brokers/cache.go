@@ -0,0 +1,269 @@
+package brokers
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	"golang.org/x/sync/singleflight"
+)
+
+const (
+	// DefaultCacheTTL is how long a quote is reused when the broker config doesn't set cache_ttl
+	DefaultCacheTTL = 5 * time.Second
+
+	// cacheSweepSize is the number of entries after which expired quotes are swept on insert
+	cacheSweepSize = 1024
+
+	meterName = "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+// ScalableRouteData is implemented by route data that can be moved to a nearby input amount.
+// The quote cache uses it to serve amounts falling in the same bucket as a cached quote,
+// route data without it is only reused for the exact amount it was quoted for.
+type ScalableRouteData interface {
+	RouteData
+	// ScaleAmountIn returns a copy of the route data swapping amountIn in total
+	ScaleAmountIn(amountIn string) RouteData
+}
+
+// CacheConfig configures the quote cache in front of a broker client
+type CacheConfig struct {
+	// TTL is how long a quote is reused, zero disables the cache
+	TTL time.Duration
+	// AmountDigits is the number of significant digits of the amount used as the cache key.
+	// Amounts that only differ after these digits share a quote scaled to the requested amount.
+	// Zero keys on the exact amount.
+	AmountDigits int
+}
+
+// CachedClient is a BrokerClient decorator that reuses quotes for a short time.
+// Concurrent identical queries are sent to the broker once and share the result.
+// Failed queries are never cached.
+// A caller whose context is done stops waiting, the shared query runs with the context of the first caller.
+type CachedClient struct {
+	BrokerClient
+
+	config CacheConfig
+	group  singleflight.Group
+
+	mu      sync.Mutex
+	entries map[string]cacheEntry
+
+	hits   metric.Int64Counter
+	misses metric.Int64Counter
+	attrs  metric.MeasurementOption
+}
+
+type cacheEntry struct {
+	// amount is the exact amount the result was quoted for
+	amount    string
+	result    *SwapResult
+	expiresAt time.Time
+}
+
+// Ensure CachedClient implements BrokerClient
+var _ BrokerClient = (*CachedClient)(nil)
+
+// NewCachedClient wraps client with a quote cache.
+// Hit and miss counters are reported through the global OTel meter provider.
+func NewCachedClient(client BrokerClient, config CacheConfig) *CachedClient {
+	meter := otel.GetMeterProvider().Meter(meterName)
+	// The instruments fall back to no-ops if they can't be created
+	hits, _ := meter.Int64Counter("pathfinder.broker.quote_cache.hits",
+		metric.WithDescription("Broker quotes served from the cache"))
+	misses, _ := meter.Int64Counter("pathfinder.broker.quote_cache.misses",
+		metric.WithDescription("Broker quotes that had to be queried"))
+
+	return &CachedClient{
+		BrokerClient: client,
+		config:       config,
+		entries:      make(map[string]cacheEntry),
+		hits:         hits,
+		misses:       misses,
+		attrs:        metric.WithAttributes(attribute.String("broker", client.GetBrokerType())),
+	}
+}
+
+// QuerySwap returns the cached exact input quote or queries the wrapped client
+func (c *CachedClient) QuerySwap(
+	ctx context.Context,
+	tokenInDenom, tokenInAmount, tokenOutDenom string,
+	singleRoute *bool,
+) (*SwapResult, error) {
+	key := c.key("in", tokenInDenom, tokenInAmount, tokenOutDenom, singleRoute)
+	return c.query(ctx, key, tokenInAmount, false, func() (*SwapResult, error) {
+		return c.BrokerClient.QuerySwap(ctx, tokenInDenom, tokenInAmount, tokenOutDenom, singleRoute)
+	})
+}
+
+// QuerySwapExactOut returns the cached exact output quote or queries the wrapped client
+func (c *CachedClient) QuerySwapExactOut(
+	ctx context.Context,
+	tokenInDenom, tokenOutDenom, tokenOutAmount string,
+	singleRoute *bool,
+) (*SwapResult, error) {
+	key := c.key("out", tokenInDenom, tokenOutAmount, tokenOutDenom, singleRoute)
+	return c.query(ctx, key, tokenOutAmount, true, func() (*SwapResult, error) {
+		return c.BrokerClient.QuerySwapExactOut(ctx, tokenInDenom, tokenOutDenom, tokenOutAmount, singleRoute)
+	})
+}
+
+// query serves the quote for key from the cache, otherwise runs fetch once for all concurrent callers.
+// amount is the requested input, or the requested output when exactOut is set.
+func (c *CachedClient) query(
+	ctx context.Context,
+	key, amount string,
+	exactOut bool,
+	fetch func() (*SwapResult, error),
+) (*SwapResult, error) {
+	if result, ok := c.lookup(key, amount, exactOut); ok {
+		c.hits.Add(ctx, 1, c.attrs)
+		return result, nil
+	}
+	c.misses.Add(ctx, 1, c.attrs)
+
+	fetchAndStore := func() (interface{}, error) {
+		result, err := fetch()
+		if err != nil {
+			return nil, err
+		}
+		c.store(key, amount, result)
+		return result, nil
+	}
+
+	// Callers in the same bucket but with a different amount need their own quote
+	var value interface{}
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case shared := <-c.group.DoChan(key+"|"+amount, fetchAndStore):
+		value = shared.Val
+		if err := shared.Err; err != nil {
+			// The caller that started the query gave up, this one still wants a quote
+			if !isContextError(err) || ctx.Err() != nil {
+				return nil, err
+			}
+			if value, err = fetchAndStore(); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	// Every caller gets its own copy, the route data is shared and read only
+	result := *value.(*SwapResult)
+	return &result, nil
+}
+
+func isContextError(err error) bool {
+	return errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)
+}
+
+// lookup returns a copy of the cached quote for key, scaled to amount if it was quoted for a different one
+func (c *CachedClient) lookup(key, amount string, exactOut bool) (*SwapResult, bool) {
+	c.mu.Lock()
+	entry, ok := c.entries[key]
+	if ok && time.Now().After(entry.expiresAt) {
+		delete(c.entries, key)
+		ok = false
+	}
+	c.mu.Unlock()
+
+	if !ok {
+		return nil, false
+	}
+	if entry.amount == amount {
+		result := *entry.result
+		return &result, true
+	}
+	return scaleSwapResult(entry.result, entry.amount, amount, exactOut)
+}
+
+func (c *CachedClient) store(key, amount string, result *SwapResult) {
+	now := time.Now()
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if len(c.entries) >= cacheSweepSize {
+		for k, entry := range c.entries {
+			if now.After(entry.expiresAt) {
+				delete(c.entries, k)
+			}
+		}
+	}
+	c.entries[key] = cacheEntry{
+		amount:    amount,
+		result:    result,
+		expiresAt: now.Add(c.config.TTL),
+	}
+}
+
+// key builds the cache key of a quote, the amount is reduced to its bucket
+func (c *CachedClient) key(mode, tokenInDenom, amount, tokenOutDenom string, singleRoute *bool) string {
+	single := singleRoute != nil && *singleRoute
+	return fmt.Sprintf("%s|%s|%s|%s|%t", mode, tokenInDenom, amountBucket(amount, c.config.AmountDigits), tokenOutDenom, single)
+}
+
+// amountBucket keeps the first digits significant digits of amount and zeroes the rest.
+// With digits of zero, or an amount that is not an integer, the amount is returned as is.
+func amountBucket(amount string, digits int) string {
+	if digits <= 0 || len(amount) <= digits {
+		return amount
+	}
+	for _, r := range amount {
+		if r < '0' || r > '9' {
+			return amount
+		}
+	}
+	bucket := []byte(amount)
+	for i := digits; i < len(bucket); i++ {
+		bucket[i] = '0'
+	}
+	return string(bucket)
+}
+
+// scaleSwapResult moves a quote for quotedAmount to the nearby requestedAmount, assuming the price is the same.
+// Exact input quotes scale the output down, exact output quotes scale the input up so it is never short.
+// It fails if the route data can't be scaled.
+func scaleSwapResult(result *SwapResult, quotedAmount, requestedAmount string, exactOut bool) (*SwapResult, bool) {
+	routeData, ok := result.RouteData.(ScalableRouteData)
+	if !ok {
+		return nil, false
+	}
+
+	quoted, err := amount.Parse(quotedAmount)
+	if err != nil || quoted.IsZero() {
+		return nil, false
+	}
+	requested, err := amount.Parse(requestedAmount)
+	if err != nil {
+		return nil, false
+	}
+
+	scaled := *result
+	if exactOut {
+		amountIn, err := amount.Parse(result.AmountIn)
+		if err != nil {
+			return nil, false
+		}
+		scaled.AmountIn = amountIn.MulDivCeil(requested, quoted).String()
+		scaled.AmountOut = requestedAmount
+	} else {
+		amountOut, err := amount.Parse(result.AmountOut)
+		if err != nil {
+			return nil, false
+		}
+		scaled.AmountIn = requestedAmount
+		scaled.AmountOut = amountOut.MulDivFloor(requested, quoted).String()
+	}
+	scaled.RouteData = routeData.ScaleAmountIn(scaled.AmountIn)
+
+	return &scaled, true
+}
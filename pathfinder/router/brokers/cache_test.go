@@ -0,0 +1,239 @@
+package brokers_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// countingClient quotes every swap at a price of 10 and counts the queries it receives
+type countingClient struct {
+	brokers.BrokerClient
+
+	calls    atomic.Int32
+	err      error
+	scalable bool
+	release  chan struct{}
+	// failFirst is returned by the first query only
+	failFirst error
+}
+
+func (c *countingClient) QuerySwap(ctx context.Context, tokenInDenom, tokenInAmount, tokenOutDenom string, singleRoute *bool) (*brokers.SwapResult, error) {
+	call := c.calls.Add(1)
+	if c.release != nil {
+		<-c.release
+	}
+	if c.failFirst != nil && call == 1 {
+		return nil, c.failFirst
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return &brokers.SwapResult{
+		AmountIn:  tokenInAmount,
+		AmountOut: tokenInAmount + "0",
+		RouteData: c.routeData(tokenInAmount),
+	}, nil
+}
+
+func (c *countingClient) QuerySwapExactOut(ctx context.Context, tokenInDenom, tokenOutDenom, tokenOutAmount string, singleRoute *bool) (*brokers.SwapResult, error) {
+	c.calls.Add(1)
+	return &brokers.SwapResult{
+		AmountIn:  tokenOutAmount + "0",
+		AmountOut: tokenOutAmount,
+		RouteData: c.routeData(tokenOutAmount + "0"),
+	}, nil
+}
+
+func (c *countingClient) GetBrokerType() string {
+	return "counting"
+}
+
+func (c *countingClient) routeData(amountIn string) brokers.RouteData {
+	if c.scalable {
+		return scalableRouteData{amountIn: amountIn}
+	}
+	return fixedRouteData{}
+}
+
+type fixedRouteData struct{}
+
+func (fixedRouteData) GetOperations() []ibcmemo.SwapOperation { return nil }
+func (fixedRouteData) GetSwapVenueName() string               { return "test" }
+
+type scalableRouteData struct {
+	fixedRouteData
+	amountIn string
+}
+
+func (d scalableRouteData) ScaleAmountIn(amountIn string) brokers.RouteData {
+	return scalableRouteData{amountIn: amountIn}
+}
+
+func TestCachedClient_ReusesQuoteWithinTTL(t *testing.T) {
+	client := &countingClient{}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute})
+
+	first, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+	second, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, client.calls.Load(), int32(1))
+	assert.Equal(t, second.AmountOut, first.AmountOut)
+
+	// Other pairs, amounts, modes and route settings are separate quotes
+	singleRoute := true
+	_, _ = cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", &singleRoute)
+	_, _ = cached.QuerySwap(t.Context(), "uosmo", "1001", "uatom", nil)
+	_, _ = cached.QuerySwap(t.Context(), "uatom", "1000", "uosmo", nil)
+	_, _ = cached.QuerySwapExactOut(t.Context(), "uosmo", "uatom", "1000", nil)
+	assert.Equal(t, client.calls.Load(), int32(5))
+}
+
+func TestCachedClient_Expires(t *testing.T) {
+	client := &countingClient{}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: 10 * time.Millisecond})
+
+	_, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+	time.Sleep(20 * time.Millisecond)
+	_, err = cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, client.calls.Load(), int32(2))
+}
+
+func TestCachedClient_DoesNotCacheErrors(t *testing.T) {
+	client := &countingClient{err: errors.New("no route")}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute})
+
+	_, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.Error(t, err)
+
+	client.err = nil
+	result, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, result.AmountOut, "10000")
+	assert.Equal(t, client.calls.Load(), int32(2))
+}
+
+func TestCachedClient_DeduplicatesConcurrentQueries(t *testing.T) {
+	client := &countingClient{release: make(chan struct{})}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute})
+
+	var wg sync.WaitGroup
+	results := make([]*brokers.SwapResult, 8)
+	for i := range results {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			results[i], _ = cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+		}(i)
+	}
+
+	// Give the callers time to join the in flight query before it returns
+	time.Sleep(20 * time.Millisecond)
+	close(client.release)
+	wg.Wait()
+
+	assert.Equal(t, client.calls.Load(), int32(1))
+	for _, result := range results {
+		assert.NotNil(t, result)
+		assert.Equal(t, result.AmountOut, "10000")
+	}
+}
+
+func TestCachedClient_ScalesQuotesInTheSameBucket(t *testing.T) {
+	client := &countingClient{scalable: true}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute, AmountDigits: 2})
+
+	_, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+
+	// 1050 shares the 1000 bucket and gets the cached price of 10 per unit
+	result, err := cached.QuerySwap(t.Context(), "uosmo", "1050", "uatom", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, client.calls.Load(), int32(1))
+	assert.Equal(t, result.AmountIn, "1050")
+	assert.Equal(t, result.AmountOut, "10500")
+	assert.Equal(t, result.RouteData.(scalableRouteData).amountIn, "1050")
+
+	// Exact output quotes scale the input
+	_, err = cached.QuerySwapExactOut(t.Context(), "uosmo", "uatom", "1000", nil)
+	assert.NoError(t, err)
+	result, err = cached.QuerySwapExactOut(t.Context(), "uosmo", "uatom", "1099", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, client.calls.Load(), int32(2))
+	assert.Equal(t, result.AmountIn, "10990")
+	assert.Equal(t, result.AmountOut, "1099")
+
+	// 1100 is in the next bucket
+	_, err = cached.QuerySwap(t.Context(), "uosmo", "1100", "uatom", nil)
+	assert.NoError(t, err)
+	assert.Equal(t, client.calls.Load(), int32(3))
+}
+
+func TestCachedClient_QueriesUnscalableRouteData(t *testing.T) {
+	client := &countingClient{}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute, AmountDigits: 2})
+
+	_, err := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+	assert.NoError(t, err)
+	result, err := cached.QuerySwap(t.Context(), "uosmo", "1050", "uatom", nil)
+	assert.NoError(t, err)
+
+	assert.Equal(t, client.calls.Load(), int32(2))
+	assert.Equal(t, result.AmountOut, "10500")
+}
+
+func TestCachedClient_CancelledCallerStopsWaiting(t *testing.T) {
+	client := &countingClient{release: make(chan struct{})}
+	defer close(client.release)
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute})
+
+	ctx, cancel := context.WithTimeout(t.Context(), 20*time.Millisecond)
+	defer cancel()
+
+	_, err := cached.QuerySwap(ctx, "uosmo", "1000", "uatom", nil)
+	assert.True(t, errors.Is(err, context.DeadlineExceeded))
+}
+
+func TestCachedClient_RequeriesWhenTheSharedQueryIsCancelled(t *testing.T) {
+	client := &countingClient{failFirst: context.Canceled, release: make(chan struct{})}
+	cached := brokers.NewCachedClient(client, brokers.CacheConfig{TTL: time.Minute})
+
+	// The first caller starts the query and gives up, the second one waits for it
+	ctx, cancel := context.WithCancel(t.Context())
+	first := make(chan error)
+	go func() {
+		_, err := cached.QuerySwap(ctx, "uosmo", "1000", "uatom", nil)
+		first <- err
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	second := make(chan *brokers.SwapResult)
+	go func() {
+		result, _ := cached.QuerySwap(t.Context(), "uosmo", "1000", "uatom", nil)
+		second <- result
+	}()
+	time.Sleep(10 * time.Millisecond)
+
+	cancel()
+	assert.True(t, errors.Is(<-first, context.Canceled))
+
+	// The shared query fails with the context error of the first caller
+	close(client.release)
+	result := <-second
+	assert.NotNil(t, result)
+	assert.Equal(t, result.AmountOut, "10000")
+	assert.Equal(t, client.calls.Load(), int32(2))
+}
@@ -1,21 +1,31 @@
 // Package brokers defines interfaces and common types for DEX broker integrations.
 // Each supported broker chain (Osmosis, Neutron, etc.) implements these interfaces.
-// For now it only works for Osmosis and it all relies on Skip Go Wasm Smart Contracts.
+// Implementations exist for Osmosis (SQS) and Astroport on Neutron, both rely on Skip Go Wasm Smart Contracts.
 package brokers
 
 import (
+	"context"
+
+	"github.com/shopspring/decimal"
+
 	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
 )
 
 // BrokerClient is an interface for querying different DEX protocols on broker chains.
 // Each broker (Osmosis, Neutron, etc.) implements this interface with their specific API.
+// Queries take the context of the route request and must give up once it is done.
 type BrokerClient interface {
-	// QuerySwap queries the broker DEX for a swap route and returns standardized swap information.
+	// QuerySwap queries the broker DEX for an exact input swap route and returns standardized swap information.
 	// tokenInDenom: the denom of the input token on the broker chain (may be IBC denom)
 	// tokenInAmount: the amount of input tokens
 	// tokenOutDenom: the denom of the desired output token on the broker chain (may be IBC denom)
 	// singleRoute: if true, only return a single route, if false, return all possible routes
-	QuerySwap(tokenInDenom, tokenInAmount, tokenOutDenom string, singleRoute *bool) (*SwapResult, error)
+	QuerySwap(ctx context.Context, tokenInDenom, tokenInAmount, tokenOutDenom string, singleRoute *bool) (*SwapResult, error)
+
+	// QuerySwapExactOut queries the broker DEX for an exact output swap route.
+	// The returned SwapResult has AmountOut set to tokenOutAmount and AmountIn set to the
+	// input needed to receive it. Route data must be usable with swap_exact_asset_out.
+	QuerySwapExactOut(ctx context.Context, tokenInDenom, tokenOutDenom, tokenOutAmount string, singleRoute *bool) (*SwapResult, error)
 
 	// GetBrokerType returns the type of broker (e.g., "osmosis-sqs", "astroport", etc.)
 	GetBrokerType() string
@@ -64,8 +74,31 @@ type RouteData interface {
 	GetSwapVenueName() string
 }
 
+// TakerFeeRouteData is implemented by route data that knows the taker fees of its pools.
+// Taker fees are charged on top of the swap fees in the quote, route data without it is assumed to have none.
+type TakerFeeRouteData interface {
+	RouteData
+	// TakerFee returns the fraction of the trade charged as taker fees (e.g., 0.001 for 0.1%)
+	TakerFee() decimal.Decimal
+}
+
+// LiquidityRouteData is implemented by route data that knows the liquidity of its pools.
+// Route data without it can't be checked against a minimum liquidity.
+type LiquidityRouteData interface {
+	RouteData
+	// MinLiquidity returns the smallest USD liquidity of the pools the swap trades through,
+	// known is false if no pool reports its liquidity
+	MinLiquidity() (liquidity decimal.Decimal, known bool)
+}
+
 // SlippageCalculator calculates minimum output with slippage tolerance.
 // slippageBps is basis points (e.g., 100 = 1%)
 func CalculateMinOutput(expectedOutput string, slippageBps uint32) (string, error) {
 	return calculateMinOutputInternal(expectedOutput, slippageBps)
 }
+
+// CalculateMaxInput calculates the maximum input for an exact output swap with slippage tolerance.
+// slippageBps is basis points (e.g., 100 = 1%)
+func CalculateMaxInput(expectedInput string, slippageBps uint32) (string, error) {
+	return calculateMaxInputInternal(expectedInput, slippageBps)
+}
@@ -1,6 +1,7 @@
 package osmosis
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -16,8 +17,13 @@ var log zerolog.Logger
 func init() {
 	out := zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}
 	log = zerolog.New(out).With().Timestamp().Str("component", "osmosis-broker").Logger()
+
+	brokers.Register(BrokerType, newFromConfig)
 }
 
+// BrokerType is the broker type the SQS broker is registered under
+const BrokerType = "osmosis-sqs"
+
 // SqsBroker implements brokers.BrokerClient for Osmosis using the SQS API
 type SqsBroker struct {
 	client               *sqsquery.SqsQueryClient
@@ -43,8 +49,48 @@ func NewSqsBrokerWithFailover(sqsApiUrls []string, contractAddress string) *SqsB
 	}
 }
 
+// NewSqsBrokerWithConfig creates a new Osmosis SQS broker client with custom failover settings
+func NewSqsBrokerWithConfig(
+	sqsApiUrls []string,
+	contractAddress string,
+	failoverConfig sqsquery.FailoverConfig,
+) *SqsBroker {
+	return &SqsBroker{
+		client:               sqsquery.NewSqsQueryClientWithFailover(sqsApiUrls, failoverConfig),
+		memoBuilder:          NewMemoBuilder(contractAddress),
+		smartContractBuilder: NewSmartContractBuilder(contractAddress),
+	}
+}
+
+// newFromConfig is the brokers.Factory for the SQS broker.
+// Failover settings missing from the config fall back to sqsquery.DefaultFailoverConfig.
+func newFromConfig(cfg brokers.Config) (brokers.BrokerClient, error) {
+	failoverConfig := sqsquery.DefaultFailoverConfig()
+	if cfg.MaxRetries > 0 {
+		failoverConfig.MaxRetries = cfg.MaxRetries
+	}
+	if cfg.RetryDelay > 0 {
+		failoverConfig.RetryDelay = cfg.RetryDelay
+	}
+	if cfg.Timeout > 0 {
+		failoverConfig.Timeout = cfg.Timeout
+	}
+	if cfg.HealthCheckInterval > 0 {
+		failoverConfig.HealthCheckInterval = cfg.HealthCheckInterval
+	}
+
+	log.Info().
+		Str("broker", cfg.Id).
+		Strs("urls", cfg.Endpoints).
+		Int("count", len(cfg.Endpoints)).
+		Msg("Osmosis SQS broker initialized")
+
+	return NewSqsBrokerWithConfig(cfg.Endpoints, cfg.ContractAddress, failoverConfig), nil
+}
+
 // QuerySwap implements brokers.BrokerClient interface for Osmosis SQS
 func (o *SqsBroker) QuerySwap(
+	ctx context.Context,
 	tokenInDenom, tokenInAmount, tokenOutDenom string,
 	singleRoute *bool,
 ) (*brokers.SwapResult, error) {
@@ -65,7 +111,7 @@ func (o *SqsBroker) QuerySwap(
 		singleRoute = new(bool)
 		*singleRoute = false
 	}
-	response, err := o.client.GetRoute(tokenIn, nil, nil, &tokenOutDenom, *singleRoute)
+	response, err := o.client.GetRoute(ctx, tokenIn, nil, nil, &tokenOutDenom, *singleRoute)
 	if err != nil {
 		log.Error().Err(err).
 			Str("tokenIn", tokenInDenom).
@@ -93,9 +139,51 @@ func (o *SqsBroker) QuerySwap(
 	}, nil
 }
 
+// QuerySwapExactOut implements brokers.BrokerClient interface for Osmosis SQS
+func (o *SqsBroker) QuerySwapExactOut(
+	ctx context.Context,
+	tokenInDenom, tokenOutDenom, tokenOutAmount string,
+	singleRoute *bool,
+) (*brokers.SwapResult, error) {
+	log.Debug().
+		Str("tokenIn", tokenInDenom).
+		Str("tokenOut", tokenOutDenom).
+		Str("amountOut", tokenOutAmount).
+		Msg("Querying SQS for exact out swap route")
+
+	tokenOut := &sqsquery.TokenRequest{
+		Denom:  tokenOutDenom,
+		Amount: tokenOutAmount,
+	}
+
+	// The entry point contract can't split an exact out swap, so always ask for a single route
+	response, err := o.client.GetRoute(ctx, nil, tokenOut, &tokenInDenom, nil, true)
+	if err != nil {
+		log.Error().Err(err).
+			Str("tokenIn", tokenInDenom).
+			Str("tokenOut", tokenOutDenom).
+			Msg("SQS exact out query failed")
+		return nil, err
+	}
+
+	log.Debug().
+		Str("amountIn", response.AmountIn.Amount).
+		Str("amountOut", tokenOutAmount).
+		Str("priceImpact", response.PriceImpact).
+		Msg("SQS exact out query successful")
+
+	return &brokers.SwapResult{
+		AmountIn:     response.AmountIn.Amount,
+		AmountOut:    tokenOutAmount,
+		PriceImpact:  response.PriceImpact,
+		EffectiveFee: response.EffectiveFee,
+		RouteData:    ConvertSqsExactOutResponseToRouteData(response, tokenOutDenom),
+	}, nil
+}
+
 // GetBrokerType returns the broker type identifier
 func (o *SqsBroker) GetBrokerType() string {
-	return "osmosis-sqs"
+	return BrokerType
 }
 
 // GetMemoBuilder returns the memo builder for Osmosis
@@ -63,7 +63,7 @@ func (b *MemoBuilder) BuildSwapMemo(params ibcmemo.SwapMemoParams) (string, erro
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params),
 				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
 				params.TimeoutTimestamp,
 				ibcmemo.NewTransferAction(params.ReceiverAddress),
@@ -121,7 +121,7 @@ func (b *MemoBuilder) BuildSwapAndForwardMemo(params ibcmemo.SwapAndForwardParam
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
 				params.TimeoutTimestamp,
 				ibcmemo.NewIBCTransferAction(
@@ -206,7 +206,7 @@ func (b *MemoBuilder) BuildSwapAndMultiHopMemo(params ibcmemo.SwapAndMultiHopPar
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
 				params.TimeoutTimestamp,
 				ibcmemo.NewIBCTransferAction(
@@ -284,7 +284,7 @@ func (b *MemoBuilder) BuildForwardSwapMemo(params ibcmemo.ForwardSwapParams) (st
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapParams.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.SwapParams.TokenOutDenom, params.SwapParams.MinOutputAmount),
 				params.SwapParams.TimeoutTimestamp,
 				ibcmemo.NewIBCTransferAction(
@@ -302,6 +302,7 @@ func (b *MemoBuilder) BuildForwardSwapMemo(params ibcmemo.ForwardSwapParams) (st
 	currentNext := ibcmemo.NewPFMNextWithWasm(wasmMemo)
 
 	// Build forwards from last hop (closest to broker) to first hop (closest to source)
+	timeouts := ibcmemo.ForwardTimeouts(params.InboundHops)
 	for i := len(params.InboundHops) - 1; i >= 0; i-- {
 		hop := params.InboundHops[i]
 		// Use receiver from pathfinder (address converter) when set; last hop = contract, else fallback to "pfm"
@@ -319,8 +320,8 @@ func (b *MemoBuilder) BuildForwardSwapMemo(params ibcmemo.ForwardSwapParams) (st
 				hop.Channel,
 				hop.Port,
 				receiver,
-				ibcmemo.DefaultRetries(),
-				hop.Timeout,
+				hop.Retries,
+				timeouts[i],
 				currentNext,
 			)
 			return forwardMemo.ToJSON()
@@ -331,8 +332,8 @@ func (b *MemoBuilder) BuildForwardSwapMemo(params ibcmemo.ForwardSwapParams) (st
 			hop.Channel,
 			hop.Port,
 			receiver,
-			ibcmemo.DefaultRetries(),
-			hop.Timeout,
+			hop.Retries,
+			timeouts[i],
 			currentNext,
 		)
 		currentNext = ibcmemo.NewPFMNextWithForward(nestedForward)
@@ -393,7 +394,7 @@ func (b *MemoBuilder) BuildForwardSwapForwardMemo(params ibcmemo.ForwardSwapForw
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapParams.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.SwapParams.TokenOutDenom, params.SwapParams.MinOutputAmount),
 				params.SwapParams.TimeoutTimestamp,
 				ibcmemo.NewIBCTransferAction(
@@ -410,6 +411,7 @@ func (b *MemoBuilder) BuildForwardSwapForwardMemo(params ibcmemo.ForwardSwapForw
 	currentNext := ibcmemo.NewPFMNextWithWasm(wasmMemo)
 
 	// Build forwards from last hop (closest to broker) to first hop (closest to source)
+	timeouts := ibcmemo.ForwardTimeouts(params.InboundHops)
 	for i := len(params.InboundHops) - 1; i >= 0; i-- {
 		hop := params.InboundHops[i]
 		// Use receiver from pathfinder (address converter) when set; last hop = contract, else fallback to "pfm"
@@ -427,8 +429,8 @@ func (b *MemoBuilder) BuildForwardSwapForwardMemo(params ibcmemo.ForwardSwapForw
 				hop.Channel,
 				hop.Port,
 				receiver,
-				ibcmemo.DefaultRetries(),
-				hop.Timeout,
+				hop.Retries,
+				timeouts[i],
 				currentNext,
 			)
 			return forwardMemo.ToJSON()
@@ -439,8 +441,8 @@ func (b *MemoBuilder) BuildForwardSwapForwardMemo(params ibcmemo.ForwardSwapForw
 			hop.Channel,
 			hop.Port,
 			receiver,
-			ibcmemo.DefaultRetries(),
-			hop.Timeout,
+			hop.Retries,
+			timeouts[i],
 			currentNext,
 		)
 		currentNext = ibcmemo.NewPFMNextWithForward(nestedForward)
@@ -514,7 +516,7 @@ func (b *MemoBuilder) BuildHopAndSwapMemo(params ibcmemo.HopAndSwapParams) (stri
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapParams.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.SwapParams.TokenOutDenom, params.SwapParams.MinOutputAmount),
 				params.SwapParams.TimeoutTimestamp,
 				ibcmemo.NewTransferAction(receiverAddr),
@@ -525,8 +527,8 @@ func (b *MemoBuilder) BuildHopAndSwapMemo(params ibcmemo.HopAndSwapParams) (stri
 		hop1.Channel,
 		hop1.Port,
 		b.contractAddress,
-		ibcmemo.DefaultRetries(),
-		params.SwapParams.TimeoutTimestamp,
+		hop1.Retries,
+		hop1.Timeout,
 		ibcmemo.NewPFMNextWithWasm(wasmMemo),
 	)
 
@@ -0,0 +1,113 @@
+package osmosis_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/osmosis"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+const entryPoint = "osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u"
+
+func swapParams(routeData *osmosis.RouteData) ibcmemo.SwapMemoParams {
+	return ibcmemo.SwapMemoParams{
+		TokenInDenom:     "uosmo",
+		TokenOutDenom:    "ibc/USDC",
+		MinOutputAmount:  "990000",
+		RouteData:        routeData,
+		TimeoutTimestamp: 1769790211797082680,
+		RecoverAddress:   "osmo1recover",
+		ReceiverAddress:  "osmo1receiver",
+	}
+}
+
+func decodeUserSwap(t *testing.T, memo string) ibcmemo.UserSwap {
+	t.Helper()
+
+	var decoded ibcmemo.WasmMemo
+	assert.NoError(t, json.Unmarshal([]byte(memo), &decoded))
+	return *decoded.Wasm.Msg.SwapAndAction.UserSwap
+}
+
+func TestMemoBuilder_SingleRouteSwap(t *testing.T) {
+	routeData := &osmosis.RouteData{
+		Routes: []osmosis.Route{
+			{
+				InAmount: "1000000",
+				Pools: []osmosis.Pool{
+					{ID: 1, TokenOutDenom: "uatom"},
+					{ID: 2, TokenOutDenom: "ibc/USDC"},
+				},
+			},
+		},
+	}
+
+	memo, err := osmosis.NewMemoBuilder(entryPoint).BuildSwapMemo(swapParams(routeData))
+	assert.NoError(t, err)
+
+	userSwap := decodeUserSwap(t, memo)
+	assert.Nil(t, userSwap.SmartSwapExactAssetIn)
+	assert.NotNil(t, userSwap.SwapExactAssetIn)
+	assert.Equal(t, userSwap.SwapExactAssetIn.Operations, []ibcmemo.SwapOperation{
+		{Pool: "1", DenomIn: "uosmo", DenomOut: "uatom"},
+		{Pool: "2", DenomIn: "uatom", DenomOut: "ibc/USDC"},
+	})
+}
+
+func TestMemoBuilder_SplitRouteSwap(t *testing.T) {
+	routeData := &osmosis.RouteData{
+		Routes: []osmosis.Route{
+			{
+				InAmount: "700000",
+				Pools:    []osmosis.Pool{{ID: 1, TokenOutDenom: "ibc/USDC"}},
+			},
+			{
+				InAmount: "300000",
+				Pools: []osmosis.Pool{
+					{ID: 5, TokenOutDenom: "uatom"},
+					{ID: 7, TokenOutDenom: "ibc/USDC"},
+				},
+			},
+		},
+	}
+
+	memo, err := osmosis.NewMemoBuilder(entryPoint).BuildSwapMemo(swapParams(routeData))
+	assert.NoError(t, err)
+
+	userSwap := decodeUserSwap(t, memo)
+	assert.Nil(t, userSwap.SwapExactAssetIn)
+	assert.NotNil(t, userSwap.SmartSwapExactAssetIn)
+	assert.Equal(t, userSwap.SmartSwapExactAssetIn.SwapVenueName, osmosis.SwapVenueName)
+
+	routes := userSwap.SmartSwapExactAssetIn.Routes
+	assert.Equal(t, len(routes), 2)
+	assert.Equal(t, *routes[0].OfferAsset.Native, ibcmemo.Asset{Denom: "uosmo", Amount: "700000"})
+	assert.Equal(t, routes[0].Operations, []ibcmemo.SwapOperation{
+		{Pool: "1", DenomIn: "uosmo", DenomOut: "ibc/USDC"},
+	})
+	assert.Equal(t, *routes[1].OfferAsset.Native, ibcmemo.Asset{Denom: "uosmo", Amount: "300000"})
+	assert.Equal(t, routes[1].Operations, []ibcmemo.SwapOperation{
+		{Pool: "5", DenomIn: "uosmo", DenomOut: "uatom"},
+		{Pool: "7", DenomIn: "uatom", DenomOut: "ibc/USDC"},
+	})
+}
+
+func TestSmartContractBuilder_SplitRouteSwap(t *testing.T) {
+	routeData := &osmosis.RouteData{
+		Routes: []osmosis.Route{
+			{InAmount: "600000", Pools: []osmosis.Pool{{ID: 1, TokenOutDenom: "ibc/USDC"}}},
+			{InAmount: "400000", Pools: []osmosis.Pool{{ID: 3, TokenOutDenom: "ibc/USDC"}}},
+		},
+	}
+
+	data, err := osmosis.NewSmartContractBuilder(entryPoint).BuildSwapAndTransfer(swapParams(routeData))
+	assert.NoError(t, err)
+
+	userSwap := data.Wasm.Msg.SwapAndAction.UserSwap
+	assert.NotNil(t, userSwap.SmartSwapExactAssetIn)
+	assert.Equal(t, len(userSwap.SmartSwapExactAssetIn.Routes), 2)
+	assert.Equal(t, data.Wasm.Msg.SwapAndAction.MinAsset.Native.Amount, "990000")
+}
@@ -4,6 +4,10 @@ package osmosis
 import (
 	"strconv"
 
+	"github.com/shopspring/decimal"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
 	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
 	sqsquery "github.com/Cogwheel-Validator/spectra-portal/pathfinder/sqs_query"
 )
@@ -13,6 +17,13 @@ const (
 	SwapVenueName = "osmosis-poolmanager"
 )
 
+// Ensure RouteData can be scaled by the quote cache and reports its taker fees and liquidity
+var (
+	_ brokers.ScalableRouteData  = (*RouteData)(nil)
+	_ brokers.TakerFeeRouteData  = (*RouteData)(nil)
+	_ brokers.LiquidityRouteData = (*RouteData)(nil)
+)
+
 // RouteData contains Osmosis-specific routing information.
 // Implements the ibcmemo.RouteData interface.
 type RouteData struct {
@@ -55,15 +66,31 @@ func (r *RouteData) GetOperations() []ibcmemo.SwapOperation {
 	return nil // Caller needs to provide tokenInDenom, use GetOperationsWithInput
 }
 
-// GetOperationsWithInput converts route data to swap operations with the given input denom
+// GetOperationsWithInput converts route data to swap operations with the given input denom.
+// Only the first route is used, split routes are built with GetSplitRoutes.
 func (r *RouteData) GetOperationsWithInput(tokenInDenom string) []ibcmemo.SwapOperation {
 	if len(r.Routes) == 0 || len(r.Routes[0].Pools) == 0 {
 		return nil
 	}
 
-	// This assumes the request made to the pathfinder used the singe route set to true
-	// TODO: address this part somehow
-	route := r.Routes[0]
+	return r.Routes[0].operations(tokenInDenom)
+}
+
+// GetSplitRoutes implements ibcmemo.SplitRouteData interface.
+// Each SQS route becomes a swap route offering its own in amount.
+func (r *RouteData) GetSplitRoutes(tokenInDenom string) []ibcmemo.SwapRoute {
+	routes := make([]ibcmemo.SwapRoute, 0, len(r.Routes))
+	for _, route := range r.Routes {
+		if len(route.Pools) == 0 {
+			continue
+		}
+		routes = append(routes, ibcmemo.NewSwapRoute(tokenInDenom, route.InAmount, route.operations(tokenInDenom)))
+	}
+	return routes
+}
+
+// operations converts the pools of the route to swap operations starting from the given input denom
+func (route Route) operations(tokenInDenom string) []ibcmemo.SwapOperation {
 	operations := make([]ibcmemo.SwapOperation, len(route.Pools))
 	currentDenomIn := tokenInDenom
 
@@ -80,6 +107,118 @@ func (r *RouteData) GetOperationsWithInput(tokenInDenom string) []ibcmemo.SwapOp
 	return operations
 }
 
+// ScaleAmountIn implements brokers.ScalableRouteData interface.
+// The in and out amounts of every route are scaled by the same ratio, the input left over
+// from rounding goes to the first route so the route inputs add up to amountIn.
+func (r *RouteData) ScaleAmountIn(amountIn string) brokers.RouteData {
+	scaled := *r
+	scaled.Routes = make([]Route, len(r.Routes))
+	copy(scaled.Routes, r.Routes)
+
+	target, err := amount.Parse(amountIn)
+	if err != nil {
+		return &scaled
+	}
+	total := amount.Zero
+	for _, route := range r.Routes {
+		routeIn, err := amount.Parse(route.InAmount)
+		if err != nil {
+			return &scaled
+		}
+		total = total.Add(routeIn)
+	}
+	if total.IsZero() {
+		return &scaled
+	}
+
+	assigned := amount.Zero
+	for i, route := range r.Routes {
+		routeIn := amount.MustParse(route.InAmount)
+		scaledIn := routeIn.MulDivFloor(target, total)
+		assigned = assigned.Add(scaledIn)
+		scaled.Routes[i].InAmount = scaledIn.String()
+
+		if routeOut, err := amount.Parse(route.OutAmount); err == nil {
+			scaled.Routes[i].OutAmount = routeOut.MulDivFloor(target, total).String()
+		}
+	}
+	if len(scaled.Routes) > 0 {
+		firstIn := amount.MustParse(scaled.Routes[0].InAmount)
+		scaled.Routes[0].InAmount = firstIn.Add(target.Sub(assigned)).String()
+	}
+
+	return &scaled
+}
+
+// TakerFee implements brokers.TakerFeeRouteData interface.
+// The taker fees of the pools in a route compound, split routes are weighted by their in amount.
+func (r *RouteData) TakerFee() decimal.Decimal {
+	one := decimal.NewFromInt(1)
+	weighted := decimal.Zero
+	total := decimal.Zero
+
+	for _, route := range r.Routes {
+		kept := one
+		for _, pool := range route.Pools {
+			takerFee, err := decimal.NewFromString(pool.TakerFee)
+			if err != nil || takerFee.IsNegative() {
+				continue
+			}
+			kept = kept.Mul(one.Sub(takerFee))
+		}
+
+		// Routes without a usable in amount count as one unit, so a single route is still weighted fully
+		weight, err := decimal.NewFromString(route.InAmount)
+		if err != nil || !weight.IsPositive() {
+			weight = one
+		}
+		weighted = weighted.Add(one.Sub(kept).Mul(weight))
+		total = total.Add(weight)
+	}
+
+	if !total.IsPositive() {
+		return decimal.Zero
+	}
+	return weighted.Div(total)
+}
+
+// MinLiquidity implements brokers.LiquidityRouteData interface.
+// The liquidity caps of the pools are used, the cap of the whole quote only if no pool has one.
+// A pool without a readable cap could be the thinnest one, so the liquidity is unknown if any pool lacks one.
+// An overflowing quote cap is too large for SQS to report, so it is left out.
+func (r *RouteData) MinLiquidity() (decimal.Decimal, bool) {
+	var minLiquidity decimal.Decimal
+	pools, capped := 0, 0
+	for _, route := range r.Routes {
+		for _, pool := range route.Pools {
+			pools++
+			if pool.LiquidityCap == "" {
+				continue
+			}
+			liquidity, err := decimal.NewFromString(pool.LiquidityCap)
+			if err != nil {
+				return decimal.Zero, false
+			}
+			if capped == 0 || liquidity.LessThan(minLiquidity) {
+				minLiquidity = liquidity
+			}
+			capped++
+		}
+	}
+	if capped > 0 {
+		return minLiquidity, capped == pools
+	}
+
+	if r.LiquidityCapOverflow {
+		return decimal.Zero, false
+	}
+	liquidity, err := decimal.NewFromString(r.LiquidityCap)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return liquidity, true
+}
+
 // GetSwapVenueName implements ibcmemo.RouteData interface
 func (r *RouteData) GetSwapVenueName() string {
 	return SwapVenueName
@@ -117,3 +256,26 @@ func ConvertSqsResponseToRouteData(sqsResponse sqsquery.RouteTokenResponse) *Rou
 		LiquidityCapOverflow: sqsResponse.LiquidityCapOverflow,
 	}
 }
+
+// ConvertSqsExactOutResponseToRouteData converts an exact amount out SQS response to typed RouteData.
+// Exact out quotes name the input denom of every pool instead of the output denom, so the output
+// of each pool is the input of the next one and the last pool outputs tokenOutDenom.
+func ConvertSqsExactOutResponseToRouteData(sqsResponse sqsquery.RouteTokenResponse, tokenOutDenom string) *RouteData {
+	routeData := ConvertSqsResponseToRouteData(sqsResponse)
+
+	for i, sqsRoute := range sqsResponse.Route {
+		pools := routeData.Routes[i].Pools
+		for j := range pools {
+			if pools[j].TokenOutDenom != "" {
+				continue
+			}
+			if j+1 < len(sqsRoute.Pools) {
+				pools[j].TokenOutDenom = sqsRoute.Pools[j+1].TokenInDenom
+			} else {
+				pools[j].TokenOutDenom = tokenOutDenom
+			}
+		}
+	}
+
+	return routeData
+}
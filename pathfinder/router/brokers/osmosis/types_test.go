@@ -0,0 +1,63 @@
+package osmosis_test
+
+import (
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/osmosis"
+)
+
+func TestRouteData_TakerFee(t *testing.T) {
+	// The taker fees of a route compound, split routes are weighted by their in amount
+	routeData := &osmosis.RouteData{
+		Routes: []osmosis.Route{
+			{InAmount: "600", Pools: []osmosis.Pool{{ID: 1, TakerFee: "0.001"}, {ID: 2, TakerFee: "0.002"}}},
+			{InAmount: "400", Pools: []osmosis.Pool{{ID: 3, TakerFee: "0.001"}}},
+		},
+	}
+	assert.Equal(t, routeData.TakerFee().String(), "0.0021988")
+
+	// Pools without a taker fee don't charge one
+	routeData = &osmosis.RouteData{
+		Routes: []osmosis.Route{{InAmount: "1000", Pools: []osmosis.Pool{{ID: 1, TakerFee: ""}}}},
+	}
+	assert.True(t, routeData.TakerFee().IsZero())
+	assert.True(t, (&osmosis.RouteData{}).TakerFee().IsZero())
+}
+
+func TestRouteData_MinLiquidity(t *testing.T) {
+	// The thinnest pool of every route counts
+	routeData := &osmosis.RouteData{
+		Routes: []osmosis.Route{
+			{Pools: []osmosis.Pool{{ID: 1, LiquidityCap: "2500000"}, {ID: 2, LiquidityCap: "12000"}}},
+			{Pools: []osmosis.Pool{{ID: 3, LiquidityCap: "800000"}}},
+		},
+		LiquidityCap: "3312000",
+	}
+	liquidity, known := routeData.MinLiquidity()
+	assert.True(t, known)
+	assert.Equal(t, liquidity.String(), "12000")
+
+	// Without pool caps the cap of the quote is used, unless it overflowed
+	routeData = &osmosis.RouteData{Routes: []osmosis.Route{{Pools: []osmosis.Pool{{ID: 1}}}}, LiquidityCap: "5000"}
+	liquidity, known = routeData.MinLiquidity()
+	assert.True(t, known)
+	assert.Equal(t, liquidity.String(), "5000")
+
+	routeData.LiquidityCapOverflow = true
+	_, known = routeData.MinLiquidity()
+	assert.False(t, known)
+	_, known = (&osmosis.RouteData{}).MinLiquidity()
+	assert.False(t, known)
+
+	// A pool with an unreadable or without a cap could be the thinnest one
+	for _, liquidityCap := range []string{"n/a", ""} {
+		routeData = &osmosis.RouteData{
+			Routes:       []osmosis.Route{{Pools: []osmosis.Pool{{ID: 1, LiquidityCap: "2500000"}, {ID: 2, LiquidityCap: liquidityCap}}}},
+			LiquidityCap: "3312000",
+		}
+		_, known = routeData.MinLiquidity()
+		assert.False(t, known)
+	}
+}
@@ -42,7 +42,7 @@ func (b *SmartContractBuilder) BuildSwapAndTransfer(params ibcmemo.SwapMemoParam
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params),
 				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
 				params.TimeoutTimestamp,
 				ibcmemo.NewTransferAction(params.ReceiverAddress),
@@ -75,7 +75,7 @@ func (b *SmartContractBuilder) BuildSwapAndForward(params ibcmemo.SwapAndForward
 		b.contractAddress,
 		ibcmemo.NewWasmMsg(
 			ibcmemo.NewSwapAndAction(
-				ibcmemo.NewUserSwap(SwapVenueName, operations),
+				ibcmemo.NewUserSwapFromParams(SwapVenueName, operations, params.SwapMemoParams),
 				ibcmemo.NewMinAsset(params.TokenOutDenom, params.MinOutputAmount),
 				params.TimeoutTimestamp,
 				ibcmemo.NewIBCTransferAction(params.SourceChannel, params.ForwardReceiver, params.ForwardMemo, params.RecoverAddress),
@@ -0,0 +1,141 @@
+package brokers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Config describes a single broker client instance.
+// It is built from the [[brokers]] section of the RPC config and passed to the factory registered for Type.
+type Config struct {
+	// Id is the broker id, it must match the broker_id of the broker chain in the chain config
+	Id string
+	// Type selects the registered broker implementation (e.g., "osmosis-sqs", "astroport")
+	Type string
+	// Endpoints are the API or LCD URLs of the broker, in order of preference
+	Endpoints []string
+	// ContractAddress is the ibc-hooks entry point contract on the broker chain
+	ContractAddress string
+
+	// Failover settings, zero values mean the implementation default
+	MaxRetries          int
+	RetryDelay          time.Duration
+	Timeout             time.Duration
+	HealthCheckInterval time.Duration
+
+	// Options holds implementation specific settings (e.g., "factory_address" for Astroport)
+	Options map[string]string
+
+	// Cache puts a quote cache in front of the client, a zero TTL leaves it out
+	Cache CacheConfig
+}
+
+// Option returns the implementation specific option, or def if it is not set
+func (c Config) Option(key, def string) string {
+	if value, ok := c.Options[key]; ok && value != "" {
+		return value
+	}
+	return def
+}
+
+// OptionList returns a comma separated option as a list, empty entries are dropped
+func (c Config) OptionList(key string) []string {
+	var values []string
+	for _, value := range strings.Split(c.Options[key], ",") {
+		if value = strings.TrimSpace(value); value != "" {
+			values = append(values, value)
+		}
+	}
+	return values
+}
+
+// Factory creates a broker client from its config
+type Factory func(cfg Config) (BrokerClient, error)
+
+var (
+	registryMu sync.RWMutex
+	factories  = make(map[string]Factory)
+)
+
+// Register makes a broker implementation available under the given type.
+// Implementations call it from their init function, registering the same type twice panics.
+func Register(brokerType string, factory Factory) {
+	registryMu.Lock()
+	defer registryMu.Unlock()
+
+	if factory == nil {
+		panic("brokers: Register factory is nil")
+	}
+	if _, exists := factories[brokerType]; exists {
+		panic("brokers: Register called twice for type " + brokerType)
+	}
+	factories[brokerType] = factory
+}
+
+// Types returns the sorted list of registered broker types
+func Types() []string {
+	registryMu.RLock()
+	defer registryMu.RUnlock()
+
+	types := make([]string, 0, len(factories))
+	for brokerType := range factories {
+		types = append(types, brokerType)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// New creates a broker client using the factory registered for cfg.Type,
+// wrapped in a quote cache if cfg.Cache has a TTL
+func New(cfg Config) (BrokerClient, error) {
+	registryMu.RLock()
+	factory, ok := factories[cfg.Type]
+	registryMu.RUnlock()
+
+	if !ok {
+		return nil, fmt.Errorf("unknown broker type %q (registered: %s)", cfg.Type, strings.Join(Types(), ", "))
+	}
+	if len(cfg.Endpoints) == 0 {
+		return nil, fmt.Errorf("broker %s has no endpoints", cfg.Id)
+	}
+
+	client, err := factory(cfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create broker %s: %w", cfg.Id, err)
+	}
+	if cfg.Cache.TTL > 0 {
+		return NewCachedClient(client, cfg.Cache), nil
+	}
+	return client, nil
+}
+
+// NewClients creates a broker client for every config, keyed by broker id.
+// If any broker fails to initialize, the clients created so far are closed.
+func NewClients(configs []Config) (map[string]BrokerClient, error) {
+	clients := make(map[string]BrokerClient, len(configs))
+
+	for _, cfg := range configs {
+		if _, exists := clients[cfg.Id]; exists {
+			closeClients(clients)
+			return nil, fmt.Errorf("duplicate broker id %s", cfg.Id)
+		}
+
+		client, err := New(cfg)
+		if err != nil {
+			closeClients(clients)
+			return nil, err
+		}
+		clients[cfg.Id] = client
+	}
+
+	return clients, nil
+}
+
+func closeClients(clients map[string]BrokerClient) {
+	for _, client := range clients {
+		client.Close()
+	}
+}
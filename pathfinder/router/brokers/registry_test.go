@@ -0,0 +1,66 @@
+package brokers_test
+
+import (
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	_ "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/all"
+)
+
+func TestRegistry_RegisteredTypes(t *testing.T) {
+	assert.DeepEqual(t, brokers.Types(), []string{"astroport", "osmosis-sqs"})
+}
+
+func TestRegistry_NewClients(t *testing.T) {
+	clients, err := brokers.NewClients([]brokers.Config{
+		{
+			Id:        "neutron-astroport",
+			Type:      "astroport",
+			Endpoints: []string{"http://127.0.0.1:1"},
+			Options:   map[string]string{"factory_address": "neutron1factory", "router_address": "neutron1router"},
+		},
+	})
+	assert.NoError(t, err)
+	defer clients["neutron-astroport"].Close()
+
+	assert.Equal(t, len(clients), 1)
+	assert.Equal(t, clients["neutron-astroport"].GetBrokerType(), "astroport")
+}
+
+func TestRegistry_NewClientsErrors(t *testing.T) {
+	cases := map[string][]brokers.Config{
+		"unknown type": {
+			{Id: "x", Type: "uniswap", Endpoints: []string{"http://127.0.0.1:1"}},
+		},
+		"no endpoints": {
+			{Id: "x", Type: "astroport", Options: map[string]string{"factory_address": "neutron1factory"}},
+		},
+		"missing option": {
+			{Id: "x", Type: "astroport", Endpoints: []string{"http://127.0.0.1:1"}},
+		},
+		"missing router": {
+			{Id: "x", Type: "astroport", Endpoints: []string{"http://127.0.0.1:1"}, Options: map[string]string{"factory_address": "f"}},
+		},
+		"duplicate id": {
+			{Id: "x", Type: "astroport", Endpoints: []string{"a"}, Options: map[string]string{"factory_address": "f", "router_address": "r"}},
+			{Id: "x", Type: "astroport", Endpoints: []string{"b"}, Options: map[string]string{"factory_address": "f", "router_address": "r"}},
+		},
+	}
+
+	for name, configs := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := brokers.NewClients(configs)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestConfig_Options(t *testing.T) {
+	cfg := brokers.Config{Options: map[string]string{"hop_denoms": " untrn,,ibc/USDC "}}
+
+	assert.DeepEqual(t, cfg.OptionList("hop_denoms"), []string{"untrn", "ibc/USDC"})
+	assert.Nil(t, cfg.OptionList("missing"))
+	assert.Equal(t, cfg.Option("missing", "default"), "default")
+}
@@ -2,21 +2,45 @@ package brokers
 
 import (
 	"fmt"
-	"strconv"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
 )
 
+// maxBps is 100% in basis points
+var maxBps = amount.FromUint64(10000)
+
 // calculateMinOutputInternal calculates minimum output with slippage tolerance.
 // slippageBps is basis points (e.g., 100 = 1%)
-// minOutput = expected * (10000 - slippageBps) / 10000
+// minOutput = floor(expected * (10000 - slippageBps) / 10000)
 func calculateMinOutputInternal(expectedOutput string, slippageBps uint32) (string, error) {
+	if slippageBps > 10000 {
+		return "", fmt.Errorf("slippage of %d bps is above 100%%", slippageBps)
+	}
+
 	// Parse the expected output
-	expected, err := strconv.ParseInt(expectedOutput, 10, 64)
+	expected, err := amount.Parse(expectedOutput)
 	if err != nil {
 		return "", fmt.Errorf("failed to parse expected output: %w", err)
 	}
 
 	// Calculate minimum with slippage
-	minOutput := expected * int64(10000-slippageBps) / 10000
+	minOutput := expected.MulDivFloor(amount.FromUint64(uint64(10000-slippageBps)), maxBps)
+
+	return minOutput.String(), nil
+}
+
+// calculateMaxInputInternal calculates maximum input with slippage tolerance, rounded up.
+// slippageBps is basis points (e.g., 100 = 1%)
+// maxInput = ceil(expected * (10000 + slippageBps) / 10000)
+func calculateMaxInputInternal(expectedInput string, slippageBps uint32) (string, error) {
+	// Parse the expected input
+	expected, err := amount.Parse(expectedInput)
+	if err != nil {
+		return "", fmt.Errorf("failed to parse expected input: %w", err)
+	}
+
+	// Calculate maximum with slippage, any remainder rounds up so the swap is never short
+	maxInput := expected.MulDivCeil(amount.FromUint64(10000+uint64(slippageBps)), maxBps)
 
-	return strconv.FormatInt(minOutput, 10), nil
+	return maxInput.String(), nil
 }
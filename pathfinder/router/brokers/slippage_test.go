@@ -0,0 +1,43 @@
+package brokers_test
+
+import (
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+func TestCalculateMaxInput(t *testing.T) {
+	maxInput, err := brokers.CalculateMaxInput("1000000", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, maxInput, "1010000")
+
+	// 12345 * 1.01 = 12468.45, rounded up so the swap is never short
+	maxInput, err = brokers.CalculateMaxInput("12345", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, maxInput, "12469")
+
+	_, err = brokers.CalculateMaxInput("not a number", 100)
+	assert.Error(t, err)
+}
+
+func TestCalculateMinOutput(t *testing.T) {
+	minOutput, err := brokers.CalculateMinOutput("1000000", 100)
+	assert.NoError(t, err)
+	assert.Equal(t, minOutput, "990000")
+
+	// 18 decimal tokens overflow int64 at 9.2 tokens
+	minOutput, err = brokers.CalculateMinOutput("25000000000000000000000", 50)
+	assert.NoError(t, err)
+	assert.Equal(t, minOutput, "24875000000000000000000")
+
+	maxInput, err := brokers.CalculateMaxInput("25000000000000000000001", 50)
+	assert.NoError(t, err)
+	assert.Equal(t, maxInput, "25125000000000000000002")
+
+	_, err = brokers.CalculateMinOutput("1000000", 10001)
+	assert.Error(t, err)
+	_, err = brokers.CalculateMinOutput("1.5", 100)
+	assert.Error(t, err)
+}
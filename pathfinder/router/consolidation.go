@@ -0,0 +1,223 @@
+package router
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+)
+
+/*
+PlanConsolidation plans moving every balance of req into TokenToDenom on ChainTo.
+
+Every balance is routed like a FindPath request with a smart route, the routes are found concurrently and
+share their broker quotes like in BatchFindPath. Balances that already are the target token on the target
+chain stay where they are, balances of tokens the chain doesn't know or without a route get an error.
+
+The transfers of the routes are grouped into as few transactions as possible, the routes starting on the
+same chain are sent by a single transaction there. Indirect routes are forwarded with PFM where every
+intermediate chain supports it (see checkPFMSupport), otherwise their next legs are sent in the next steps,
+again one transaction per chain and step. The transaction of a later leg depends on the ones sending the legs
+before it, it can only be broadcast once their transfers were received.
+
+Returns:
+- models.ConsolidationPlan: a route per balance and the transactions in the order they have to be broadcast
+- error: if the target token is unknown, a route can't be signed for or ctx is done
+*/
+func (s *Pathfinder) PlanConsolidation(ctx context.Context, req models.ConsolidationRequest) (models.ConsolidationPlan, error) {
+	s = s.current()
+
+	targetTokens, err := s.denomResolver.GetChainTokens(req.ChainTo)
+	if err != nil {
+		return models.ConsolidationPlan{}, fmt.Errorf("unknown target chain: %w", err)
+	}
+	if _, ok := findChainToken(targetTokens, req.TokenToDenom); !ok {
+		return models.ConsolidationPlan{}, fmt.Errorf("token %s is not available on %s", req.TokenToDenom, req.ChainTo)
+	}
+
+	plan := models.ConsolidationPlan{
+		Routes:       make([]models.ConsolidationRoute, len(req.Balances)),
+		Transactions: make([]models.PlannedTransaction, 0),
+	}
+	chainTokens := map[string]*models.ChainTokens{req.ChainTo: targetTokens}
+	routeReqs := make([]models.RouteRequest, 0, len(req.Balances))
+	routed := make([]int, 0, len(req.Balances))
+	for i, balance := range req.Balances {
+		plan.Routes[i].Balance = balance
+		if balance.ChainID == req.ChainTo && balance.Denom == req.TokenToDenom {
+			continue
+		}
+
+		routeReq, err := s.consolidationRouteRequest(req, balance, chainTokens)
+		if err != nil {
+			plan.Routes[i].Error = err.Error()
+			continue
+		}
+		routeReqs = append(routeReqs, routeReq)
+		routed = append(routed, i)
+	}
+
+	responses := s.BatchFindPath(ctx, routeReqs, DefaultBatchWorkers)
+	if err := ctx.Err(); err != nil {
+		return models.ConsolidationPlan{}, err
+	}
+	for j, i := range routed {
+		if !responses[j].Success {
+			plan.Routes[i].Error = responses[j].ErrorMessage
+			continue
+		}
+		plan.Routes[i].Route = &responses[j]
+	}
+
+	transactions, err := s.planTransactions(req.SenderAddress, plan.Routes)
+	if err != nil {
+		return models.ConsolidationPlan{}, err
+	}
+	plan.Transactions = transactions
+
+	pathfinderLog.Info().
+		Str("chainTo", req.ChainTo).
+		Str("tokenTo", req.TokenToDenom).
+		Int("balances", len(req.Balances)).
+		Int("transactions", len(plan.Transactions)).
+		Msg("Planned consolidation")
+	return plan, nil
+}
+
+// consolidationRouteRequest returns the route request that moves balance to the target of req.
+// chainTokens caches the tokens of the chains looked up so far.
+func (s *Pathfinder) consolidationRouteRequest(
+	req models.ConsolidationRequest,
+	balance models.Balance,
+	chainTokens map[string]*models.ChainTokens,
+) (models.RouteRequest, error) {
+	tokens, ok := chainTokens[balance.ChainID]
+	if !ok {
+		var err error
+		if tokens, err = s.denomResolver.GetChainTokens(balance.ChainID); err != nil {
+			return models.RouteRequest{}, err
+		}
+		chainTokens[balance.ChainID] = tokens
+	}
+	if _, ok := findChainToken(tokens, balance.Denom); !ok {
+		return models.RouteRequest{}, fmt.Errorf("token %s is not available on %s", balance.Denom, balance.ChainID)
+	}
+
+	sender, err := s.addressConverter.ConvertAddress(req.SenderAddress, balance.ChainID)
+	if err != nil {
+		return models.RouteRequest{}, fmt.Errorf("failed to derive the sender on %s: %w", balance.ChainID, err)
+	}
+
+	// The plan is executed with BuildTransaction, broker swaps need their execution data for it
+	smartRoute := true
+	return models.RouteRequest{
+		ChainFrom:       balance.ChainID,
+		TokenFromDenom:  balance.Denom,
+		AmountIn:        balance.Amount,
+		ChainTo:         req.ChainTo,
+		TokenToDenom:    req.TokenToDenom,
+		SenderAddress:   sender,
+		ReceiverAddress: req.ReceiverAddress,
+		SmartRoute:      &smartRoute,
+		SlippageBps:     req.SlippageBps,
+	}, nil
+}
+
+// findChainToken returns the token of a chain with the given chain denom
+func findChainToken(tokens *models.ChainTokens, denom string) (models.TokenDetails, bool) {
+	for _, token := range slices.Concat(tokens.NativeTokens, tokens.IBCTokens) {
+		if token.Denom == denom {
+			return token, true
+		}
+	}
+	return models.TokenDetails{}, false
+}
+
+// planTransactions groups the transfers of the found routes into one transaction per step and chain,
+// ordered by step and chain id. Signers are derived from sender.
+// A later leg of a route spends what the leg before delivered, its transaction depends on the one sending that leg.
+func (s *Pathfinder) planTransactions(sender string, routes []models.ConsolidationRoute) ([]models.PlannedTransaction, error) {
+	type plannedKey struct {
+		step    int
+		chainId string
+	}
+
+	planned := make(map[plannedKey]*models.PlannedTransaction)
+	dependsOn := make(map[plannedKey][]plannedKey)
+	for i, route := range routes {
+		if route.Route == nil {
+			continue
+		}
+
+		chainIds := transactionChains(*route.Route)
+		for step, chainId := range chainIds {
+			key := plannedKey{step: step, chainId: chainId}
+			if step > 0 {
+				previous := plannedKey{step: step - 1, chainId: chainIds[step-1]}
+				if !slices.Contains(dependsOn[key], previous) {
+					dependsOn[key] = append(dependsOn[key], previous)
+				}
+			}
+			transaction, ok := planned[key]
+			if !ok {
+				signer, err := s.addressConverter.ConvertAddress(sender, chainId)
+				if err != nil {
+					return nil, fmt.Errorf("failed to derive the signer on %s: %w", chainId, err)
+				}
+				transaction = &models.PlannedTransaction{Step: step, ChainID: chainId, Signer: signer}
+				planned[key] = transaction
+			}
+			transaction.Routes = append(transaction.Routes, i)
+		}
+	}
+
+	transactions := make([]models.PlannedTransaction, 0, len(planned))
+	for _, transaction := range planned {
+		transactions = append(transactions, *transaction)
+	}
+	slices.SortFunc(transactions, func(a, b models.PlannedTransaction) int {
+		return cmp.Or(cmp.Compare(a.Step, b.Step), strings.Compare(a.ChainID, b.ChainID))
+	})
+
+	indexes := make(map[plannedKey]int, len(transactions))
+	for i, transaction := range transactions {
+		indexes[plannedKey{step: transaction.Step, chainId: transaction.ChainID}] = i
+	}
+	for i := range transactions {
+		for _, previous := range dependsOn[plannedKey{step: transactions[i].Step, chainId: transactions[i].ChainID}] {
+			transactions[i].DependsOn = append(transactions[i].DependsOn, indexes[previous])
+		}
+		slices.Sort(transactions[i].DependsOn)
+	}
+	return transactions, nil
+}
+
+// transactionChains returns the chains of the transactions BuildTransactions builds for route, in their order
+func transactionChains(route models.RouteResponse) []string {
+	switch route.RouteType {
+	case "direct":
+		if route.Direct != nil && route.Direct.Transfer != nil {
+			return []string{route.Direct.Transfer.FromChain}
+		}
+	case "indirect":
+		if route.Indirect == nil || len(route.Indirect.Legs) == 0 {
+			return nil
+		}
+		if route.Indirect.SupportsPFM && route.Indirect.PFMMemo != "" {
+			return []string{route.Indirect.Legs[0].FromChain}
+		}
+		chainIds := make([]string, len(route.Indirect.Legs))
+		for i, leg := range route.Indirect.Legs {
+			chainIds[i] = leg.FromChain
+		}
+		return chainIds
+	case "broker_swap":
+		if route.BrokerSwap != nil && len(route.BrokerSwap.Path) > 0 {
+			return []string{route.BrokerSwap.Path[0]}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,143 @@
+package router_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+)
+
+// consolidateToOsmosisUSDC moves the given balances into USDC on Osmosis
+func consolidateToOsmosisUSDC(t *testing.T, balances ...models.Balance) models.ConsolidationRequest {
+	t.Helper()
+	return models.ConsolidationRequest{
+		Balances:        balances,
+		ChainTo:         "osmosis-1",
+		TokenToDenom:    junoToOsmosisUSDC.TokenToDenom,
+		SenderAddress:   addressOn(t, "cosmos"),
+		ReceiverAddress: addressOn(t, "osmo"),
+	}
+}
+
+func TestPathfinder_PlanConsolidation(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	plan, err := pathfinder.PlanConsolidation(t.Context(), consolidateToOsmosisUSDC(t,
+		models.Balance{ChainID: "juno-1", Denom: junoToOsmosisUSDC.TokenFromDenom, Amount: "5000000"},
+		models.Balance{ChainID: "cosmoshub-4", Denom: "uatom", Amount: "1000000"},
+		models.Balance{ChainID: "juno-1", Denom: "ujuno", Amount: "1000000"},
+		models.Balance{ChainID: "osmosis-1", Denom: junoToOsmosisUSDC.TokenToDenom, Amount: "1000000"},
+		models.Balance{ChainID: "cosmoshub-4", Denom: "unknown", Amount: "1000000"},
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, len(plan.Routes), 5)
+
+	assert.Equal(t, plan.Routes[0].Route.RouteType, "indirect")
+	assert.True(t, plan.Routes[0].Route.Indirect.SupportsPFM)
+	assert.Equal(t, plan.Routes[1].Route.RouteType, "broker_swap")
+	assert.Equal(t, plan.Routes[2].Route.RouteType, "broker_swap")
+	assert.NotNil(t, plan.Routes[2].Route.BrokerSwap.Execution)
+
+	// USDC on Osmosis already is the target
+	assert.Nil(t, plan.Routes[3].Route)
+	assert.Equal(t, plan.Routes[3].Error, "")
+	assert.Nil(t, plan.Routes[4].Route)
+	assert.Equal(t, plan.Routes[4].Error, "token unknown is not available on cosmoshub-4")
+
+	// Both Juno balances leave in one transaction, the USDC forwarded through Noble with PFM
+	assert.DeepEqual(t, plan.Transactions, []models.PlannedTransaction{
+		{Step: 0, ChainID: "cosmoshub-4", Signer: addressOn(t, "cosmos"), Routes: []int{1}},
+		{Step: 0, ChainID: "juno-1", Signer: addressOn(t, "juno"), Routes: []int{0, 2}},
+	})
+}
+
+func TestPathfinder_PlanConsolidationWithoutPFM(t *testing.T) {
+	prefixes := map[string]string{
+		"osmosis-1": "osmo", "cosmoshub-4": "cosmos", "juno-1": "juno", "atomone-1": "atone", "noble-1": "noble",
+	}
+	noPFMChains := slices.Clone(chains)
+	for i := range noPFMChains {
+		noPFMChains[i].Bech32Prefix = prefixes[noPFMChains[i].Id]
+		if noPFMChains[i].Id == "noble-1" {
+			noPFMChains[i].HasPFM = false
+		}
+	}
+	pathfinder := router.NewPathfinder(noPFMChains, buildIndex(t, noPFMChains), map[string]brokers.BrokerClient{
+		"osmosis-sqs": &MockBrokerClient{brokerType: "osmosis-sqs", contractAddress: entryPointContract},
+	})
+
+	plan, err := pathfinder.PlanConsolidation(t.Context(), consolidateToOsmosisUSDC(t,
+		models.Balance{ChainID: "juno-1", Denom: junoToOsmosisUSDC.TokenFromDenom, Amount: "5000000"},
+		models.Balance{ChainID: "juno-1", Denom: "ujuno", Amount: "1000000"},
+	))
+	assert.NoError(t, err)
+	assert.False(t, plan.Routes[0].Route.Indirect.SupportsPFM)
+
+	// Noble can't forward, the second leg is sent from Noble once the first one arrived
+	assert.DeepEqual(t, plan.Transactions, []models.PlannedTransaction{
+		{Step: 0, ChainID: "juno-1", Signer: addressOn(t, "juno"), Routes: []int{0, 1}},
+		{Step: 1, ChainID: "noble-1", Signer: addressOn(t, "noble"), Routes: []int{0}, DependsOn: []int{0}},
+	})
+}
+
+func TestPathfinder_PlanConsolidationMixedPFM(t *testing.T) {
+	prefixes := map[string]string{
+		"osmosis-1": "osmo", "cosmoshub-4": "cosmos", "juno-1": "juno", "atomone-1": "atone", "noble-1": "noble",
+	}
+	usdcVia := func(chainDenom, ibcDenom string) map[string]router.TokenInfo {
+		return map[string]router.TokenInfo{
+			chainDenom: {ChainDenom: chainDenom, IbcDenom: ibcDenom, BaseDenom: "uusdc", OriginChain: "noble-1", Decimals: 6},
+		}
+	}
+
+	// Juno holds USDC that came through Noble, which forwards with PFM, and USDC that came through Kujira, which can't
+	mixedChains := slices.Clone(chains)
+	for i := range mixedChains {
+		mixedChains[i].Bech32Prefix = prefixes[mixedChains[i].Id]
+		if mixedChains[i].Id == "juno-1" {
+			mixedChains[i].Routes = append(slices.Clone(mixedChains[i].Routes), router.BasicRoute{
+				ToChain: "kujira", ToChainId: "kaiyo-1", ChannelId: "channel-8", PortId: "transfer",
+				AllowedTokens: usdcVia("ibc/usdc-kujira-juno", "ibc/usdc-kujira"),
+			})
+		}
+	}
+	mixedChains = append(mixedChains, router.PathfinderChain{
+		Name: "Kujira", Id: "kaiyo-1", Bech32Prefix: "kujira", HasPFM: false,
+		Routes: []router.BasicRoute{{
+			ToChain: "osmosis", ToChainId: "osmosis-1", ChannelId: "channel-3", PortId: "transfer",
+			AllowedTokens: usdcVia("ibc/usdc-kujira", junoToOsmosisUSDC.TokenToDenom),
+		}},
+	})
+	pathfinder := router.NewPathfinder(mixedChains, buildIndex(t, mixedChains), map[string]brokers.BrokerClient{
+		"osmosis-sqs": &MockBrokerClient{brokerType: "osmosis-sqs", contractAddress: entryPointContract},
+	})
+
+	plan, err := pathfinder.PlanConsolidation(t.Context(), consolidateToOsmosisUSDC(t,
+		models.Balance{ChainID: "juno-1", Denom: "ibc/usdc-kujira-juno", Amount: "2000000"},
+		models.Balance{ChainID: "juno-1", Denom: junoToOsmosisUSDC.TokenFromDenom, Amount: "5000000"},
+	))
+	assert.NoError(t, err)
+	assert.Equal(t, plan.Routes[0].Route.RouteType, "indirect")
+	assert.False(t, plan.Routes[0].Route.Indirect.SupportsPFM)
+	assert.Equal(t, plan.Routes[1].Route.RouteType, "indirect")
+	assert.True(t, plan.Routes[1].Route.Indirect.SupportsPFM)
+
+	// Both leave Juno together, the USDC on Kujira can only be sent on once the transfer from Juno was received
+	assert.DeepEqual(t, plan.Transactions, []models.PlannedTransaction{
+		{Step: 0, ChainID: "juno-1", Signer: addressOn(t, "juno"), Routes: []int{0, 1}},
+		{Step: 1, ChainID: "kaiyo-1", Signer: addressOn(t, "kujira"), Routes: []int{0}, DependsOn: []int{0}},
+	})
+}
+
+func TestPathfinder_PlanConsolidationUnknownTarget(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	req := consolidateToOsmosisUSDC(t, models.Balance{ChainID: "juno-1", Denom: "ujuno", Amount: "1000000"})
+	req.TokenToDenom = "unknown"
+	_, err := pathfinder.PlanConsolidation(t.Context(), req)
+	assert.Error(t, err)
+}
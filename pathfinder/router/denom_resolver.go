@@ -91,27 +91,32 @@ func (dr *DenomResolver) lookupDenom(chainID, denom string) (*models.DenomInfo,
 		return nil, fmt.Errorf("denom %s not found on chain %s", denom, chainID)
 	}
 
-	// Find IBC path if applicable
-	ibcPath := ""
-	if tokenInfo.OriginChain != chainID {
-		// Find the route this token came from
-		for _, route := range dr.routeIndex.chainRoutes[chainID] {
-			if _, allowed := route.AllowedTokens[denom]; allowed {
-				ibcPath = route.PortId + "/" + route.ChannelId
-				break
-			}
-		}
-	}
-
 	return &models.DenomInfo{
 		ChainDenom:  tokenInfo.ChainDenom,
 		BaseDenom:   tokenInfo.BaseDenom,
 		OriginChain: tokenInfo.OriginChain,
 		IsNative:    tokenInfo.OriginChain == chainID,
-		IbcPath:     ibcPath,
+		IbcPath:     dr.ibcPath(chainID, denom, tokenInfo),
 	}, nil
 }
 
+// ibcPath returns the trace path of a denom on a chain, empty for native tokens.
+// Configs generated without trace paths fall back to the channel of a route the token can be sent on.
+func (dr *DenomResolver) ibcPath(chainID, denom string, tokenInfo *TokenInfo) string {
+	if tokenInfo.OriginChain == chainID {
+		return ""
+	}
+	if tokenInfo.ChainPath != "" {
+		return tokenInfo.ChainPath
+	}
+	for _, route := range dr.routeIndex.chainRoutes[chainID] {
+		if _, allowed := route.AllowedTokens[denom]; allowed {
+			return route.PortId + "/" + route.ChannelId
+		}
+	}
+	return ""
+}
+
 // resolveHumanReadableDenom tries to find a token by its base denom on a chain.
 // Supports disambiguation syntax: "denom@origin_chain" (e.g., "uusdc@noble-1")
 // If multiple tokens have the same base denom and no origin is specified, returns an error.
@@ -164,22 +169,12 @@ func (dr *DenomResolver) resolveHumanReadableDenom(chainID, denomInput string) (
 
 	// Single match - return it
 	match := matches[0]
-	ibcPath := ""
-	if match.tokenInfo.OriginChain != chainID {
-		for _, route := range dr.routeIndex.chainRoutes[chainID] {
-			if _, allowed := route.AllowedTokens[match.denom]; allowed {
-				ibcPath = route.PortId + "/" + route.ChannelId
-				break
-			}
-		}
-	}
-
 	return &models.DenomInfo{
 		ChainDenom:  match.denom,
 		BaseDenom:   match.tokenInfo.BaseDenom,
 		OriginChain: match.tokenInfo.OriginChain,
 		IsNative:    match.tokenInfo.OriginChain == chainID,
-		IbcPath:     ibcPath,
+		IbcPath:     dr.ibcPath(chainID, match.denom, match.tokenInfo),
 	}, nil
 }
 
@@ -0,0 +1,76 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+)
+
+func TestDenomResolver_IbcPath(t *testing.T) {
+	tracedChains := []router.PathfinderChain{
+		{
+			Name: "AtomOne",
+			Id:   "atomone-1",
+			Routes: []router.BasicRoute{
+				{
+					ToChain:      "osmosis",
+					ToChainId:    "osmosis-1",
+					ConnectionId: "connection-2",
+					ChannelId:    "channel-2",
+					PortId:       "transfer",
+					AllowedTokens: map[string]router.TokenInfo{
+						// STARS that came from Stargaze through Osmosis
+						"ibc/stars-via-osmosis": {
+							ChainDenom:  "ibc/stars-via-osmosis",
+							ChainPath:   "transfer/channel-2/transfer/channel-75",
+							IbcDenom:    "ibc/stars-osmosis",
+							IbcPath:     "transfer/channel-75",
+							BaseDenom:   "ustars",
+							OriginChain: "stargaze-1",
+							Decimals:    6,
+						},
+						// A config generated without trace paths
+						"ibc/uosmo-atomone": {
+							ChainDenom:  "ibc/uosmo-atomone",
+							IbcDenom:    "uosmo",
+							BaseDenom:   "uosmo",
+							OriginChain: "osmosis-1",
+							Decimals:    6,
+						},
+						"uatone": {
+							ChainDenom:  "uatone",
+							IbcDenom:    "ibc/uatone-osmosis",
+							IbcPath:     "transfer/channel-94814",
+							BaseDenom:   "uatone",
+							OriginChain: "atomone-1",
+							Decimals:    6,
+						},
+					},
+				},
+			},
+		},
+	}
+	routeIndex := router.NewRouteIndex()
+	assert.NoError(t, routeIndex.BuildIndex(tracedChains))
+	resolver := router.NewDenomResolver(routeIndex)
+
+	stars, err := resolver.ResolveDenom("atomone-1", "ibc/stars-via-osmosis")
+	assert.NoError(t, err)
+	assert.Equal(t, stars.IbcPath, "transfer/channel-2/transfer/channel-75")
+
+	// Human readable denoms resolve to the same path
+	stars, err = resolver.ResolveDenom("atomone-1", "ustars")
+	assert.NoError(t, err)
+	assert.Equal(t, stars.IbcPath, "transfer/channel-2/transfer/channel-75")
+
+	osmo, err := resolver.ResolveDenom("atomone-1", "ibc/uosmo-atomone")
+	assert.NoError(t, err)
+	assert.Equal(t, osmo.IbcPath, "transfer/channel-2")
+
+	atone, err := resolver.ResolveDenom("atomone-1", "uatone")
+	assert.NoError(t, err)
+	assert.True(t, atone.IsNative)
+	assert.Equal(t, atone.IbcPath, "")
+}
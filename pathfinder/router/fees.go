@@ -0,0 +1,277 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	"github.com/shopspring/decimal"
+)
+
+// Gas limits of the transactions routes are executed with. The transactions are not simulated,
+// the limits leave enough headroom for the transfers and swaps on the supported chains.
+const (
+	transferGas          = 150_000   // MsgTransfer without a memo
+	contractExecutionGas = 1_000_000 // MsgExecuteContract of a swap on the entry point contract
+	gasPerMemoByte       = 10        // Gas charged for every byte of a memo or contract message, the Cosmos SDK tx size cost
+)
+
+// relayerFeeNote is added to every route, the sender only pays for the transactions they sign
+const relayerFeeNote = "Receiving and acknowledging the transfers is paid by the relayers, relayer fees are not included"
+
+// attachFees estimates the fee of every transaction the sender signs to execute the route.
+// The estimates are set on the signed legs and the execution data, the totals and notes on the response.
+// Legs that are forwarded by PFM or by a contract have no fee of their own.
+func (s *Pathfinder) attachFees(response *models.RouteResponse) {
+	var transactions []*models.FeeEstimate
+	notes := []string{relayerFeeNote}
+
+	switch {
+	case response.Direct != nil && response.Direct.Transfer != nil:
+		transfer := response.Direct.Transfer
+		transfer.Fee = s.estimateFee(transfer.FromChain, transferGas)
+		transactions = append(transactions, transfer.Fee)
+
+	case response.Indirect != nil && len(response.Indirect.Legs) > 0:
+		indirect := response.Indirect
+		if indirect.SupportsPFM && indirect.PFMMemo != "" {
+			firstLeg := indirect.Legs[0]
+			firstLeg.Fee = s.estimateFee(firstLeg.FromChain, memoGas(transferGas, indirect.PFMMemo))
+			transactions = append(transactions, firstLeg.Fee)
+			notes = append(notes, s.pfmFeeNotes(indirect.Legs[1:])...)
+			break
+		}
+
+		for _, leg := range indirect.Legs {
+			leg.Fee = s.estimateFee(leg.FromChain, transferGas)
+			transactions = append(transactions, leg.Fee)
+		}
+		if len(indirect.Legs) > 1 {
+			notes = append(notes, "Every leg is a transaction of its own, the sender needs the fee token on every chain of the path")
+		}
+
+	case response.BrokerSwap != nil:
+		brokerRoute := response.BrokerSwap
+		execution := brokerRoute.Execution
+		if execution == nil {
+			notes = append(notes, "Fees are only estimated for routes with execution data, find the route with smart_route set")
+			break
+		}
+
+		if len(brokerRoute.InboundLegs) > 0 && brokerRoute.InboundLegs[0] != nil {
+			firstLeg := brokerRoute.InboundLegs[0]
+			memo := ""
+			if execution.Memo != nil {
+				memo = *execution.Memo
+			}
+			firstLeg.Fee = s.estimateFee(firstLeg.FromChain, memoGas(transferGas, memo))
+			execution.Fee = firstLeg.Fee
+			notes = append(notes, s.pfmFeeNotes(brokerRoute.InboundLegs[1:])...)
+			if brokerChain := brokerRoute.InboundLegs[len(brokerRoute.InboundLegs)-1]; execution.UsesWasm && brokerChain != nil {
+				notes = append(notes, fmt.Sprintf("The swap on %s is executed on receive of the transfer, its gas is not paid by the sender", brokerChain.ToChain))
+			}
+		} else if len(brokerRoute.Path) > 0 {
+			contractMsg := ""
+			if execution.SmartContractData != nil && execution.SmartContractData.Wasm != nil {
+				if msg, err := json.Marshal(execution.SmartContractData.Wasm.Msg); err == nil {
+					contractMsg = string(msg)
+				}
+			}
+			execution.Fee = s.estimateFee(brokerRoute.Path[0], memoGas(contractExecutionGas, contractMsg))
+		}
+		if execution.Fee != nil {
+			transactions = append(transactions, execution.Fee)
+		}
+
+		// The contract sends the first outbound transfer, the ones after it are forwarded by PFM
+		if len(brokerRoute.OutboundLegs) > 1 {
+			notes = append(notes, s.pfmFeeNotes(brokerRoute.OutboundLegs[1:])...)
+		}
+	}
+
+	for _, fee := range transactions {
+		if fee.Denom == "" {
+			notes = append(notes, fmt.Sprintf("No fee currency is configured for %s, only the gas is estimated", fee.ChainID))
+		}
+	}
+
+	response.Fees = &models.RouteFees{
+		Total: totalFees(transactions),
+		Notes: notes,
+	}
+}
+
+// estimateFee returns the fee of a transaction on chainId with the average gas price of the chain's first fee currency
+func (s *Pathfinder) estimateFee(chainId string, gas uint64) *models.FeeEstimate {
+	fee := &models.FeeEstimate{ChainID: chainId, Gas: gas}
+
+	chain, exists := s.chainsMap[chainId]
+	if !exists || len(chain.FeeCurrencies) == 0 {
+		return fee
+	}
+
+	feeCurrency := chain.FeeCurrencies[0]
+	gasPrice := decimal.NewFromFloat(feeCurrency.GasPriceStep.Average)
+	fee.Denom = feeCurrency.Denom
+	fee.GasPrice = gasPrice.String()
+	fee.Amount = gasPrice.Mul(decimal.NewFromUint64(gas)).Ceil().String()
+	return fee
+}
+
+// memoGas adds the size cost of memo to gas
+func memoGas(gas uint64, memo string) uint64 {
+	return gas + uint64(len(memo))*gasPerMemoByte
+}
+
+// pfmFeeNotes notes the fees of the chains that forward the given legs with PFM.
+// Known fees are already taken from the leg amounts, chains without a configured fee can keep one on top.
+func (s *Pathfinder) pfmFeeNotes(forwardedLegs []*models.IBCLeg) []string {
+	notes := make([]string, 0, len(forwardedLegs))
+	for _, leg := range forwardedLegs {
+		if leg == nil {
+			continue
+		}
+		fee, known := s.pfmFee(leg.FromChain)
+		switch {
+		case !known:
+			notes = append(notes, fmt.Sprintf("%s forwards the transfer with PFM and can keep a fee from the forwarded amount", leg.FromChain))
+		case fee.IsPositive():
+			notes = append(notes, fmt.Sprintf("%s keeps %s%% of the forwarded amount with PFM, the leg amounts include it",
+				leg.FromChain, fee.Mul(decimal.NewFromInt(100)).String()))
+		}
+	}
+	return notes
+}
+
+// parsePFMFee parses the PFM fee of a chain config, a fraction below 1
+func parsePFMFee(pfmFee string) (decimal.Decimal, error) {
+	fee, err := decimal.NewFromString(pfmFee)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	if fee.IsNegative() || fee.GreaterThanOrEqual(decimal.NewFromInt(1)) {
+		return decimal.Zero, fmt.Errorf("%s is not a fraction between 0 and 1", pfmFee)
+	}
+	return fee, nil
+}
+
+// pfmFee returns the fee PFM on chainId keeps from forwarded transfers, known is false if the config doesn't set it
+func (s *Pathfinder) pfmFee(chainId string) (fee decimal.Decimal, known bool) {
+	chain, exists := s.chainsMap[chainId]
+	if !exists || chain.PFMFeePercentage == "" {
+		return decimal.Zero, false
+	}
+	fee, err := parsePFMFee(chain.PFMFeePercentage)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return fee, true
+}
+
+// afterPFMFees returns what is left of amount once every forwarder kept its PFM fee
+func (s *Pathfinder) afterPFMFees(amount string, forwarders []string) string {
+	for _, chainId := range forwarders {
+		fee, _ := s.pfmFee(chainId)
+		amount = afterFee(amount, fee)
+	}
+	return amount
+}
+
+// beforePFMFees returns the amount to send so that at least amount is left after the PFM fees of the forwarders
+func (s *Pathfinder) beforePFMFees(amount string, forwarders []string) string {
+	for i := len(forwarders) - 1; i >= 0; i-- {
+		fee, _ := s.pfmFee(forwarders[i])
+		amount = beforeFee(amount, fee)
+	}
+	return amount
+}
+
+// inboundForwarders returns the chains that forward the inbound hops of a broker route before the swap
+func inboundForwarders(hopInfo *MultiHopInfo) []string {
+	chains := []string{}
+	if hopInfo.SourceIsBroker {
+		return chains
+	}
+	for i := 0; i < len(hopInfo.InboundRoutes)-1; i++ {
+		chains = append(chains, hopInfo.InboundRoutes[i].ToChainId)
+	}
+	return chains
+}
+
+// outboundForwarders returns the chains that forward the outbound hops of a broker route after the swap
+func outboundForwarders(hopInfo *MultiHopInfo) []string {
+	chains := []string{}
+	if hopInfo.SwapOnly {
+		return chains
+	}
+	for i := 0; i < len(hopInfo.OutboundRoutes)-1; i++ {
+		chains = append(chains, hopInfo.OutboundRoutes[i].ToChainId)
+	}
+	return chains
+}
+
+// forwardingChains returns the chains that forward the legs after the first one
+func forwardingChains(legs []*models.IBCLeg) []string {
+	chains := []string{}
+	for i := 1; i < len(legs); i++ {
+		if legs[i] != nil {
+			chains = append(chains, legs[i].FromChain)
+		}
+	}
+	return chains
+}
+
+// takerFee returns the taker fee of a swap, zero if the broker doesn't report one
+func takerFee(swapResult *brokers.SwapResult) decimal.Decimal {
+	if routeData, ok := swapResult.RouteData.(brokers.TakerFeeRouteData); ok {
+		return routeData.TakerFee()
+	}
+	return decimal.Zero
+}
+
+// afterFee takes fee (a fraction) from amount, the fee is rounded down the same way PFM and the pools do
+func afterFee(value string, fee decimal.Decimal) string {
+	parsed, err := amount.Parse(value)
+	if err != nil || !fee.IsPositive() {
+		return value
+	}
+	return parsed.Sub(parsed.MulFloor(fee)).String()
+}
+
+// beforeFee returns the amount that is still worth at least value after fee is taken from it,
+// value divided by the share fee leaves and rounded up
+func beforeFee(value string, fee decimal.Decimal) string {
+	parsed, err := amount.Parse(value)
+	one := decimal.NewFromInt(1)
+	if err != nil || !fee.IsPositive() || fee.GreaterThanOrEqual(one) {
+		return value
+	}
+	return parsed.DivCeil(one.Sub(fee)).String()
+}
+
+// totalFees sums up the fees per chain and denom, in the order the transactions are signed
+func totalFees(transactions []*models.FeeEstimate) []*models.FeeEstimate {
+	total := []*models.FeeEstimate{}
+	for _, fee := range transactions {
+		var sum *models.FeeEstimate
+		for _, existing := range total {
+			if existing.ChainID == fee.ChainID && existing.Denom == fee.Denom {
+				sum = existing
+				break
+			}
+		}
+		if sum == nil {
+			copied := *fee
+			total = append(total, &copied)
+			continue
+		}
+
+		sum.Gas += fee.Gas
+		if fee.Denom != "" {
+			sum.Amount = amount.MustParse(sum.Amount).Add(amount.MustParse(fee.Amount)).String()
+		}
+	}
+	return total
+}
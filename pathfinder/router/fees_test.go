@@ -0,0 +1,349 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+	"github.com/shopspring/decimal"
+)
+
+// setupFeePathfinder returns a pathfinder where every test chain except Noble has a fee currency
+func setupFeePathfinder(t *testing.T) *router.Pathfinder {
+	t.Helper()
+	return newFeePathfinder(t, nil, &MockBrokerClient{brokerType: "osmosis-sqs", contractAddress: entryPointContract})
+}
+
+// newFeePathfinder is setupFeePathfinder with the given PFM fees per chain and broker client
+func newFeePathfinder(t *testing.T, pfmFees map[string]string, broker *MockBrokerClient) *router.Pathfinder {
+	t.Helper()
+
+	prefixes := map[string]string{
+		"osmosis-1": "osmo", "cosmoshub-4": "cosmos", "juno-1": "juno", "atomone-1": "atone", "noble-1": "noble",
+	}
+	feeCurrencies := map[string]router.FeeCurrency{
+		"osmosis-1":   {Denom: "uosmo", Symbol: "OSMO", Decimals: 6, GasPriceStep: router.GasPriceStep{Low: 0.0025, Average: 0.025, High: 0.04}},
+		"cosmoshub-4": {Denom: "uatom", Symbol: "ATOM", Decimals: 6, GasPriceStep: router.GasPriceStep{Low: 0.005, Average: 0.025, High: 0.03}},
+		"juno-1":      {Denom: "ujuno", Symbol: "JUNO", Decimals: 6, GasPriceStep: router.GasPriceStep{Low: 0.075, Average: 0.1, High: 0.125}},
+		"atomone-1":   {Denom: "uphoton", Symbol: "PHOTON", Decimals: 6, GasPriceStep: router.GasPriceStep{Low: 0.225, Average: 0.36, High: 0.72}},
+	}
+
+	feeChains := make([]router.PathfinderChain, len(chains))
+	for i, chain := range chains {
+		chain.Bech32Prefix = prefixes[chain.Id]
+		chain.PFMFeePercentage = pfmFees[chain.Id]
+		if feeCurrency, ok := feeCurrencies[chain.Id]; ok {
+			chain.FeeCurrencies = []router.FeeCurrency{feeCurrency}
+		}
+		feeChains[i] = chain
+	}
+	return router.NewPathfinder(feeChains, buildIndex(t, feeChains), map[string]brokers.BrokerClient{"osmosis-sqs": broker})
+}
+
+func TestPathfinder_DirectRouteFees(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.True(t, response.Success)
+
+	expected := &models.FeeEstimate{ChainID: "cosmoshub-4", Gas: 150000, Denom: "uatom", Amount: "3750", GasPrice: "0.025"}
+	assert.DeepEqual(t, response.Direct.Transfer.Fee, expected)
+	assert.NotNil(t, response.Fees)
+	assert.DeepEqual(t, response.Fees.Total, []*models.FeeEstimate{expected})
+	assert.Equal(t, len(response.Fees.Notes), 1)
+}
+
+func TestPathfinder_IndirectRouteFees(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	// USDC from Juno to Osmosis is forwarded by Noble with PFM
+	response := pathfinder.FindPath(t.Context(), models.RouteRequest{
+		ChainFrom:       "juno-1",
+		ChainTo:         "osmosis-1",
+		TokenFromDenom:  "ibc/EAC38D55372F38F1AFD68DF7FE9EF762DCF69F26520643CF3F9D292A738D8034",
+		TokenToDenom:    "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+		AmountIn:        "5000000",
+		SenderAddress:   addressOn(t, "juno"),
+		ReceiverAddress: addressOn(t, "osmo"),
+	})
+	assert.True(t, response.Success)
+	assert.Equal(t, response.RouteType, "indirect")
+	assert.True(t, response.Indirect.SupportsPFM)
+
+	// Only the first leg is signed, the memo makes the transfer more expensive
+	legs := response.Indirect.Legs
+	assert.NotNil(t, legs[0].Fee)
+	assert.Equal(t, legs[0].Fee.ChainID, "juno-1")
+	assert.Equal(t, legs[0].Fee.Gas, uint64(150000+10*len(response.Indirect.PFMMemo)))
+	assert.Equal(t, legs[0].Fee.Denom, "ujuno")
+	assert.Nil(t, legs[1].Fee)
+
+	assert.Equal(t, len(response.Fees.Total), 1)
+	assert.Equal(t, response.Fees.Total[0].ChainID, "juno-1")
+	assert.Equal(t, response.Fees.Notes[1], "noble-1 forwards the transfer with PFM and can keep a fee from the forwarded amount")
+}
+
+func TestPathfinder_BrokerRouteFees(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+	smartRoute := true
+
+	t.Run("inbound transfer", func(t *testing.T) {
+		response := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "cosmoshub-4",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uatom",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   addressOn(t, "cosmos"),
+			ReceiverAddress: addressOn(t, "juno"),
+			SmartRoute:      &smartRoute,
+		})
+		assert.True(t, response.Success)
+		assert.Equal(t, response.RouteType, "broker_swap")
+
+		// The swap starts with the inbound transfer carrying the wasm memo
+		brokerRoute := response.BrokerSwap
+		fee := brokerRoute.Execution.Fee
+		assert.NotNil(t, fee)
+		assert.Equal(t, fee, brokerRoute.InboundLegs[0].Fee)
+		assert.Equal(t, fee.ChainID, "cosmoshub-4")
+		assert.Equal(t, fee.Gas, uint64(150000+10*len(*brokerRoute.Execution.Memo)))
+		assert.Nil(t, brokerRoute.OutboundLegs[0].Fee)
+
+		assert.Equal(t, len(response.Fees.Total), 1)
+		assert.Equal(t, response.Fees.Notes[len(response.Fees.Notes)-1],
+			"The swap on osmosis-1 is executed on receive of the transfer, its gas is not paid by the sender")
+	})
+
+	t.Run("contract execution", func(t *testing.T) {
+		response := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "osmosis-1",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uosmo",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   addressOn(t, "osmo"),
+			ReceiverAddress: addressOn(t, "juno"),
+			SmartRoute:      &smartRoute,
+		})
+		assert.True(t, response.Success)
+		assert.Equal(t, response.RouteType, "broker_swap")
+
+		fee := response.BrokerSwap.Execution.Fee
+		assert.NotNil(t, fee)
+		assert.Equal(t, fee.ChainID, "osmosis-1")
+		assert.Equal(t, fee.Denom, "uosmo")
+		assert.True(t, fee.Gas > 1000000)
+	})
+
+	t.Run("no execution data", func(t *testing.T) {
+		response := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "cosmoshub-4",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uatom",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   addressOn(t, "cosmos"),
+			ReceiverAddress: addressOn(t, "juno"),
+		})
+		assert.True(t, response.Success)
+		assert.Nil(t, response.BrokerSwap.InboundLegs[0].Fee)
+		assert.Equal(t, len(response.Fees.Total), 0)
+		assert.Equal(t, len(response.Fees.Notes), 2)
+	})
+}
+
+func TestPathfinder_FeesWithoutFeeCurrency(t *testing.T) {
+	// None of the default test chains have a fee currency, only the gas is estimated
+	pathfinder, _ := setupTestPathfinder()
+
+	response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.True(t, response.Success)
+
+	fee := response.Direct.Transfer.Fee
+	assert.DeepEqual(t, fee, &models.FeeEstimate{ChainID: "cosmoshub-4", Gas: 150000})
+	assert.Equal(t, response.Fees.Notes[len(response.Fees.Notes)-1], "No fee currency is configured for cosmoshub-4, only the gas is estimated")
+}
+
+// junoToOsmosisUSDC is forwarded by Noble with PFM
+var junoToOsmosisUSDC = models.RouteRequest{
+	ChainFrom:      "juno-1",
+	ChainTo:        "osmosis-1",
+	TokenFromDenom: "ibc/EAC38D55372F38F1AFD68DF7FE9EF762DCF69F26520643CF3F9D292A738D8034",
+	TokenToDenom:   "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+}
+
+func TestPathfinder_PFMFees(t *testing.T) {
+	pathfinder := newFeePathfinder(t, map[string]string{"noble-1": "0.001", "osmosis-1": "0"},
+		&MockBrokerClient{brokerType: "osmosis-sqs", contractAddress: entryPointContract})
+
+	t.Run("exact in", func(t *testing.T) {
+		req := junoToOsmosisUSDC
+		req.AmountIn = "5000000"
+		req.SenderAddress = addressOn(t, "juno")
+		req.ReceiverAddress = addressOn(t, "osmo")
+
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+		assert.True(t, response.Indirect.SupportsPFM)
+
+		// Noble keeps 0.1% of the forwarded transfer
+		legs := response.Indirect.Legs
+		assert.Equal(t, legs[0].Amount, "5000000")
+		assert.Equal(t, legs[1].Amount, "4995000")
+		assert.Equal(t, response.Fees.Notes[1], "noble-1 keeps 0.1% of the forwarded amount with PFM, the leg amounts include it")
+	})
+
+	t.Run("exact out", func(t *testing.T) {
+		req := junoToOsmosisUSDC
+		req.AmountOut = "4995000"
+		req.SenderAddress = addressOn(t, "juno")
+		req.ReceiverAddress = addressOn(t, "osmo")
+
+		// The first leg sends enough for the receiver to get the requested amount
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+		legs := response.Indirect.Legs
+		assert.Equal(t, legs[0].Amount, "5000000")
+		assert.Equal(t, legs[1].Amount, "4995000")
+	})
+}
+
+// takerFeeRouteData reports a taker fee like the Osmosis route data
+type takerFeeRouteData struct {
+	MockRouteData
+	takerFee decimal.Decimal
+}
+
+func (r *takerFeeRouteData) TakerFee() decimal.Decimal {
+	return r.takerFee
+}
+
+func TestPathfinder_TakerFees(t *testing.T) {
+	routeData := &takerFeeRouteData{
+		MockRouteData: MockRouteData{
+			operations:    []ibcmemo.SwapOperation{{Pool: "1", DenomIn: "uatom", DenomOut: "ujuno"}},
+			swapVenueName: "osmosis-poolmanager",
+		},
+		takerFee: decimal.RequireFromString("0.002"),
+	}
+	pathfinder := newFeePathfinder(t, nil, &MockBrokerClient{
+		brokerType:      "osmosis-sqs",
+		contractAddress: entryPointContract,
+		swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			return &brokers.SwapResult{AmountIn: amountIn, AmountOut: "990000", PriceImpact: "0.007", RouteData: routeData}, nil
+		},
+		swapExactOutFunc: func(tokenIn, tokenOut, amountOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			return &brokers.SwapResult{AmountIn: "1010000", AmountOut: amountOut, PriceImpact: "0.007", RouteData: routeData}, nil
+		},
+	})
+	smartRoute := true
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		SenderAddress:   addressOn(t, "cosmos"),
+		ReceiverAddress: addressOn(t, "juno"),
+		SmartRoute:      &smartRoute,
+	}
+
+	t.Run("exact in", func(t *testing.T) {
+		req := req
+		req.AmountIn = "1000000"
+
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+
+		// The taker fee is taken from the quoted output, the outbound transfer sends what is left
+		brokerRoute := response.BrokerSwap
+		assert.Equal(t, brokerRoute.Swap.AmountOut, "990000")
+		assert.Equal(t, brokerRoute.Swap.TakerFee, "0.002")
+		assert.Equal(t, brokerRoute.Swap.NetAmountOut, "988020")
+		assert.Equal(t, brokerRoute.OutboundLegs[0].Amount, "988020")
+
+		minOutput, err := brokers.CalculateMinOutput("988020", 100)
+		assert.NoError(t, err)
+		assert.Equal(t, brokerRoute.Execution.MinOutputAmount, minOutput)
+		assert.Equal(t, brokerRoute.Execution.MinReceivedAmount, minOutput)
+	})
+
+	t.Run("exact out", func(t *testing.T) {
+		req := req
+		req.AmountOut = "1000000"
+
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+
+		// The input covers the taker fee on top of the quote
+		maxInput, err := brokers.CalculateMaxInput("1012025", 100)
+		assert.NoError(t, err)
+		assert.Equal(t, response.BrokerSwap.Execution.MaxInputAmount, maxInput)
+	})
+}
+
+func TestPathfinder_InboundPFMFees(t *testing.T) {
+	quoted := make([]string, 0)
+	pathfinder := newFeePathfinder(t, map[string]string{"noble-1": "0.001"}, &MockBrokerClient{
+		brokerType:      "osmosis-sqs",
+		contractAddress: entryPointContract,
+		swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			quoted = append(quoted, amountIn)
+			return &brokers.SwapResult{AmountIn: amountIn, AmountOut: "990000", PriceImpact: "0.007",
+				RouteData: &MockRouteData{swapVenueName: "osmosis-poolmanager"}}, nil
+		},
+		swapExactOutFunc: func(tokenIn, tokenOut, amountOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			return &brokers.SwapResult{AmountIn: "1010000", AmountOut: amountOut, PriceImpact: "0.007",
+				RouteData: &MockRouteData{swapVenueName: "osmosis-poolmanager"}}, nil
+		},
+	})
+	smartRoute := true
+	// USDC on Juno goes back to Noble, which forwards it to Osmosis for the swap
+	req := models.RouteRequest{
+		ChainFrom:       "juno-1",
+		ChainTo:         "osmosis-1",
+		TokenFromDenom:  "ibc/EAC38D55372F38F1AFD68DF7FE9EF762DCF69F26520643CF3F9D292A738D8034",
+		TokenToDenom:    "uosmo",
+		SenderAddress:   addressOn(t, "juno"),
+		ReceiverAddress: addressOn(t, "osmo"),
+		SmartRoute:      &smartRoute,
+	}
+
+	t.Run("exact in", func(t *testing.T) {
+		req := req
+		req.AmountIn = "5000000"
+
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+
+		// Noble keeps 0.1% before the funds reach Osmosis, the swap is quoted for what arrives
+		legs := response.BrokerSwap.InboundLegs
+		assert.Equal(t, len(legs), 2)
+		assert.Equal(t, legs[0].Amount, "5000000")
+		assert.Equal(t, legs[1].Amount, "4995000")
+		assert.DeepEqual(t, quoted, []string{"4995000"})
+		assert.Equal(t, response.BrokerSwap.Swap.AmountIn, "4995000")
+	})
+
+	t.Run("exact out", func(t *testing.T) {
+		req := req
+		req.AmountOut = "1000000"
+
+		response := pathfinder.FindPath(t.Context(), req)
+		assert.True(t, response.Success)
+
+		// The sender sends the PFM fee on top so the max input still reaches Osmosis
+		maxInput, err := brokers.CalculateMaxInput("1010000", 100)
+		assert.NoError(t, err)
+		legs := response.BrokerSwap.InboundLegs
+		assert.Equal(t, len(legs), 2)
+		assert.Equal(t, maxInput, "1020100")
+		assert.Equal(t, legs[0].Amount, "1021122")
+		assert.Equal(t, legs[1].Amount, "1020101")
+		assert.Equal(t, response.BrokerSwap.Execution.MaxInputAmount, legs[0].Amount)
+	})
+}
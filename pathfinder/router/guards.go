@@ -0,0 +1,147 @@
+package router
+
+import (
+	"errors"
+	"fmt"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	"github.com/shopspring/decimal"
+)
+
+// RouteGuards are the limits a route is rejected at, zero values are not checked.
+// Requests can tighten every limit with their own, but not loosen it.
+type RouteGuards struct {
+	// Largest accepted price impact of the swap as a fraction (e.g., 0.05 for 5%)
+	MaxPriceImpact float64
+	// Smallest accepted USD liquidity of a pool the swap trades through
+	MinLiquidityUSD float64
+	// Largest accepted number of IBC transfers in a route
+	MaxHops int
+	// Broker ids whose swaps pass the minimum liquidity with a warning when the broker doesn't report it
+	AcceptUnknownLiquidity map[string]bool
+}
+
+// SetRouteGuards sets the server-level route guards.
+// It must be called before the pathfinder serves requests.
+func (s *Pathfinder) SetRouteGuards(guards RouteGuards) {
+	s.guards = guards
+}
+
+// guardError is returned when a route breaks one of the route guards
+type guardError struct {
+	code    string
+	message string
+}
+
+func (e *guardError) Error() string {
+	return e.message
+}
+
+// guardErrorCode returns the code of the guard err broke, empty if err is not a guard error
+func guardErrorCode(err error) string {
+	var guardErr *guardError
+	if errors.As(err, &guardErr) {
+		return guardErr.code
+	}
+	return ""
+}
+
+// routeGuards returns the guards of the request, the stricter of the request and the server-level limit applies.
+// Limits the request leaves unset or at 0 fall back to the server-level ones.
+func (s *Pathfinder) routeGuards(req models.RouteRequest) RouteGuards {
+	guards := s.guards
+	if req.MaxPriceImpact != nil && *req.MaxPriceImpact > 0 &&
+		(guards.MaxPriceImpact == 0 || *req.MaxPriceImpact < guards.MaxPriceImpact) {
+		guards.MaxPriceImpact = *req.MaxPriceImpact
+	}
+	if req.MinLiquidityUSD != nil && *req.MinLiquidityUSD > guards.MinLiquidityUSD {
+		guards.MinLiquidityUSD = *req.MinLiquidityUSD
+	}
+	if req.MaxHops != nil && *req.MaxHops > 0 && (guards.MaxHops == 0 || int(*req.MaxHops) < guards.MaxHops) {
+		guards.MaxHops = int(*req.MaxHops)
+	}
+	return guards
+}
+
+// checkHops rejects a route with more IBC transfers than the guards allow
+func (g RouteGuards) checkHops(hops int) error {
+	if g.MaxHops > 0 && hops > g.MaxHops {
+		return &guardError{
+			code:    models.ErrorCodeTooManyHops,
+			message: fmt.Sprintf("route has %d IBC transfers, at most %d are allowed", hops, g.MaxHops),
+		}
+	}
+	return nil
+}
+
+// checkSwap rejects a swap of the broker with a price impact above or a pool liquidity below the guards.
+// Limits the broker gives no data for can't be checked, the swap is rejected for them as well
+// unless the broker accepts unknown liquidity.
+func (g RouteGuards) checkSwap(brokerId string, swapResult *brokers.SwapResult) ([]models.RouteWarning, error) {
+	var warnings []models.RouteWarning
+
+	if g.MaxPriceImpact > 0 {
+		maxImpact := decimal.NewFromFloat(g.MaxPriceImpact)
+		// SQS reports the price impact as a negative fraction, only its size matters
+		impact, err := decimal.NewFromString(swapResult.PriceImpact)
+		switch {
+		case err != nil:
+			return nil, &guardError{
+				code:    models.ErrorCodePriceImpactUnknown,
+				message: fmt.Sprintf("broker did not report the price impact, it can't be checked against the limit of %s%%", percent(maxImpact)),
+			}
+		case impact.Abs().GreaterThan(maxImpact):
+			return nil, &guardError{
+				code: models.ErrorCodePriceImpactTooHigh,
+				message: fmt.Sprintf("price impact of %s%% is above the limit of %s%%",
+					percent(impact.Abs()), percent(maxImpact)),
+			}
+		case impact.Abs().GreaterThan(maxImpact.Div(decimal.NewFromInt(2))):
+			warnings = append(warnings, models.RouteWarning{
+				Code: models.WarningCodeHighPriceImpact,
+				Message: fmt.Sprintf("Price impact of %s%% is close to the limit of %s%%",
+					percent(impact.Abs()), percent(maxImpact)),
+			})
+		}
+	}
+
+	if g.MinLiquidityUSD > 0 {
+		minLiquidity := decimal.NewFromFloat(g.MinLiquidityUSD)
+		liquidity, known := swapLiquidity(swapResult)
+		switch {
+		case !known && g.AcceptUnknownLiquidity[brokerId]:
+			warnings = append(warnings, models.RouteWarning{
+				Code: models.WarningCodeLiquidityUnchecked,
+				Message: fmt.Sprintf("Broker %s did not report the liquidity of the pools, it was not checked against the minimum of $%s",
+					brokerId, minLiquidity.String()),
+			})
+		case !known:
+			return nil, &guardError{
+				code:    models.ErrorCodeLiquidityUnknown,
+				message: fmt.Sprintf("broker did not report the liquidity of the pools, it can't be checked against the minimum of $%s", minLiquidity.String()),
+			}
+		case liquidity.LessThan(minLiquidity):
+			return nil, &guardError{
+				code: models.ErrorCodeLiquidityTooLow,
+				message: fmt.Sprintf("swap trades through a pool with $%s of liquidity, at least $%s is required",
+					liquidity.String(), minLiquidity.String()),
+			}
+		}
+	}
+
+	return warnings, nil
+}
+
+// swapLiquidity returns the smallest pool liquidity of a swap, known is false if the broker doesn't report it
+func swapLiquidity(swapResult *brokers.SwapResult) (decimal.Decimal, bool) {
+	if routeData, ok := swapResult.RouteData.(brokers.LiquidityRouteData); ok {
+		return routeData.MinLiquidity()
+	}
+	return decimal.Zero, false
+}
+
+// percent formats a fraction as a percentage
+func percent(fraction decimal.Decimal) string {
+	return fraction.Mul(decimal.NewFromInt(100)).Round(2).String()
+}
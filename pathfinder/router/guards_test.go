@@ -0,0 +1,224 @@
+package router_test
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// liquidityRouteData reports the pool liquidity like the Osmosis route data
+type liquidityRouteData struct {
+	MockRouteData
+	liquidity decimal.Decimal
+}
+
+func (r *liquidityRouteData) MinLiquidity() (decimal.Decimal, bool) {
+	return r.liquidity, true
+}
+
+// newGuardPathfinder returns a pathfinder whose broker quotes every swap with the given price impact and route data
+func newGuardPathfinder(t *testing.T, priceImpact string, routeData brokers.RouteData, queries *int) *router.Pathfinder {
+	t.Helper()
+
+	return newFeePathfinder(t, nil, &MockBrokerClient{
+		brokerType:      "osmosis-sqs",
+		contractAddress: entryPointContract,
+		swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			*queries++
+			return &brokers.SwapResult{AmountIn: amountIn, AmountOut: "990000", PriceImpact: priceImpact, RouteData: routeData}, nil
+		},
+	})
+}
+
+// guardRequest swaps ATOM from the Hub to JUNO on Juno through Osmosis
+func guardRequest(t *testing.T) models.RouteRequest {
+	t.Helper()
+
+	return models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   addressOn(t, "cosmos"),
+		ReceiverAddress: addressOn(t, "juno"),
+	}
+}
+
+var guardOperations = []ibcmemo.SwapOperation{{Pool: "1", DenomIn: "uatom", DenomOut: "ujuno"}}
+
+func TestPathfinder_PriceImpactGuard(t *testing.T) {
+	queries := 0
+	routeData := &MockRouteData{operations: guardOperations, swapVenueName: "osmosis-poolmanager"}
+	// SQS reports the price impact as a negative fraction
+	pathfinder := newGuardPathfinder(t, "-0.08", routeData, &queries)
+	pathfinder.SetRouteGuards(router.RouteGuards{MaxPriceImpact: 0.05})
+
+	response := pathfinder.FindPath(t.Context(), guardRequest(t))
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodePriceImpactTooHigh)
+	assert.Equal(t, response.ErrorMessage, "Broker swap route found but rejected: price impact of 8% is above the limit of 5%")
+
+	// A request can't accept a larger price impact than the server
+	req := guardRequest(t)
+	maxPriceImpact := 0.1
+	req.MaxPriceImpact = &maxPriceImpact
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodePriceImpactTooHigh)
+
+	// Without server guards the limit of the request applies, the route is returned with a warning
+	pathfinder.SetRouteGuards(router.RouteGuards{})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+	assert.Equal(t, response.ErrorCode, "")
+	assert.Equal(t, len(response.Warnings), 1)
+	assert.Equal(t, response.Warnings[0].Code, models.WarningCodeHighPriceImpact)
+
+	// A stricter request limit applies over the server one
+	pathfinder.SetRouteGuards(router.RouteGuards{MaxPriceImpact: 0.2})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+	maxPriceImpact = 0.07
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodePriceImpactTooHigh)
+
+	// Without guards nothing is checked
+	pathfinder.SetRouteGuards(router.RouteGuards{})
+	response = pathfinder.FindPath(t.Context(), guardRequest(t))
+	assert.True(t, response.Success)
+	assert.Equal(t, len(response.Warnings), 0)
+}
+
+func TestPathfinder_LiquidityGuard(t *testing.T) {
+	queries := 0
+	routeData := &liquidityRouteData{
+		MockRouteData: MockRouteData{operations: guardOperations, swapVenueName: "osmosis-poolmanager"},
+		liquidity:     decimal.NewFromInt(500),
+	}
+	pathfinder := newGuardPathfinder(t, "0.001", routeData, &queries)
+	pathfinder.SetRouteGuards(router.RouteGuards{MinLiquidityUSD: 10000})
+
+	// A thin pool rejects the route
+	response := pathfinder.FindPath(t.Context(), guardRequest(t))
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeLiquidityTooLow)
+
+	// A looser request limit is ignored
+	req := guardRequest(t)
+	minLiquidity := 100.0
+	req.MinLiquidityUSD = &minLiquidity
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeLiquidityTooLow)
+
+	pathfinder.SetRouteGuards(router.RouteGuards{})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+	assert.Equal(t, len(response.Warnings), 0)
+
+	// A broker that doesn't report liquidity can't be checked, the route is rejected
+	pathfinder = newGuardPathfinder(t, "0.001", &MockRouteData{operations: guardOperations}, &queries)
+	pathfinder.SetRouteGuards(router.RouteGuards{MinLiquidityUSD: 10000})
+	response = pathfinder.FindPath(t.Context(), guardRequest(t))
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeLiquidityUnknown)
+
+	// A request can't turn the guard off
+	req.MinLiquidityUSD = new(float64)
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeLiquidityUnknown)
+
+	// Without the guard the route is not checked
+	pathfinder.SetRouteGuards(router.RouteGuards{})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+}
+
+func TestPathfinder_AstroportLiquidityGuard(t *testing.T) {
+	pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+		return &brokers.SwapResult{
+			AmountIn:     amountIn,
+			AmountOut:    "975000",
+			PriceImpact:  "0.002",
+			EffectiveFee: "0.003",
+			RouteData: &astroport.RouteData{
+				Hops:      []astroport.Hop{{PairAddress: "neutron1pair", PairType: "xyk", DenomIn: tokenIn, DenomOut: tokenOut}},
+				AmountIn:  amountIn,
+				AmountOut: "975000",
+			},
+		}, nil
+	})
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "juno1receiver",
+	}
+
+	// Astroport doesn't report the liquidity of its pairs, its quote can't pass the guard
+	pathfinder.SetRouteGuards(router.RouteGuards{MinLiquidityUSD: 10000})
+	response := pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeLiquidityUnknown)
+
+	// Set to accept unknown liquidity the Astroport quote passes with a warning, the mock Osmosis quote
+	// doesn't report its liquidity either and is still rejected
+	pathfinder.SetRouteGuards(router.RouteGuards{
+		MinLiquidityUSD:        10000,
+		AcceptUnknownLiquidity: map[string]bool{"astroport": true},
+	})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+	assert.Equal(t, response.BrokerSwap.Swap.Broker, "astroport")
+	assert.Equal(t, len(response.Warnings), 1)
+	assert.Equal(t, response.Warnings[0].Code, models.WarningCodeLiquidityUnchecked)
+}
+
+func TestPathfinder_MaxHopsGuard(t *testing.T) {
+	queries := 0
+	pathfinder := newGuardPathfinder(t, "0.001", &MockRouteData{operations: guardOperations}, &queries)
+	pathfinder.SetRouteGuards(router.RouteGuards{MaxHops: 1})
+
+	// The route transfers to Osmosis and on to Juno, it is rejected without quoting the swap
+	response := pathfinder.FindPath(t.Context(), guardRequest(t))
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeTooManyHops)
+	assert.Equal(t, queries, 0)
+
+	// Direct routes are a single transfer
+	response = pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.True(t, response.Success)
+
+	// A request can't allow more hops than the server
+	req := guardRequest(t)
+	maxHops := uint32(2)
+	req.MaxHops = &maxHops
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeTooManyHops)
+	assert.Equal(t, queries, 0)
+
+	pathfinder.SetRouteGuards(router.RouteGuards{})
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.True(t, response.Success)
+	assert.Equal(t, queries, 1)
+
+	// Without server guards the limit of the request applies
+	maxHops = 1
+	response = pathfinder.FindPath(t.Context(), req)
+	assert.False(t, response.Success)
+	assert.Equal(t, response.ErrorCode, models.ErrorCodeTooManyHops)
+}
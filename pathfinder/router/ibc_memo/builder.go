@@ -42,6 +42,13 @@ type MemoBuilder interface {
 	GetContractAddress() string
 }
 
+// SplitRouteData is implemented by broker route data that can split the swap input over several routes.
+// When it returns more than one route the swap is built as smart_swap_exact_asset_in.
+type SplitRouteData interface {
+	// GetSplitRoutes returns the routes of the swap, each offering its part of the input
+	GetSplitRoutes(tokenInDenom string) []SwapRoute
+}
+
 // SwapMemoParams contains parameters for building a simple swap memo (case 2).
 // Used when: Source -> Broker (swap) -> stays on Broker
 type SwapMemoParams struct {
@@ -49,12 +56,16 @@ type SwapMemoParams struct {
 	TokenInDenom string
 	// TokenOutDenom is the denom of the output token on the broker chain
 	TokenOutDenom string
-	// MinOutputAmount is the minimum output amount (for slippage protection)
+	// MinOutputAmount is the minimum output amount (for slippage protection).
+	// For exact output swaps it is the exact amount to receive.
 	MinOutputAmount string
+	// ExactOutput selects swap_exact_asset_out instead of swap_exact_asset_in,
+	// the input left over after the swap is refunded to RecoverAddress
+	ExactOutput bool
 	// RouteData contains broker-specific routing information (pools, etc.)
 	// Each broker implementation casts this to their specific type.
 	RouteData interface{}
-	// TimeoutTimestamp is the timeout in nanoseconds
+	// TimeoutTimestamp is the Unix time in nanoseconds the swap and its post swap transfer time out at
 	TimeoutTimestamp int64
 	// RecoverAddress is where funds go if the swap fails (on broker chain)
 	RecoverAddress string
@@ -126,8 +137,24 @@ type IBCHop struct {
 	// converter to derive the correct bech32 address for intermediate chains; the
 	// final hop uses the user's destination address.
 	Receiver string
-	// Timeout in nanoseconds
+	// Timeout is the time the transfer of this hop alone has to be received in nanoseconds,
+	// the forward timeout of the hop is computed with ForwardTimeouts
 	Timeout int64
+	// Retries is the number of times PFM retries the transfer of this hop if it fails
+	Retries int
+}
+
+// ForwardTimeouts returns the relative PFM timeout of every hop of nested forwards in nanoseconds.
+// A forward is only done once every hop after it is done, so a hop gets its own timeout plus the
+// timeouts of all hops after it and the timeouts decrease towards the last hop.
+func ForwardTimeouts(hops []IBCHop) []int64 {
+	timeouts := make([]int64, len(hops))
+	var remaining int64
+	for i := len(hops) - 1; i >= 0; i-- {
+		remaining += hops[i].Timeout
+		timeouts[i] = remaining
+	}
+	return timeouts
 }
 
 // buildNestedForwardMemo builds a nested PFM forward structure for multi-hop forwarding.
@@ -142,6 +169,7 @@ func BuildNestedForwardMemo(hops []IBCHop, finalReceiver string) *PFMForward {
 
 	// Build from the last hop backwards
 	var current *PFMForward
+	timeouts := ForwardTimeouts(hops)
 
 	for i := len(hops) - 1; i >= 0; i-- {
 		hop := hops[i]
@@ -160,8 +188,8 @@ func BuildNestedForwardMemo(hops []IBCHop, finalReceiver string) *PFMForward {
 				hop.Channel,
 				hop.Port,
 				receiver,
-				DefaultRetries(),
-				hop.Timeout,
+				hop.Retries,
+				timeouts[i],
 				nil,
 			)
 		} else {
@@ -170,8 +198,8 @@ func BuildNestedForwardMemo(hops []IBCHop, finalReceiver string) *PFMForward {
 				hop.Channel,
 				hop.Port,
 				receiver,
-				DefaultRetries(),
-				hop.Timeout,
+				hop.Retries,
+				timeouts[i],
 				NewPFMNextWithForward(current),
 			)
 		}
@@ -181,7 +209,7 @@ func BuildNestedForwardMemo(hops []IBCHop, finalReceiver string) *PFMForward {
 }
 
 // BuildSimpleForwardMemo creates a simple PFM forward memo (case 1 from doc.go).
-// This is a convenience method for non-swap forwarding.
+// This is a convenience method for non-swap forwarding, timeout is relative in nanoseconds.
 func BuildSimpleForwardMemo(channel, port, receiver string, timeout int64) (string, error) {
 	memo := NewForwardMemo(channel, port, receiver, DefaultRetries(), timeout)
 	return memo.ToJSON()
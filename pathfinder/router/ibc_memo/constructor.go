@@ -47,6 +47,56 @@ func NewUserSwap(swapVenueName string, operations []SwapOperation) *UserSwap {
 	}
 }
 
+// NewUserSwapExactOut creates a new UserSwap with swap_exact_asset_out
+func NewUserSwapExactOut(swapVenueName string, operations []SwapOperation, refundAddress string) *UserSwap {
+	return &UserSwap{
+		SwapExactAssetOut: &SwapExactAssetOut{
+			SwapVenueName: swapVenueName,
+			Operations:    operations,
+			RefundAddress: refundAddress,
+		},
+	}
+}
+
+// NewUserSmartSwap creates a new UserSwap with smart_swap_exact_asset_in
+func NewUserSmartSwap(swapVenueName string, routes []SwapRoute) *UserSwap {
+	return &UserSwap{
+		SmartSwapExactAssetIn: &SmartSwapExactAssetIn{
+			SwapVenueName: swapVenueName,
+			Routes:        routes,
+		},
+	}
+}
+
+// NewUserSwapFromParams creates the UserSwap matching the swap mode of the params:
+// swap_exact_asset_out refunding to the recover address for exact output swaps,
+// smart_swap_exact_asset_in if the route data splits the input over several routes
+// and swap_exact_asset_in otherwise
+func NewUserSwapFromParams(swapVenueName string, operations []SwapOperation, params SwapMemoParams) *UserSwap {
+	if params.ExactOutput {
+		return NewUserSwapExactOut(swapVenueName, operations, params.RecoverAddress)
+	}
+	if splitData, ok := params.RouteData.(SplitRouteData); ok {
+		if routes := splitData.GetSplitRoutes(params.TokenInDenom); len(routes) > 1 {
+			return NewUserSmartSwap(swapVenueName, routes)
+		}
+	}
+	return NewUserSwap(swapVenueName, operations)
+}
+
+// NewSwapRoute creates a single route of a split swap offering the given amount
+func NewSwapRoute(denom, amount string, operations []SwapOperation) SwapRoute {
+	return SwapRoute{
+		OfferAsset: &OfferAsset{
+			Native: &Asset{
+				Denom:  denom,
+				Amount: amount,
+			},
+		},
+		Operations: operations,
+	}
+}
+
 // NewSwapOperation creates a single swap operation (pool hop)
 func NewSwapOperation(pool, denomIn, denomOut string) SwapOperation {
 	return SwapOperation{
@@ -157,6 +207,11 @@ func DefaultTimeoutTimestamp() int64 {
 	return time.Now().Add(15 * time.Minute).UnixNano()
 }
 
+// DefaultHopTimeout returns the default time an IBC transfer has to be received
+func DefaultHopTimeout() time.Duration {
+	return 10 * time.Minute
+}
+
 // DefaultRetries returns the default number of retries for PFM
 func DefaultRetries() int {
 	return 2
@@ -30,7 +30,7 @@ This example is for a transfer from Neutron, sending USDC to Osmosis:
 	    "port": "transfer",
 	    "receiver": "osmo1bech32address",
 	    "retries": 2,
-	    "timeout": 1769791113913992700
+	    "timeout": 600000000000
 	  }
 	}
 
@@ -165,7 +165,7 @@ send the assets over the IBC:
 	        "ibc_info": {
 	          "source_channel": "channel-750",
 	          "receiver": "noble1bech32address",
-	          "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":1769794463350749612}}",
+	          "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":600000000000}}",
 	          "recover_address": "osmo1bech32address"
 	        }
 	      }
@@ -301,7 +301,7 @@ This is an example transfer from Neutron to Injective Chain.
 	      "port":"transfer",
 	      "receiver":"osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u",
 	      "retries":2,
-	      "timeout":1769614031777016644
+	      "timeout":600000000000
 	   }
 	}
 
@@ -346,7 +346,7 @@ of the memo should be similar to what was already explained in Swap and Transfer
 	            "ibc_info": {
 	              "source_channel": "channel-750",
 	              "receiver": "noble1bech32address",
-	              "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":1769804258113749879}}",
+	              "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":600000000000}}",
 	              "recover_address": "osmo1bech32address"
 	            }
 	          }
@@ -380,7 +380,7 @@ IBC transaction is the smart contract address on the Osmosis chain.
 	            "post_swap_action": {
 	              "ibc_transfer": {
 	                "ibc_info": {
-	                  "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":1769803380957035530}}",
+	                  "memo": "{\"forward\":{\"channel\":\"channel-3\",\"port\":\"transfer\",\"receiver\":\"juno1bech32address\",\"retries\":2,\"timeout\":600000000000}}",
 	                  "receiver": "noble1bech32address",
 	                  "recover_address": "osmo1bech32address",
 	                  "source_channel": "channel-132"
@@ -417,10 +417,64 @@ IBC transaction is the smart contract address on the Osmosis chain.
 	    "port": "transfer",
 	    "receiver": "osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u",
 	    "retries": 2,
-	    "timeout": 1769803380957019000
+	    "timeout": 600000000000
 	  }
 	}
 
 So this is combination of all things seen here before and it is the most complex variation.
+
+6. Split routes
+
+For larger trades the broker can quote a better price by splitting the input over several routes.
+All of the cases above can carry a split swap, only the user_swap part changes. Instead of the
+swap_exact_asset_in the smart_swap_exact_asset_in is used and every route offers its own part of the input.
+The offered amounts must add up to the amount the contract receives:
+
+	"user_swap": {
+	  "smart_swap_exact_asset_in": {
+	    "swap_venue_name": "osmosis-poolmanager",
+	    "routes": [
+	      {
+	        "offer_asset": { "native": { "denom": "uatom", "amount": "700000" } },
+	        "operations": [
+	          { "pool": "1", "denom_in": "uatom", "denom_out": "uosmo" }
+	        ]
+	      },
+	      {
+	        "offer_asset": { "native": { "denom": "uatom", "amount": "300000" } },
+	        "operations": [
+	          { "pool": "1135", "denom_in": "uatom", "denom_out": "uosmo" }
+	        ]
+	      }
+	    ]
+	  }
+	}
+
+The min_asset is checked against the summed output of all routes.
+
+7. Timeouts and retries
+
+The timeout of a PFM forward is relative, it is a duration in nanoseconds counted from the moment the
+forwarding chain sends the transfer on. A forward is only done once every hop after it is done, so the
+forwards of nested memos get decreasing timeouts, see ForwardTimeouts. For three hops of 10 minutes each:
+
+	{
+	  "forward": {
+	    "channel": "channel-0",
+	    "retries": 2,
+	    "timeout": 1800000000000,
+	    "next": {
+	      "forward": {
+	        "channel": "channel-141",
+	        "retries": 2,
+	        "timeout": 1200000000000,
+	        "next": { "forward": { "channel": "channel-3", "retries": 2, "timeout": 600000000000 } }
+	      }
+	    }
+	  }
+	}
+
+The timeout_timestamp of swap_and_action is absolute, it is the Unix time in nanoseconds the swap and
+the transfer after it time out at.
 */
 package ibcmemo
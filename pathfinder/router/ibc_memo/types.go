@@ -60,9 +60,11 @@ type SwapAndAction struct {
 	Affiliates       []interface{}   `json:"affiliates"`
 }
 
-// UserSwap contains the swap route information
+// UserSwap contains the swap route information (union type)
 type UserSwap struct {
-	SwapExactAssetIn *SwapExactAssetIn `json:"swap_exact_asset_in"`
+	SwapExactAssetIn      *SwapExactAssetIn      `json:"swap_exact_asset_in,omitempty"`
+	SwapExactAssetOut     *SwapExactAssetOut     `json:"swap_exact_asset_out,omitempty"`
+	SmartSwapExactAssetIn *SmartSwapExactAssetIn `json:"smart_swap_exact_asset_in,omitempty"`
 }
 
 // SwapExactAssetIn contains the swap venue and operations
@@ -71,6 +73,32 @@ type SwapExactAssetIn struct {
 	Operations    []SwapOperation `json:"operations"`
 }
 
+// SwapExactAssetOut contains the swap venue and operations for an exact output swap.
+// The min_asset of the swap is the exact amount to receive, the unused input is sent to RefundAddress.
+type SwapExactAssetOut struct {
+	SwapVenueName string          `json:"swap_venue_name"`
+	Operations    []SwapOperation `json:"operations"`
+	RefundAddress string          `json:"refund_address,omitempty"`
+}
+
+// SmartSwapExactAssetIn splits the input over several routes, each route swaps its own offer amount.
+// The outputs of all routes are summed and checked against the min_asset of the swap.
+type SmartSwapExactAssetIn struct {
+	SwapVenueName string      `json:"swap_venue_name"`
+	Routes        []SwapRoute `json:"routes"`
+}
+
+// SwapRoute is a single route of a split swap
+type SwapRoute struct {
+	OfferAsset *OfferAsset     `json:"offer_asset"`
+	Operations []SwapOperation `json:"operations"`
+}
+
+// OfferAsset is the part of the input swapped through a route
+type OfferAsset struct {
+	Native *Asset `json:"native"`
+}
+
 // SwapOperation represents a single pool hop in a swap route
 type SwapOperation struct {
 	Pool     string `json:"pool"`
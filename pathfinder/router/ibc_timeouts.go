@@ -0,0 +1,95 @@
+package router
+
+import (
+	"time"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// IBCTimeouts are the timeouts and PFM retries of the IBC transfers routes are executed with.
+// Requests can override the timeout and the retries of every transfer with their own.
+type IBCTimeouts struct {
+	// Time a transfer has to be received, ibcmemo.DefaultHopTimeout() when unset
+	Timeout time.Duration
+	// Times PFM retries a forwarded transfer that failed, ibcmemo.DefaultRetries() when unset
+	Retries int
+	// Settings of slow or unreliable chains by chain id, they apply to every transfer from or to the chain
+	Chains map[string]ChainIBCTimeouts
+}
+
+// ChainIBCTimeouts are the IBC settings of a single chain, unset values use the server-level ones
+type ChainIBCTimeouts struct {
+	Timeout time.Duration
+	Retries int
+}
+
+// SetIBCTimeouts sets the server-level and per-chain IBC timeouts and retries.
+// It must be called before the pathfinder serves requests.
+func (s *Pathfinder) SetIBCTimeouts(timeouts IBCTimeouts) {
+	s.ibcTimeouts = timeouts
+}
+
+// hopTimeout returns the time the transfer from fromChain to toChain has to be received,
+// the timeout of the request or else the longer of the two chain timeouts
+func (s *Pathfinder) hopTimeout(req models.RouteRequest, fromChain, toChain string) time.Duration {
+	if req.IBCTimeoutSeconds != nil {
+		return time.Duration(*req.IBCTimeoutSeconds) * time.Second
+	}
+
+	timeout := s.ibcTimeouts.Timeout
+	if timeout == 0 {
+		timeout = ibcmemo.DefaultHopTimeout()
+	}
+	for _, chainId := range []string{fromChain, toChain} {
+		if chain, ok := s.ibcTimeouts.Chains[chainId]; ok && chain.Timeout > timeout {
+			timeout = chain.Timeout
+		}
+	}
+	return timeout
+}
+
+// hopRetries returns how often PFM retries the transfer from fromChain to toChain,
+// the retries of the request or else the larger of the two chain retries
+func (s *Pathfinder) hopRetries(req models.RouteRequest, fromChain, toChain string) int {
+	if req.PFMRetries != nil {
+		return int(*req.PFMRetries)
+	}
+
+	retries := s.ibcTimeouts.Retries
+	if retries == 0 {
+		retries = ibcmemo.DefaultRetries()
+	}
+	for _, chainId := range []string{fromChain, toChain} {
+		if chain, ok := s.ibcTimeouts.Chains[chainId]; ok && chain.Retries > retries {
+			retries = chain.Retries
+		}
+	}
+	return retries
+}
+
+// newIBCHop returns a hop with the timeout and retries of the transfer from fromChain to toChain
+func (s *Pathfinder) newIBCHop(req models.RouteRequest, fromChain, toChain, channel, port, receiver string) ibcmemo.IBCHop {
+	return ibcmemo.IBCHop{
+		Channel:  channel,
+		Port:     port,
+		Receiver: receiver,
+		Timeout:  s.hopTimeout(req, fromChain, toChain).Nanoseconds(),
+		Retries:  s.hopRetries(req, fromChain, toChain),
+	}
+}
+
+// swapTimeoutTimestamp returns the Unix time in nanoseconds a swap times out at,
+// late enough for every transfer of the route to be received
+func swapTimeoutTimestamp(hops ...[]ibcmemo.IBCHop) int64 {
+	var total time.Duration
+	for _, group := range hops {
+		for _, hop := range group {
+			total += time.Duration(hop.Timeout)
+		}
+	}
+	if total == 0 {
+		total = ibcmemo.DefaultHopTimeout()
+	}
+	return time.Now().Add(total).UnixNano()
+}
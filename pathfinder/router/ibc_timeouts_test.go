@@ -0,0 +1,97 @@
+package router_test
+
+import (
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+// pfmRequest returns junoToOsmosisUSDC with an amount and addresses
+func pfmRequest(t *testing.T) models.RouteRequest {
+	t.Helper()
+	req := junoToOsmosisUSDC
+	req.AmountIn = "5000000"
+	req.SenderAddress = addressOn(t, "juno")
+	req.ReceiverAddress = addressOn(t, "osmo")
+	return req
+}
+
+// pfmForward returns the forward of an indirect route's PFM memo
+func pfmForward(t *testing.T, response models.RouteResponse) *ibcmemo.PFMForward {
+	t.Helper()
+	assert.True(t, response.Success)
+	assert.Equal(t, response.RouteType, "indirect")
+
+	var memo ibcmemo.ForwardMemo
+	assert.NoError(t, json.Unmarshal([]byte(response.Indirect.PFMMemo), &memo))
+	assert.NotNil(t, memo.Forward)
+	return memo.Forward
+}
+
+func TestPathfinder_PFMMemoTimeouts(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	t.Run("defaults", func(t *testing.T) {
+		forward := pfmForward(t, pathfinder.FindPath(t.Context(), pfmRequest(t)))
+		assert.Equal(t, forward.Timeout, ibcmemo.DefaultHopTimeout().Nanoseconds())
+		assert.Equal(t, forward.Retries, ibcmemo.DefaultRetries())
+	})
+
+	t.Run("slow chain", func(t *testing.T) {
+		pathfinder.SetIBCTimeouts(router.IBCTimeouts{
+			Chains: map[string]router.ChainIBCTimeouts{"noble-1": {Timeout: 30 * time.Minute, Retries: 4}},
+		})
+		defer pathfinder.SetIBCTimeouts(router.IBCTimeouts{})
+
+		forward := pfmForward(t, pathfinder.FindPath(t.Context(), pfmRequest(t)))
+		assert.Equal(t, forward.Timeout, (30 * time.Minute).Nanoseconds())
+		assert.Equal(t, forward.Retries, 4)
+	})
+
+	t.Run("request override", func(t *testing.T) {
+		pathfinder.SetIBCTimeouts(router.IBCTimeouts{
+			Chains: map[string]router.ChainIBCTimeouts{"noble-1": {Timeout: 30 * time.Minute, Retries: 4}},
+		})
+		defer pathfinder.SetIBCTimeouts(router.IBCTimeouts{})
+
+		req := pfmRequest(t)
+		timeoutSeconds, retries := uint32(120), uint32(1)
+		req.IBCTimeoutSeconds = &timeoutSeconds
+		req.PFMRetries = &retries
+
+		forward := pfmForward(t, pathfinder.FindPath(t.Context(), req))
+		assert.Equal(t, forward.Timeout, (2 * time.Minute).Nanoseconds())
+		assert.Equal(t, forward.Retries, 1)
+	})
+}
+
+func TestBuildNestedForwardMemo_DecreasingTimeouts(t *testing.T) {
+	minute := time.Minute.Nanoseconds()
+	hops := []ibcmemo.IBCHop{
+		{Channel: "channel-0", Port: "transfer", Receiver: "pfm", Timeout: 10 * minute, Retries: 2},
+		{Channel: "channel-141", Port: "transfer", Receiver: "pfm", Timeout: 30 * minute, Retries: 4},
+		{Channel: "channel-3", Port: "transfer", Timeout: 5 * minute, Retries: 2},
+	}
+	assert.DeepEqual(t, ibcmemo.ForwardTimeouts(hops), []int64{45 * minute, 35 * minute, 5 * minute})
+
+	forward := ibcmemo.BuildNestedForwardMemo(hops, "juno1receiver")
+	var timeouts []int64
+	var retries []int
+	for forward != nil {
+		timeouts = append(timeouts, forward.Timeout)
+		retries = append(retries, forward.Retries)
+		if forward.Next == nil {
+			assert.Equal(t, forward.Receiver, "juno1receiver")
+			break
+		}
+		forward = forward.Next.Forward
+	}
+	assert.DeepEqual(t, timeouts, []int64{45 * minute, 35 * minute, 5 * minute})
+	assert.DeepEqual(t, retries, []int{2, 4, 2})
+}
@@ -15,6 +15,7 @@ func NewRouteIndex() *RouteIndex {
 		brokerChains:        make(map[string]string),
 		pfmChains:           make(map[string]bool),
 		chainRoutes:         make(map[string]map[string]*BasicRoute),
+		channelHealth:       NewChannelHealth(),
 	}
 }
 
@@ -1,18 +1,29 @@
 package router
 
 import (
+	"context"
 	"fmt"
 	"os"
+	"strings"
+	"sync/atomic"
 	"time"
 
 	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
 	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
 	"github.com/rs/zerolog"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
 )
 
 var pathfinderLog zerolog.Logger
 
+// tracer creates the spans of route solving, it picks up the global tracer provider once the OTel SDK is set up
+var tracer = otel.Tracer("github.com/Cogwheel-Validator/spectra-portal/pathfinder/router")
+
 func init() {
 	out := zerolog.ConsoleWriter{Out: os.Stderr, TimeFormat: time.RFC3339}
 	pathfinderLog = zerolog.New(out).With().Timestamp().Str("component", "pathfinder").Logger()
@@ -20,48 +31,75 @@ func init() {
 
 // Pathfinder orchestrates route finding and integrates with broker DEX APIs
 type Pathfinder struct {
-	chainsMap        map[string]PathfinderChain      // mapped chainId -> PathfinderChain
-	routeIndex       *RouteIndex                     // routeIndex from which all routes are found
-	brokerClients    map[string]brokers.BrokerClient // mapped brokerId -> broker client interface
-	denomResolver    *DenomResolver                  // denomResolver for resolving denoms across chains
-	addressConverter *AddressConverter               // addressConverter for converting addresses across chains
-	maxRetries       int                             // maximum number of retries for broker queries
-	retryDelay       time.Duration                   // delay between retries for broker queries
+	*routingTables                                 // routing tables of the current request, see current
+	tables         *atomic.Pointer[routingTables]  // latest routing tables, swapped by Reload
+	brokerClients  map[string]brokers.BrokerClient // mapped brokerId -> broker client interface
+	priceProvider  prices.Provider                 // prices routes are valued with, nil if valuation is off
+	guards         RouteGuards                     // server-level route guards, requests can tighten them
+	ibcTimeouts    IBCTimeouts                     // timeouts and PFM retries of the transfers, requests can override them
+	maxRetries     int                             // maximum number of retries for broker queries
+	retryDelay     time.Duration                   // delay between retries for broker queries
+	pinned         bool                            // the view keeps its routing tables, see current
 }
 
 // NewPathfinder creates a new Pathfinder with the given route index and broker clients
 func NewPathfinder(chains []PathfinderChain, routeIndex *RouteIndex, brokerClients map[string]brokers.BrokerClient) *Pathfinder {
-	chainMap := make(map[string]PathfinderChain, len(chains))
-	for _, chain := range chains {
-		chainMap[chain.Id] = chain
-	}
+	tables := &atomic.Pointer[routingTables]{}
+	tables.Store(newRoutingTables(chains, routeIndex))
+
 	return &Pathfinder{
-		chainsMap:        chainMap,
-		routeIndex:       routeIndex,
-		brokerClients:    brokerClients,
-		denomResolver:    NewDenomResolver(routeIndex),
-		addressConverter: NewAddressConverter(chains),
-		maxRetries:       3,
-		retryDelay:       500 * time.Millisecond,
+		routingTables: tables.Load(),
+		tables:        tables,
+		brokerClients: brokerClients,
+		maxRetries:    3,
+		retryDelay:    500 * time.Millisecond,
 	}
 }
 
 // FindPath attempts to find a route for the given request and returns execution details
 // Priority order: 1) Direct route, 2) Indirect route (no swap), 3) Broker swap route
-func (s *Pathfinder) FindPath(req models.RouteRequest) models.RouteResponse {
+// For broker swap routes all eligible brokers are quoted and the best quote is returned,
+// with the quotes of the other brokers attached as alternatives.
+// Broker queries are bound to ctx, once it is done they stop and the route fails with the context error.
+// If a price provider is set the route found is valued in USD.
+func (s *Pathfinder) FindPath(ctx context.Context, req models.RouteRequest) models.RouteResponse {
+	response, _ := s.current().solvePath(ctx, req)
+	return response
+}
+
+// solvePath finds and values the route of req like FindPath, it also returns the broker route candidate
+// the route was quoted for, nil when the route has no swap or failed
+func (s *Pathfinder) solvePath(ctx context.Context, req models.RouteRequest) (models.RouteResponse, *MultiHopInfo) {
+	ctx, span := tracer.Start(ctx, "Pathfinder.FindPath", trace.WithAttributes(routeRequestAttributes(req)...))
+	defer span.End()
+
 	pathfinderLog.Info().
 		Str("chainFrom", req.ChainFrom).
 		Str("chainTo", req.ChainTo).
 		Str("tokenFrom", req.TokenFromDenom).
 		Str("tokenTo", req.TokenToDenom).
 		Str("amount", req.AmountIn).
+		Str("amountOut", req.AmountOut).
 		Msg("Solving route")
 
+	// IBC transfers keep the amount, so routes without a swap send exactly the requested output.
+	// Broker routes replace it with the maximum input of their exact out quote.
+	if req.IsExactOut() {
+		req.AmountIn = req.AmountOut
+	}
+
+	response, candidate := s.findPath(ctx, req)
+	s.newValuer().attachValuation(ctx, req, &response)
+	return response, candidate
+}
+
+// findPath returns the first route found in the priority order of FindPath and the broker route candidate of it
+func (s *Pathfinder) findPath(ctx context.Context, req models.RouteRequest) (models.RouteResponse, *MultiHopInfo) {
 	// First, try to find a direct IBC route (no swap needed)
 	directRoute := s.routeIndex.FindDirectRoute(req)
 	if directRoute != nil {
 		pathfinderLog.Info().Msg("Found direct route")
-		return s.buildDirectResponse(req, directRoute)
+		return s.buildDirectResponse(req, directRoute), nil
 	}
 	pathfinderLog.Debug().Msg("No direct route found")
 
@@ -69,7 +107,7 @@ func (s *Pathfinder) FindPath(req models.RouteRequest) models.RouteResponse {
 	indirectRoute := s.routeIndex.FindIndirectRoute(req)
 	if indirectRoute != nil {
 		pathfinderLog.Info().Int("hops", len(indirectRoute.Path)-1).Msg("Found indirect route")
-		return s.buildIndirectResponse(req, indirectRoute)
+		return s.buildIndirectResponse(req, indirectRoute), nil
 	}
 	pathfinderLog.Debug().Msg("No indirect route found")
 
@@ -81,40 +119,48 @@ func (s *Pathfinder) FindPath(req models.RouteRequest) models.RouteResponse {
 			Success:      false,
 			RouteType:    "impossible",
 			ErrorMessage: "No route found between chains for the requested tokens",
-		}
+		}, nil
 	}
 
 	pathfinderLog.Info().Int("candidates", len(brokerRoutes)).Msg("Found broker route candidates")
 
-	// Try each broker route and query the broker for swap details
-	var lastErr error
-	for i, hopInfo := range brokerRoutes {
-		pathfinderLog.Debug().
-			Int("attempt", i+1).
-			Str("broker", hopInfo.BrokerChain).
-			Bool("swapOnly", hopInfo.SwapOnly).
-			Msg("Trying broker route")
-
-		response, err := s.buildBrokerSwapResponse(req, hopInfo)
-		if err == nil {
-			pathfinderLog.Info().Str("broker", hopInfo.BrokerChain).Msg("Broker route succeeded")
-			return response
-		}
-		lastErr = err
-		pathfinderLog.Debug().Err(err).Str("broker", hopInfo.BrokerChain).Msg("Broker route failed, trying next")
+	// Query every broker concurrently and pick the best quote
+	quoted, quotedCandidates, lastErr := s.quoteBrokerRoutes(ctx, req, brokerRoutes)
+	if len(quoted) > 0 {
+		best := withAlternativeQuotes(quoted)
+		pathfinderLog.Info().
+			Str("broker", best.BrokerSwap.Swap.Broker).
+			Int("quotes", len(quoted)).
+			Msg("Broker route succeeded")
+		return best, quotedCandidates[0]
 	}
 
 	// All brokers failed or returned no valid route
-	errMsg := "Broker swap route found but broker query failed"
-	if lastErr != nil {
-		errMsg = fmt.Sprintf("Broker swap route found but query failed: %v", lastErr)
-	}
 	pathfinderLog.Warn().Err(lastErr).Msg("All broker routes failed")
+	return brokerFailureResponse(ctx, lastErr), nil
+}
+
+// brokerFailureResponse is the response of a broker route that could not be quoted, lastErr is the error of the last failed candidate
+func brokerFailureResponse(ctx context.Context, lastErr error) models.RouteResponse {
+	errMsg := brokerFailureMessage(lastErr)
+	trace.SpanFromContext(ctx).SetStatus(codes.Error, errMsg)
 	return models.RouteResponse{
 		Success:      false,
 		RouteType:    "impossible",
 		ErrorMessage: errMsg,
+		ErrorCode:    guardErrorCode(lastErr),
+	}
+}
+
+// brokerFailureMessage describes why no broker route could be used, lastErr is the error of the last failed candidate
+func brokerFailureMessage(lastErr error) string {
+	switch {
+	case lastErr == nil:
+		return "Broker swap route found but broker query failed"
+	case guardErrorCode(lastErr) != "":
+		return fmt.Sprintf("Broker swap route found but rejected: %v", lastErr)
 	}
+	return fmt.Sprintf("Broker swap route found but query failed: %v", lastErr)
 }
 
 // buildDirectResponse creates a RouteResponse for a direct IBC transfer
@@ -142,15 +188,48 @@ func (s *Pathfinder) buildDirectResponse(req models.RouteRequest, route *BasicRo
 		},
 	}
 
-	return models.RouteResponse{
+	response := models.RouteResponse{
 		Success:   true,
 		RouteType: "direct",
 		Direct:    direct,
+		Warnings:  s.canonicalDenomWarnings(req),
 	}
+	s.attachFees(&response)
+	return response
 }
 
 // buildIndirectResponse creates a RouteResponse for a multi-hop route without swaps
 func (s *Pathfinder) buildIndirectResponse(req models.RouteRequest, routeInfo *IndirectRouteInfo) models.RouteResponse {
+	if err := s.routeGuards(req).checkHops(len(routeInfo.Routes)); err != nil {
+		pathfinderLog.Info().Err(err).Msg("Indirect route rejected")
+		return models.RouteResponse{
+			Success:      false,
+			RouteType:    "impossible",
+			ErrorMessage: fmt.Sprintf("Indirect route found but rejected: %v", err),
+			ErrorCode:    guardErrorCode(err),
+		}
+	}
+
+	// The token would arrive with another trace than the requested token, e.g. ATOM forwarded over
+	// Osmosis instead of coming from the Hub. It is a different token on the destination.
+	if s.routeIndex.deliversOtherDenom(req, routeInfo) {
+		pathfinderLog.Info().
+			Str("delivered", routeInfo.DeliveredDenom).
+			Str("requested", req.TokenToDenom).
+			Msg("Indirect route rejected, it delivers another denom")
+		delivered := routeInfo.DeliveredDenom
+		if routeInfo.DeliveredPath != "" {
+			delivered += " (" + routeInfo.DeliveredPath + ")"
+		}
+		return models.RouteResponse{
+			Success:   false,
+			RouteType: "impossible",
+			ErrorMessage: fmt.Sprintf("Indirect route found but rejected: the token would arrive on %s as %s over %s instead of %s",
+				req.ChainTo, delivered, strings.Join(routeInfo.Path, " -> "), req.TokenToDenom),
+			ErrorCode: models.ErrorCodeNonCanonicalDenom,
+		}
+	}
+
 	// Build IBC legs for each hop
 	legs := []*models.IBCLeg{}
 	currentDenom := req.TokenFromDenom
@@ -160,11 +239,14 @@ func (s *Pathfinder) buildIndirectResponse(req models.RouteRequest, routeInfo *I
 		fromChain := routeInfo.Path[i]
 		toChain := routeInfo.Path[i+1]
 
-		// Get token info on the current chain
+		// Get token info on the current chain, as the search found it
 		var tokenInfo *TokenInfo
-		if i == 0 {
+		switch {
+		case i < len(routeInfo.Tokens):
+			tokenInfo = routeInfo.Tokens[i]
+		case i == 0:
 			tokenInfo = routeInfo.Token
-		} else {
+		default:
 			tokenInfo = s.routeIndex.findTokenByOrigin(fromChain, routeInfo.Token.OriginChain, routeInfo.Token.BaseDenom)
 		}
 
@@ -213,7 +295,17 @@ func (s *Pathfinder) buildIndirectResponse(req models.RouteRequest, routeInfo *I
 	pfmMemo := ""
 
 	if supportsPFM && len(routeInfo.Path) > 2 {
-		pfmMemo = s.generatePFMMemo(legs, req.ReceiverAddress)
+		// The first leg is the transfer the memo is attached to, the memo forwards the ones after it
+		pfmMemo = s.generatePFMMemo(legs[1:], req)
+
+		// The forwarding chains keep their PFM fee, exact out routes send enough to deliver the requested amount
+		forwarders := forwardingChains(legs)
+		if req.IsExactOut() {
+			legs[0].Amount = s.beforePFMFees(req.AmountOut, forwarders)
+		}
+		for i := 1; i < len(legs); i++ {
+			legs[i].Amount = s.afterPFMFees(legs[i-1].Amount, forwarders[i-1:i])
+		}
 	}
 
 	indirect := &models.IndirectRoute{
@@ -224,11 +316,32 @@ func (s *Pathfinder) buildIndirectResponse(req models.RouteRequest, routeInfo *I
 		PFMMemo:       pfmMemo,
 	}
 
-	return models.RouteResponse{
+	response := models.RouteResponse{
 		Success:   true,
 		RouteType: "indirect",
 		Indirect:  indirect,
+		Warnings:  s.canonicalDenomWarnings(req),
 	}
+	s.attachFees(&response)
+	return response
+}
+
+// canonicalDenomWarnings warns if the requested token is not the canonical denom of the token on the destination,
+// i.e. the receiver gets a token that came a longer way from its origin than the one usually held there
+func (s *Pathfinder) canonicalDenomWarnings(req models.RouteRequest) []models.RouteWarning {
+	tokenInfo := s.routeIndex.denomToTokenInfo[req.ChainTo][req.TokenToDenom]
+	if tokenInfo == nil {
+		return nil
+	}
+	canonical, known := s.routeIndex.canonicalDenom(req.ChainTo, tokenInfo.OriginChain, tokenInfo.BaseDenom)
+	if !known || canonical == req.TokenToDenom {
+		return nil
+	}
+	return []models.RouteWarning{{
+		Code: models.WarningCodeNonCanonicalDenom,
+		Message: fmt.Sprintf("%s on %s has the trace %s, the %s usually held there is %s",
+			req.TokenToDenom, req.ChainTo, tokenInfo.ChainPath, tokenInfo.BaseDenom, canonical),
+	}}
 }
 
 // checkPFMSupport checks if all intermediate chains in the path support PFM
@@ -248,43 +361,31 @@ func (s *Pathfinder) checkPFMSupport(path []string) bool {
 	return true
 }
 
-// generatePFMMemo generates an IBC memo for PFM forwarding
-// Format: {"forward":{"receiver":"<addr>","port":"transfer","channel":"<channel>"}}
-// For multi-hop, we nest the forward messages
-func (s *Pathfinder) generatePFMMemo(legs []*models.IBCLeg, finalReceiver string) string {
+// generatePFMMemo generates an IBC memo for PFM forwarding over the given legs
+// Format: {"forward":{"channel":"<channel>","port":"transfer","receiver":"<addr>","retries":2,"timeout":<ns>}}
+// For multi-hop, we nest the forward messages, every forward uses the timeout and retries of its leg
+func (s *Pathfinder) generatePFMMemo(legs []*models.IBCLeg, req models.RouteRequest) string {
 	if len(legs) == 0 {
 		return ""
 	}
 
-	// Build memo from the last leg backwards
-	var buildMemo func(legIndex int, receiver string) string
-	buildMemo = func(legIndex int, receiver string) string {
-		if legIndex >= len(legs) {
-			return ""
-		}
-
-		leg := legs[legIndex]
-
-		if legIndex == len(legs)-1 {
-			// Last leg - use final receiver
-			return fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s"}}`,
-				receiver, leg.Port, leg.Channel)
-		}
-
-		// Intermediate leg - nest the next memo
-		nextMemo := buildMemo(legIndex+1, receiver)
-		// For intermediate hops, the receiver should be the intermediate chain's module account
-		// But in PFM, the memo itself handles forwarding, so we use a placeholder
-		return fmt.Sprintf(`{"forward":{"receiver":"%s","port":"%s","channel":"%s","next":%s}}`,
-			receiver, leg.Port, leg.Channel, nextMemo)
+	hops := make([]ibcmemo.IBCHop, len(legs))
+	for i, leg := range legs {
+		// PFM derives the receivers on the intermediate chains itself, so every forward names the final receiver
+		hops[i] = s.newIBCHop(req, leg.FromChain, leg.ToChain, leg.Channel, leg.Port, req.ReceiverAddress)
 	}
 
-	// Start from the first leg (after source chain)
-	return buildMemo(1, finalReceiver)
+	memo, err := ibcmemo.BuildNestedForwardMemo(hops, req.ReceiverAddress).ToJSON()
+	if err != nil {
+		pathfinderLog.Warn().Err(err).Msg("Failed to build PFM memo")
+		return ""
+	}
+	return memo
 }
 
 // buildBrokerSwapResponse creates a RouteResponse for a broker swap route
 func (s *Pathfinder) buildBrokerSwapResponse(
+	ctx context.Context,
 	req models.RouteRequest,
 	hopInfo *MultiHopInfo,
 ) (models.RouteResponse, error) {
@@ -298,6 +399,12 @@ func (s *Pathfinder) buildBrokerSwapResponse(
 		return models.RouteResponse{}, fmt.Errorf("no client configured for broker %s", hopInfo.BrokerChain)
 	}
 
+	// Routes with too many transfers are rejected before the broker is queried
+	guards := s.routeGuards(req)
+	if err := guards.checkHops(len(hopInfo.InboundRoutes) + len(hopInfo.OutboundRoutes)); err != nil {
+		return models.RouteResponse{}, err
+	}
+
 	// Determine the correct denoms to use on the broker chain (Osmosis SQS expects broker-chain denoms)
 	var tokenInDenomOnBroker string
 	if hopInfo.SourceIsBroker {
@@ -316,33 +423,63 @@ func (s *Pathfinder) buildBrokerSwapResponse(
 	// For the output token: use TokenOutOnBroker.ChainDenom (the denom on the broker chain)
 	tokenOutDenomOnBroker := hopInfo.TokenOutOnBroker.ChainDenom
 
+	// The chains forwarding the inbound hops keep their PFM fees before the funds reach the broker,
+	// exact in quotes are for what is left of the input.
+	// Exact out quotes are for the requested output plus the PFM fees kept by the outbound hops.
+	inbound := inboundForwarders(hopInfo)
+	amount := s.afterPFMFees(req.AmountIn, inbound)
+	if req.IsExactOut() {
+		amount = s.beforePFMFees(req.AmountOut, outboundForwarders(hopInfo))
+	}
+
 	pathfinderLog.Debug().
 		Str("tokenIn", tokenInDenomOnBroker).
 		Str("tokenOut", tokenOutDenomOnBroker).
-		Str("amount", req.AmountIn).
+		Str("amount", amount).
+		Bool("exactOut", req.IsExactOut()).
 		Bool("swapOnly", hopInfo.SwapOnly).
 		Bool("sourceIsBroker", hopInfo.SourceIsBroker).
 		Msg("Querying broker for swap")
 
 	// Query with retry logic
 	swapResult, err := s.queryBrokerWithRetry(
-		brokerClient, req.AmountIn, tokenInDenomOnBroker, tokenOutDenomOnBroker, req.SmartRoute)
+		ctx, brokerClient, amount, tokenInDenomOnBroker, tokenOutDenomOnBroker, req.SmartRoute, req.IsExactOut())
 	if err != nil {
 		pathfinderLog.Error().Err(err).Msg("Broker query failed")
 		return models.RouteResponse{}, fmt.Errorf("broker query failed: %w", err)
 	}
 
+	// Thin pools and large price impacts are rejected before anything is built for them
+	warnings, err := guards.checkSwap(hopInfo.BrokerChain, swapResult)
+	if err != nil {
+		pathfinderLog.Info().Err(err).Str("broker", hopInfo.BrokerChain).Msg("Broker swap rejected")
+		return models.RouteResponse{}, err
+	}
+
+	// For exact out the sender transfers the quoted input plus taker fees and slippage, the rest is refunded after the swap.
+	// The PFM fees of the inbound hops are sent on top so the max input still reaches the broker.
+	if req.IsExactOut() {
+		maxInput, err := brokers.CalculateMaxInput(beforeFee(swapResult.AmountIn, takerFee(swapResult)), slippageBps(req))
+		if err != nil {
+			return models.RouteResponse{}, fmt.Errorf("failed to calculate max input: %w", err)
+		}
+		req.AmountIn = s.beforePFMFees(maxInput, inbound)
+	}
+
 	// Build the broker swap route information
 	brokerRoute, err := s.buildBrokerRoute(req, hopInfo, swapResult, brokerClient)
 	if err != nil {
 		return models.RouteResponse{}, fmt.Errorf("failed to build broker route: %w", err)
 	}
 
-	return models.RouteResponse{
+	response := models.RouteResponse{
 		Success:    true,
 		RouteType:  "broker_swap",
 		BrokerSwap: brokerRoute,
-	}, nil
+		Warnings:   warnings,
+	}
+	s.attachFees(&response)
+	return response, nil
 }
 
 // getMapKeys returns the keys of a map as a slice
@@ -354,35 +491,94 @@ func getMapKeys(m map[string]brokers.BrokerClient) []string {
 	return keys
 }
 
-// queryBrokerWithRetry queries any broker DEX with exponential backoff retry logic
+// queryBrokerWithRetry queries any broker DEX with exponential backoff retry logic.
+// amount is the input amount, or the output amount when exactOut is set.
+// Retries stop as soon as ctx is done.
 func (s *Pathfinder) queryBrokerWithRetry(
+	ctx context.Context,
 	client brokers.BrokerClient,
-	amountIn string,
+	amount string,
 	tokenInDenom string,
 	tokenOutDenom string,
 	singleRoute *bool,
+	exactOut bool,
 ) (*brokers.SwapResult, error) {
+	ctx, span := tracer.Start(ctx, "Pathfinder.QueryBroker", trace.WithAttributes(
+		attribute.String("broker", client.GetBrokerType()),
+		attribute.String("token_in", tokenInDenom),
+		attribute.String("token_out", tokenOutDenom),
+		attribute.String("amount", amount),
+		attribute.Bool("exact_out", exactOut),
+	))
+	defer span.End()
+
 	var lastErr error
 	delay := s.retryDelay
 
 	for attempt := 0; attempt <= s.maxRetries; attempt++ {
 		if attempt > 0 {
-			time.Sleep(delay)
+			if err := sleepContext(ctx, delay); err != nil {
+				lastErr = err
+				break
+			}
 			delay *= 2 // Exponential backoff
 		}
 
 		// Query broker for the swap route
-		result, err := client.QuerySwap(tokenInDenom, amountIn, tokenOutDenom, singleRoute)
+		var result *brokers.SwapResult
+		var err error
+		if exactOut {
+			result, err = client.QuerySwapExactOut(ctx, tokenInDenom, tokenOutDenom, amount, singleRoute)
+		} else {
+			result, err = client.QuerySwap(ctx, tokenInDenom, amount, tokenOutDenom, singleRoute)
+		}
 		if err == nil {
+			span.SetAttributes(attribute.Int("attempts", attempt+1))
 			return result, nil
 		}
 
 		lastErr = err
+		span.AddEvent("broker query failed", trace.WithAttributes(attribute.String("error", err.Error())))
+		// No point in retrying a request that was cancelled or ran out of time
+		if ctx.Err() != nil {
+			lastErr = ctx.Err()
+			break
+		}
 	}
 
+	span.RecordError(lastErr)
+	span.SetStatus(codes.Error, lastErr.Error())
+	if ctx.Err() != nil {
+		return nil, fmt.Errorf("%s query stopped: %w", client.GetBrokerType(), lastErr)
+	}
 	return nil, fmt.Errorf("%s query failed after %d attempts: %w", client.GetBrokerType(), s.maxRetries+1, lastErr)
 }
 
+// sleepContext waits for delay, returning early with the context error if ctx is done first
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// routeRequestAttributes returns the span attributes describing a route request
+func routeRequestAttributes(req models.RouteRequest) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("chain_from", req.ChainFrom),
+		attribute.String("chain_to", req.ChainTo),
+		attribute.String("token_from", req.TokenFromDenom),
+		attribute.String("token_to", req.TokenToDenom),
+		attribute.String("amount_in", req.AmountIn),
+		attribute.String("amount_out", req.AmountOut),
+	}
+}
+
 // buildBrokerRoute creates the broker swap route structure with support for multiple legs.
 // Handles:
 // - Same-chain swap: no inbound/outbound legs
@@ -412,7 +608,7 @@ func (s *Pathfinder) buildBrokerRoute(
 	// Leave it like this only for the tests... The proto will ALWAYS provide value
 	// TODO: Refactor this in the future, it is not needed for program to function but tests rely on it
 	if req.SlippageBps == nil {
-		defaultSlippage := uint32(100) // 1% default slippage
+		defaultSlippage := slippageBps(req)
 		req.SlippageBps = &defaultSlippage
 	}
 
@@ -460,9 +656,10 @@ func (s *Pathfinder) buildBrokerRoute(
 		// Build inbound legs - can be single or multi-hop
 		inboundLegs = make([]*models.IBCLeg, 0, len(hopInfo.InboundRoutes))
 
-		// Track the current token as it travels through the hops
+		// Track the current token as it travels through the hops, the chains forwarding after the first leg keep their PFM fee
 		currentTokenDenom := hopInfo.TokenIn.ChainDenom
 		currentTokenInfo := hopInfo.TokenIn
+		currentAmount := req.AmountIn
 
 		for i, route := range hopInfo.InboundRoutes {
 			fromChain := hopInfo.InboundPath[i]
@@ -497,6 +694,7 @@ func (s *Pathfinder) buildBrokerRoute(
 					IsNative:    s.denomResolver.IsTokenNativeToChain(intToken, fromChain),
 				}
 				currentTokenInfo = intToken
+				currentAmount = s.afterPFMFees(currentAmount, []string{fromChain})
 			}
 
 			leg := &models.IBCLeg{
@@ -505,7 +703,7 @@ func (s *Pathfinder) buildBrokerRoute(
 				Channel:   route.ChannelId,
 				Port:      route.PortId,
 				Token:     legTokenMapping,
-				Amount:    req.AmountIn, // Amount stays the same through pure transfers
+				Amount:    currentAmount,
 			}
 			inboundLegs = append(inboundLegs, leg)
 
@@ -546,6 +744,14 @@ func (s *Pathfinder) buildBrokerRoute(
 		IsNative:    s.denomResolver.IsTokenNativeToChain(hopInfo.TokenOutOnBroker, hopInfo.BrokerChainId),
 	}
 
+	// Taker fees are charged on top of the quote, exact in swaps output that much less.
+	// Exact out swaps output the quoted amount, their taker fees are paid from the max input.
+	swapTakerFee := takerFee(swapResult)
+	netSwapResult := *swapResult
+	if !req.IsExactOut() {
+		netSwapResult.AmountOut = afterFee(swapResult.AmountOut, swapTakerFee)
+	}
+
 	// Build the swap quote
 	swap := &models.SwapQuote{
 		Broker:       brokerType,
@@ -555,6 +761,8 @@ func (s *Pathfinder) buildBrokerRoute(
 		AmountOut:    swapResult.AmountOut,
 		PriceImpact:  swapResult.PriceImpact,
 		EffectiveFee: swapResult.EffectiveFee,
+		TakerFee:     swapTakerFee.String(),
+		NetAmountOut: netSwapResult.AmountOut,
 		RouteData:    swapResult.RouteData,
 	}
 
@@ -563,8 +771,8 @@ func (s *Pathfinder) buildBrokerRoute(
 	supportsPFM := false
 
 	if !hopInfo.SwapOnly && len(hopInfo.OutboundRoutes) > 0 {
-		// Build leg for each outbound route
-		currentAmount := swapResult.AmountOut
+		// Build leg for each outbound route, the chains forwarding after the first leg keep their PFM fee
+		currentAmount := netSwapResult.AmountOut
 		currentToken := tokenOutOnBroker
 		prevChain := hopInfo.BrokerChainId
 
@@ -585,6 +793,9 @@ func (s *Pathfinder) buildBrokerRoute(
 			} else {
 				legToken = currentToken
 			}
+			if i > 0 {
+				currentAmount = s.afterPFMFees(currentAmount, []string{prevChain})
+			}
 
 			outboundLeg := &models.IBCLeg{
 				FromChain: prevChain,
@@ -597,6 +808,7 @@ func (s *Pathfinder) buildBrokerRoute(
 			outboundLegs = append(outboundLegs, outboundLeg)
 			prevChain = route.ToChainId
 		}
+		swap.NetAmountOut = currentAmount
 
 		// PFM support check - all intermediate chains must support PFM
 		supportsPFM = true
@@ -623,7 +835,7 @@ func (s *Pathfinder) buildBrokerRoute(
 			// Same-chain swap: Source == Broker == Destination
 			// Use smart contract data (direct contract call, no IBC)
 			pathfinderLog.Debug().Msg("Building same-chain swap route (smart contract)")
-			execution, err = s.buildSmartContractSwapExecution(req, hopInfo, swapResult, smartContractBuilder, brokerChain, brokerExists)
+			execution, err = s.buildSmartContractSwapExecution(req, hopInfo, &netSwapResult, smartContractBuilder, brokerChain, brokerExists)
 			if err != nil {
 				pathfinderLog.Warn().Err(err).Msg("Failed to build smart contract data, route still usable")
 			}
@@ -631,7 +843,7 @@ func (s *Pathfinder) buildBrokerRoute(
 			// Source is broker, dest is not: swap + outbound IBC
 			// Use smart contract data with IBC forward built-in
 			pathfinderLog.Debug().Msg("Building broker-as-source route (smart contract + IBC forward)")
-			execution, err = s.buildSmartContractSwapAndForwardExecution(req, hopInfo, swapResult, outboundLegs, smartContractBuilder, brokerChain, brokerExists)
+			execution, err = s.buildSmartContractSwapAndForwardExecution(req, hopInfo, &netSwapResult, outboundLegs, smartContractBuilder, brokerChain, brokerExists)
 			if err != nil {
 				pathfinderLog.Warn().Err(err).Msg("Failed to build smart contract data, route still usable")
 			}
@@ -639,7 +851,7 @@ func (s *Pathfinder) buildBrokerRoute(
 			// Source is not broker, dest is broker: inbound IBC + swap
 			// Use IBC memo (ibc-hooks will trigger swap)
 			pathfinderLog.Debug().Msg("Building swap-only route (IBC memo)")
-			execution, err = s.buildSwapOnlyExecution(req, hopInfo, swapResult, memoBuilder, brokerChain, brokerExists)
+			execution, err = s.buildSwapOnlyExecution(req, hopInfo, &netSwapResult, memoBuilder, brokerChain, brokerExists)
 			if err != nil {
 				pathfinderLog.Warn().Err(err).Msg("Failed to build execution data, route still usable")
 			}
@@ -647,7 +859,7 @@ func (s *Pathfinder) buildBrokerRoute(
 			// Full route: source -> broker -> dest (all different chains)
 			// Use IBC memo (ibc-hooks will trigger swap + forward)
 			pathfinderLog.Debug().Int("outboundHops", len(outboundLegs)).Msg("Building full broker route (IBC memo)")
-			execution, err = s.buildSwapAndForwardExecution(req, hopInfo, swapResult, outboundLegs, memoBuilder, brokerExists)
+			execution, err = s.buildSwapAndForwardExecution(req, hopInfo, &netSwapResult, outboundLegs, memoBuilder, brokerExists)
 			if err != nil {
 				pathfinderLog.Warn().Err(err).Msg("Failed to build execution data, route still usable")
 			}
@@ -656,6 +868,11 @@ func (s *Pathfinder) buildBrokerRoute(
 		pathfinderLog.Debug().Msg("Manual route - skipping execution data generation")
 	}
 
+	// The swap output is protected by the min output, the receiver gets it minus the PFM fees of the outbound hops
+	if execution != nil {
+		execution.MinReceivedAmount = s.afterPFMFees(execution.MinOutputAmount, forwardingChains(outboundLegs))
+	}
+
 	return &models.BrokerRoute{
 		Path:                path,
 		InboundLegs:         inboundLegs,
@@ -693,16 +910,7 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 	}
 
 	// Calculate minimum output with slippage
-	minOutput := swapResult.AmountOut
-	if req.SlippageBps != nil {
-		calculated, calcErr := brokers.CalculateMinOutput(swapResult.AmountOut, *req.SlippageBps)
-		if calcErr != nil {
-			// If for some reason it does fail at least try to return some value
-			pathfinderLog.Warn().Err(calcErr).Msg("Failed to calculate min output, using original amount")
-		} else {
-			minOutput = calculated
-		}
-	}
+	minOutput := minOutputAmount(req, swapResult)
 
 	// Determine token in denom on broker (after all inbound IBC transfers)
 	var tokenInDenomOnBroker string
@@ -716,10 +924,11 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 	}
 
 	var memo string
+	inboundHops := s.buildInboundHops(hopInfo, req)
+	timeoutTimestamp := swapTimeoutTimestamp(inboundHops)
 
 	// Check if we need multi-hop inbound (Forward + Swap)
 	if len(hopInfo.InboundRoutes) > 1 {
-		inboundHops := s.buildInboundHops(hopInfo, req)
 
 		if len(hopInfo.InboundRoutes) == 2 {
 			// Two inbound hops (source -> intermediate -> broker): use HopAndSwap memo.
@@ -731,8 +940,9 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 						TokenInDenom:     tokenInDenomOnBroker,
 						TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 						MinOutputAmount:  minOutput,
+						ExactOutput:      req.IsExactOut(),
 						RouteData:        swapResult.RouteData,
-						TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+						TimeoutTimestamp: timeoutTimestamp,
 						RecoverAddress:   addresses.BrokerAddress,
 						ReceiverAddress:  addresses.DestinationAddress,
 					},
@@ -754,8 +964,9 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 						TokenInDenom:     tokenInDenomOnBroker,
 						TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 						MinOutputAmount:  minOutput,
+						ExactOutput:      req.IsExactOut(),
 						RouteData:        swapResult.RouteData,
-						TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+						TimeoutTimestamp: timeoutTimestamp,
 						RecoverAddress:   addresses.BrokerAddress,
 						ReceiverAddress:  addresses.DestinationAddress,
 					},
@@ -774,8 +985,9 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 			TokenInDenom:     tokenInDenomOnBroker,
 			TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 			MinOutputAmount:  minOutput,
+			ExactOutput:      req.IsExactOut(),
 			RouteData:        swapResult.RouteData,
-			TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+			TimeoutTimestamp: timeoutTimestamp,
 			RecoverAddress:   addresses.BrokerAddress,
 			ReceiverAddress:  addresses.DestinationAddress, // For swap-only, receiver is on broker chain
 		})
@@ -800,6 +1012,7 @@ func (s *Pathfinder) buildSwapOnlyExecution(
 		IBCReceiver:     &ibcReceiver,
 		RecoverAddress:  &addresses.BrokerAddress,
 		MinOutputAmount: minOutput,
+		MaxInputAmount:  maxInputAmount(req),
 		UsesWasm:        true,
 		Description:     fmt.Sprintf("IBC transfer with swap on %s", hopInfo.BrokerChainId),
 	}, nil
@@ -831,16 +1044,7 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 	}
 
 	// Calculate minimum output with slippage
-	minOutput := swapResult.AmountOut
-	if req.SlippageBps != nil {
-		calculated, calcErr := brokers.CalculateMinOutput(swapResult.AmountOut, *req.SlippageBps)
-		if calcErr != nil {
-			// If for some reason it does fail at least try to return some value
-			pathfinderLog.Warn().Err(calcErr).Msg("Failed to calculate min output, using original amount")
-		} else {
-			minOutput = calculated
-		}
-	}
+	minOutput := minOutputAmount(req, swapResult)
 
 	// Determine token in denom on broker (after all inbound IBC transfers)
 	var tokenInDenomOnBroker string
@@ -874,17 +1078,18 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 	hasMultiHopInbound := len(hopInfo.InboundRoutes) > 1
 	hasMultiHopOutbound := len(outboundLegs) > 1
 
+	inboundHops := s.buildInboundHops(hopInfo, req)
+	outboundHops := s.buildOutboundHops(outboundLegs, addresses.DestinationAddress, req)
+	timeoutTimestamp := swapTimeoutTimestamp(inboundHops, outboundHops)
+
 	if hasMultiHopInbound {
 		// Multi-hop inbound: use ForwardSwap or ForwardSwapForward.
 		// The memo is attached to the first IBC transfer (source → first intermediate).
 		// It must describe only the remaining hops (first intermediate → broker → ...).
-		inboundHops := s.buildInboundHops(hopInfo, req)
 		memoInboundHops := inboundHops[1:]
 
 		if hasMultiHopOutbound {
 			// Forward + Swap + MultiHop Forward (case 5.4)
-			outboundHops := s.buildOutboundHops(outboundLegs, addresses.DestinationAddress, req)
-
 			memo, err = memoBuilder.BuildForwardSwapForwardMemo(ibcmemo.ForwardSwapForwardParams{
 				InboundHops: memoInboundHops,
 				SwapParams: ibcmemo.SwapAndMultiHopParams{
@@ -892,8 +1097,9 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 						TokenInDenom:     tokenInDenomOnBroker,
 						TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 						MinOutputAmount:  minOutput,
+						ExactOutput:      req.IsExactOut(),
 						RouteData:        swapResult.RouteData,
-						TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+						TimeoutTimestamp: timeoutTimestamp,
 						RecoverAddress:   addresses.BrokerAddress,
 						ReceiverAddress:  firstHopReceiver,
 					},
@@ -910,8 +1116,9 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 						TokenInDenom:     tokenInDenomOnBroker,
 						TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 						MinOutputAmount:  minOutput,
+						ExactOutput:      req.IsExactOut(),
 						RouteData:        swapResult.RouteData,
-						TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+						TimeoutTimestamp: timeoutTimestamp,
 						RecoverAddress:   addresses.BrokerAddress,
 						ReceiverAddress:  firstHopReceiver,
 					},
@@ -925,15 +1132,14 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 		// Single-hop inbound
 		if hasMultiHopOutbound {
 			// Swap + MultiHop Forward (case 5.3)
-			outboundHops := s.buildOutboundHops(outboundLegs, addresses.DestinationAddress, req)
-
 			memo, err = memoBuilder.BuildSwapAndMultiHopMemo(ibcmemo.SwapAndMultiHopParams{
 				SwapMemoParams: ibcmemo.SwapMemoParams{
 					TokenInDenom:     tokenInDenomOnBroker,
 					TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 					MinOutputAmount:  minOutput,
+					ExactOutput:      req.IsExactOut(),
 					RouteData:        swapResult.RouteData,
-					TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+					TimeoutTimestamp: timeoutTimestamp,
 					RecoverAddress:   addresses.BrokerAddress,
 					ReceiverAddress:  firstHopReceiver,
 				},
@@ -947,8 +1153,9 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 					TokenInDenom:     tokenInDenomOnBroker,
 					TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 					MinOutputAmount:  minOutput,
+					ExactOutput:      req.IsExactOut(),
 					RouteData:        swapResult.RouteData,
-					TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+					TimeoutTimestamp: timeoutTimestamp,
 					RecoverAddress:   addresses.BrokerAddress,
 					ReceiverAddress:  firstHopReceiver,
 				},
@@ -978,11 +1185,44 @@ func (s *Pathfinder) buildSwapAndForwardExecution(
 		IBCReceiver:     &contractAddress,
 		RecoverAddress:  &addresses.BrokerAddress,
 		MinOutputAmount: minOutput,
+		MaxInputAmount:  maxInputAmount(req),
 		UsesWasm:        true,
 		Description:     description,
 	}, nil
 }
 
+// slippageBps returns the requested slippage or the 1% default
+func slippageBps(req models.RouteRequest) uint32 {
+	if req.SlippageBps == nil {
+		return 100
+	}
+	return *req.SlippageBps
+}
+
+// minOutputAmount returns the minimum swap output after slippage.
+// Exact out swaps must deliver the quoted output, so no slippage is applied to them.
+func minOutputAmount(req models.RouteRequest, swapResult *brokers.SwapResult) string {
+	if req.IsExactOut() {
+		return swapResult.AmountOut
+	}
+
+	minOutput, err := brokers.CalculateMinOutput(swapResult.AmountOut, slippageBps(req))
+	if err != nil {
+		// If for some reason it does fail at least try to return some value
+		pathfinderLog.Warn().Err(err).Msg("Failed to calculate min output, using original amount")
+		return swapResult.AmountOut
+	}
+	return minOutput
+}
+
+// maxInputAmount returns the amount sent into an exact out swap, empty for exact in routes
+func maxInputAmount(req models.RouteRequest) string {
+	if req.IsExactOut() {
+		return req.AmountIn
+	}
+	return ""
+}
+
 // buildInboundHops converts inbound routes to IBCHop slice for memo building.
 // For intermediate hops, Receiver is set to the address on that hop's destination chain
 // (via the address converter). The last hop's receiver is left empty; the memo builder
@@ -998,12 +1238,11 @@ func (s *Pathfinder) buildInboundHops(hopInfo *MultiHopInfo, req models.RouteReq
 				receiver = addr
 			}
 		}
-		hops[i] = ibcmemo.IBCHop{
-			Channel:  route.ChannelId,
-			Port:     route.PortId,
-			Receiver: receiver,
-			Timeout:  ibcmemo.DefaultTimeoutTimestamp(),
+		fromChain := ""
+		if i < len(hopInfo.InboundPath) {
+			fromChain = hopInfo.InboundPath[i]
 		}
+		hops[i] = s.newIBCHop(req, fromChain, route.ToChainId, route.ChannelId, route.PortId, receiver)
 	}
 	return hops
 }
@@ -1023,23 +1262,17 @@ func (s *Pathfinder) buildSmartContractSwapExecution(
 	}
 
 	// Calculate minimum output with slippage
-	minOutput := swapResult.AmountOut
-	if req.SlippageBps != nil {
-		calculated, calcErr := brokers.CalculateMinOutput(swapResult.AmountOut, *req.SlippageBps)
-		if calcErr != nil {
-			pathfinderLog.Warn().Err(calcErr).Msg("Failed to calculate min output, using original amount")
-		} else {
-			minOutput = calculated
-		}
-	}
+	minOutput := minOutputAmount(req, swapResult)
 
-	// Build smart contract data for same-chain swap
+	// Build smart contract data for same-chain swap, there are no transfers to wait for
+	timeoutTimestamp := swapTimeoutTimestamp()
 	scData, err := scBuilder.BuildSwapAndTransfer(ibcmemo.SwapMemoParams{
 		TokenInDenom:     hopInfo.TokenIn.ChainDenom, // Native denom since source is broker
 		TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 		MinOutputAmount:  minOutput,
+		ExactOutput:      req.IsExactOut(),
 		RouteData:        swapResult.RouteData,
-		TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+		TimeoutTimestamp: timeoutTimestamp,
 		RecoverAddress:   req.SenderAddress,   // On same chain, use sender as recover
 		ReceiverAddress:  req.ReceiverAddress, // Final destination on same chain
 	})
@@ -1050,6 +1283,7 @@ func (s *Pathfinder) buildSmartContractSwapExecution(
 	return &models.BrokerExecutionData{
 		SmartContractData: scData,
 		MinOutputAmount:   minOutput,
+		MaxInputAmount:    maxInputAmount(req),
 		UsesWasm:          true,
 		Description:       fmt.Sprintf("Smart contract swap on %s", hopInfo.BrokerChainId),
 	}, nil
@@ -1074,15 +1308,7 @@ func (s *Pathfinder) buildSmartContractSwapAndForwardExecution(
 	}
 
 	// Calculate minimum output with slippage
-	minOutput := swapResult.AmountOut
-	if req.SlippageBps != nil {
-		calculated, calcErr := brokers.CalculateMinOutput(swapResult.AmountOut, *req.SlippageBps)
-		if calcErr != nil {
-			pathfinderLog.Warn().Err(calcErr).Msg("Failed to calculate min output, using original amount")
-		} else {
-			minOutput = calculated
-		}
-	}
+	minOutput := minOutputAmount(req, swapResult)
 
 	// For single outbound hop, use simple swap+forward
 	// For multi-hop, we'd need PFM memo in the forward action
@@ -1090,17 +1316,19 @@ func (s *Pathfinder) buildSmartContractSwapAndForwardExecution(
 	var forwardMemo string
 	if len(outboundLegs) > 1 {
 		// Build PFM memo for remaining hops
-		forwardMemo = s.generatePFMMemo(outboundLegs[1:], req.ReceiverAddress)
+		forwardMemo = s.generatePFMMemo(outboundLegs[1:], req)
 	}
 
 	// Build smart contract data for swap + IBC forward
+	timeoutTimestamp := swapTimeoutTimestamp(s.buildOutboundHops(outboundLegs, req.ReceiverAddress, req))
 	scData, err := scBuilder.BuildSwapAndForward(ibcmemo.SwapAndForwardParams{
 		SwapMemoParams: ibcmemo.SwapMemoParams{
 			TokenInDenom:     hopInfo.TokenIn.ChainDenom, // Native denom since source is broker
 			TokenOutDenom:    hopInfo.TokenOutOnBroker.ChainDenom,
 			MinOutputAmount:  minOutput,
+			ExactOutput:      req.IsExactOut(),
 			RouteData:        swapResult.RouteData,
-			TimeoutTimestamp: ibcmemo.DefaultTimeoutTimestamp(),
+			TimeoutTimestamp: timeoutTimestamp,
 			RecoverAddress:   req.SenderAddress,   // On broker, use sender as recover
 			ReceiverAddress:  req.ReceiverAddress, // Used for forward action
 		},
@@ -1120,6 +1348,7 @@ func (s *Pathfinder) buildSmartContractSwapAndForwardExecution(
 	return &models.BrokerExecutionData{
 		SmartContractData: scData,
 		MinOutputAmount:   minOutput,
+		MaxInputAmount:    maxInputAmount(req),
 		UsesWasm:          true,
 		Description:       description,
 	}, nil
@@ -1138,12 +1367,7 @@ func (s *Pathfinder) buildOutboundHops(outboundLegs []*models.IBCLeg, finalRecei
 				receiver = nextAddr
 			}
 		}
-		hops[i] = ibcmemo.IBCHop{
-			Channel:  leg.Channel,
-			Port:     leg.Port,
-			Receiver: receiver,
-			Timeout:  ibcmemo.DefaultTimeoutTimestamp(),
-		}
+		hops[i] = s.newIBCHop(req, leg.FromChain, leg.ToChain, leg.Channel, leg.Port, receiver)
 	}
 	return hops
 }
@@ -1159,7 +1383,7 @@ Returns:
 - error: if the chain is not found
 */
 func (s *Pathfinder) GetChainInfo(chainId string) (PathfinderChain, error) {
-	chain, exists := s.chainsMap[chainId]
+	chain, exists := s.current().chainsMap[chainId]
 	if !exists {
 		return PathfinderChain{}, fmt.Errorf("chain %s not found", chainId)
 	}
@@ -1173,8 +1397,9 @@ Returns:
 - []string: the list of all chain ids
 */
 func (s *Pathfinder) GetAllChains() []string {
-	chains := make([]string, 0, len(s.chainsMap))
-	for chainId := range s.chainsMap {
+	chainsMap := s.current().chainsMap
+	chains := make([]string, 0, len(chainsMap))
+	for chainId := range chainsMap {
 		chains = append(chains, chainId)
 	}
 	return chains
@@ -1,8 +1,12 @@
 package router_test
 
 import (
+	"context"
 	"fmt"
+	"math"
+	"sync/atomic"
 	"testing"
+	"time"
 
 	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
 	router "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
@@ -344,12 +348,13 @@ func (m *MockMemoBuilder) BuildHopAndSwapMemo(params ibcmemo.HopAndSwapParams) (
 
 // MockBrokerClient implements the brokers.BrokerClient interface for testing
 type MockBrokerClient struct {
-	brokerType      string
-	contractAddress string
-	swapFunc        func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error)
+	brokerType       string
+	contractAddress  string
+	swapFunc         func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error)
+	swapExactOutFunc func(tokenIn, tokenOut, amountOut string, singleRoute *bool) (*brokers.SwapResult, error)
 }
 
-func (m *MockBrokerClient) QuerySwap(tokenInDenom, tokenInAmount, tokenOutDenom string, singleRoute *bool) (*brokers.SwapResult, error) {
+func (m *MockBrokerClient) QuerySwap(ctx context.Context, tokenInDenom, tokenInAmount, tokenOutDenom string, singleRoute *bool) (*brokers.SwapResult, error) {
 	if m.swapFunc != nil {
 		return m.swapFunc(tokenInDenom, tokenInAmount, tokenOutDenom, singleRoute)
 	}
@@ -368,6 +373,23 @@ func (m *MockBrokerClient) QuerySwap(tokenInDenom, tokenInAmount, tokenOutDenom
 	}, nil
 }
 
+func (m *MockBrokerClient) QuerySwapExactOut(ctx context.Context, tokenInDenom, tokenOutDenom, tokenOutAmount string, singleRoute *bool) (*brokers.SwapResult, error) {
+	if m.swapExactOutFunc != nil {
+		return m.swapExactOutFunc(tokenInDenom, tokenOutDenom, tokenOutAmount, singleRoute)
+	}
+	// Same fake 1:1 swap as QuerySwap, the 1% in total is added to the input
+	return &brokers.SwapResult{
+		AmountIn:     "1010000", // Assuming 1000000 output + 1% total (0.3% fee + 0.7% slippage)
+		AmountOut:    tokenOutAmount,
+		PriceImpact:  "0.007",
+		EffectiveFee: "0.003",
+		RouteData: &MockRouteData{
+			operations:    []ibcmemo.SwapOperation{{Pool: "1", DenomIn: tokenInDenom, DenomOut: tokenOutDenom}},
+			swapVenueName: "osmosis-poolmanager",
+		},
+	}, nil
+}
+
 func (m *MockBrokerClient) GetBrokerType() string {
 	return m.brokerType
 }
@@ -458,7 +480,7 @@ func TestPathfinder_DirectRoute(t *testing.T) {
 		ReceiverAddress: "osmo1receiver",
 	}
 
-	response := pathfinder.FindPath(req)
+	response := pathfinder.FindPath(t.Context(), req)
 
 	t.Logf("Response: %+v", response)
 	assert.True(t, response.Success)
@@ -493,7 +515,7 @@ func TestPathfinder_BrokerSwapRoute(t *testing.T) {
 		ReceiverAddress: "juno1receiver",
 	}
 
-	response := pathfinder.FindPath(req)
+	response := pathfinder.FindPath(t.Context(), req)
 
 	t.Logf("Response: %+v", response)
 	assert.True(t, response.Success)
@@ -544,6 +566,84 @@ func TestPathfinder_BrokerSwapRoute(t *testing.T) {
 	t.Logf("Broker swap route test passed")
 }
 
+func TestPathfinder_ExactOutBrokerSwapRoute(t *testing.T) {
+	// Execution data derives the recover address on the broker, so the chains need their prefixes
+	prefixes := map[string]string{"cosmoshub-4": "cosmos", "osmosis-1": "osmo", "juno-1": "juno"}
+	prefixedChains := make([]router.PathfinderChain, len(chains))
+	for i, chain := range chains {
+		chain.Bech32Prefix = prefixes[chain.Id]
+		prefixedChains[i] = chain
+	}
+	routeIndex := router.NewRouteIndex()
+	assert.NoError(t, routeIndex.BuildIndex(prefixedChains))
+	pathfinder := router.NewPathfinder(prefixedChains, routeIndex, map[string]brokers.BrokerClient{
+		"osmosis-sqs": &MockBrokerClient{
+			brokerType:      "osmosis-sqs",
+			contractAddress: "osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u",
+		},
+	})
+
+	sender, err := router.ConvertBech32Address("osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u", "cosmos")
+	assert.NoError(t, err)
+	receiver, err := router.ConvertBech32Address(sender, "juno")
+	assert.NoError(t, err)
+
+	smartRoute := true
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountOut:       "1000000",
+		SenderAddress:   sender,
+		ReceiverAddress: receiver,
+		SmartRoute:      &smartRoute,
+	}
+
+	response := pathfinder.FindPath(t.Context(), req)
+
+	t.Logf("Response: %+v", response)
+	assert.True(t, response.Success)
+	assert.Equal(t, response.RouteType, "broker_swap")
+
+	brokerRoute := response.BrokerSwap
+	assert.Equal(t, brokerRoute.Swap.AmountIn, "1010000")
+	assert.Equal(t, brokerRoute.Swap.AmountOut, "1000000")
+
+	// The sender transfers the quoted input plus 1% default slippage
+	assert.Equal(t, brokerRoute.InboundLegs[0].Amount, "1020100")
+	assert.Equal(t, brokerRoute.OutboundLegs[0].Amount, "1000000")
+
+	// The receiver gets exactly the requested amount, no slippage is taken from the output
+	assert.NotNil(t, brokerRoute.Execution)
+	assert.Equal(t, brokerRoute.Execution.MinOutputAmount, "1000000")
+	assert.Equal(t, brokerRoute.Execution.MaxInputAmount, "1020100")
+
+	// if all goes well
+	t.Logf("Exact out broker swap route test passed")
+}
+
+func TestPathfinder_ExactOutDirectRoute(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "osmosis-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		AmountOut:       "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "osmo1receiver",
+	}
+
+	response := pathfinder.FindPath(t.Context(), req)
+
+	// IBC transfers keep the amount, the sender sends exactly the requested output
+	assert.True(t, response.Success)
+	assert.Equal(t, response.RouteType, "direct")
+	assert.Equal(t, response.Direct.Transfer.Amount, "1000000")
+}
+
 func TestPathfinder_IndirectRoute(t *testing.T) {
 	pathfinder, _ := setupTestPathfinder()
 
@@ -558,7 +658,7 @@ func TestPathfinder_IndirectRoute(t *testing.T) {
 		ReceiverAddress: "osmo1receiver",
 	}
 
-	response := pathfinder.FindPath(req)
+	response := pathfinder.FindPath(t.Context(), req)
 
 	t.Logf("Response: %+v", response)
 	assert.True(t, response.Success)
@@ -609,7 +709,7 @@ func TestPathfinder_ImpossibleRoute(t *testing.T) {
 		ReceiverAddress: "nonexist1receiver",
 	}
 
-	response := pathfinder.FindPath(req)
+	response := pathfinder.FindPath(t.Context(), req)
 
 	t.Logf("Response: %+v", response)
 	assert.False(t, response.Success)
@@ -622,6 +722,79 @@ func TestPathfinder_ImpossibleRoute(t *testing.T) {
 	t.Logf("Impossible route test passed")
 }
 
+func TestPathfinder_FindPaths(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "juno1receiver",
+	}
+
+	response := pathfinder.FindPaths(t.Context(), req)
+
+	t.Logf("Response: %+v", response)
+	assert.True(t, response.Success)
+	assert.True(t, len(response.Routes) > 0)
+
+	best := response.Routes[0]
+	assert.Equal(t, best.Rank, 1)
+	assert.Equal(t, best.Route.RouteType, "broker_swap")
+	assert.Equal(t, best.ExpectedOutput, "980000")
+	assert.Equal(t, best.HopCount, 2)
+	assert.True(t, best.IsBestPrice)
+
+	// Routes must be ordered by score with consecutive ranks
+	for i := 1; i < len(response.Routes); i++ {
+		assert.True(t, response.Routes[i-1].Score >= response.Routes[i].Score)
+		assert.Equal(t, response.Routes[i].Rank, i+1)
+	}
+
+	// if all goes well
+	t.Logf("Find paths test passed with %d routes", len(response.Routes))
+}
+
+func TestPathfinder_FindPathsPrefersDirectRoute(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "osmosis-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "osmo1receiver",
+	}
+
+	response := pathfinder.FindPaths(t.Context(), req)
+
+	t.Logf("Response: %+v", response)
+	assert.True(t, response.Success)
+	assert.True(t, len(response.Routes) > 0)
+
+	// The direct transfer delivers the full amount in one hop, nothing can beat it
+	best := response.Routes[0]
+	assert.Equal(t, best.Route.RouteType, "direct")
+	assert.Equal(t, best.ExpectedOutput, "1000000")
+	assert.Equal(t, best.HopCount, 1)
+	assert.Equal(t, best.EffectiveFee, "0")
+	assert.True(t, best.IsBestPrice)
+	assert.True(t, best.IsFastest)
+
+	// The broker swap-only route is still offered as an alternative
+	assert.Equal(t, len(response.Routes), 2)
+	assert.Equal(t, response.Routes[1].Route.RouteType, "broker_swap")
+	assert.False(t, response.Routes[1].IsBestPrice)
+
+	// if all goes well
+	t.Logf("Direct route ranked first out of %d routes", len(response.Routes))
+}
+
 func TestPathfinder_AllChainPairs(t *testing.T) {
 	pathfinder, _ := setupTestPathfinder()
 
@@ -687,7 +860,7 @@ func TestPathfinder_AllChainPairs(t *testing.T) {
 				ReceiverAddress: "receiver456",
 			}
 
-			response := pathfinder.FindPath(req)
+			response := pathfinder.FindPath(t.Context(), req)
 
 			t.Logf("%s: RouteType=%s, Success=%v", tc.name, response.RouteType, response.Success)
 
@@ -769,7 +942,7 @@ func BenchmarkPathfinder_DirectRoute(b *testing.B) {
 	}
 
 	for b.Loop() {
-		pathfinder.FindPath(req)
+		pathfinder.FindPath(b.Context(), req)
 	}
 }
 
@@ -787,7 +960,7 @@ func BenchmarkPathfinder_BrokerSwapRoute(b *testing.B) {
 	}
 
 	for b.Loop() {
-		pathfinder.FindPath(req)
+		pathfinder.FindPath(b.Context(), req)
 	}
 }
 
@@ -802,6 +975,386 @@ func BenchmarkPathfinder_IndirectRoute(b *testing.B) {
 	}
 
 	for b.Loop() {
-		pathfinder.FindPath(req)
+		pathfinder.FindPath(b.Context(), req)
+	}
+}
+
+// setupDiamondRouteIndex builds a route index where ALPHA can reach delta-1 over
+// two equally long paths: alpha-1 -> beta-1 -> delta-1 and alpha-1 -> gamma-1 -> delta-1
+func setupDiamondRouteIndex(t *testing.T, betaPFM, gammaPFM bool) *router.RouteIndex {
+	t.Helper()
+
+	alphaOn := func(chainDenom, ibcDenom string) map[string]router.TokenInfo {
+		return map[string]router.TokenInfo{
+			chainDenom: {
+				ChainDenom:  chainDenom,
+				IbcDenom:    ibcDenom,
+				BaseDenom:   "ualpha",
+				OriginChain: "alpha-1",
+				Symbol:      "ALPHA",
+				Decimals:    6,
+			},
+		}
+	}
+
+	diamond := []router.PathfinderChain{
+		{
+			Name: "Alpha",
+			Id:   "alpha-1",
+			Routes: []router.BasicRoute{
+				{ToChain: "Beta", ToChainId: "beta-1", ChannelId: "channel-1", PortId: "transfer",
+					AllowedTokens: alphaOn("ualpha", "ibc/alpha-on-beta")},
+				{ToChain: "Gamma", ToChainId: "gamma-1", ChannelId: "channel-2", PortId: "transfer",
+					AllowedTokens: alphaOn("ualpha", "ibc/alpha-on-gamma")},
+			},
+		},
+		{
+			Name:   "Beta",
+			Id:     "beta-1",
+			HasPFM: betaPFM,
+			Routes: []router.BasicRoute{
+				{ToChain: "Delta", ToChainId: "delta-1", ChannelId: "channel-3", PortId: "transfer",
+					AllowedTokens: alphaOn("ibc/alpha-on-beta", "ibc/alpha-on-delta")},
+			},
+		},
+		{
+			Name:   "Gamma",
+			Id:     "gamma-1",
+			HasPFM: gammaPFM,
+			Routes: []router.BasicRoute{
+				{ToChain: "Delta", ToChainId: "delta-1", ChannelId: "channel-4", PortId: "transfer",
+					AllowedTokens: alphaOn("ibc/alpha-on-gamma", "ibc/alpha-on-delta")},
+			},
+		},
+		{
+			Name: "Delta",
+			Id:   "delta-1",
+			Routes: []router.BasicRoute{
+				{ToChain: "Beta", ToChainId: "beta-1", ChannelId: "channel-5", PortId: "transfer",
+					AllowedTokens: alphaOn("ibc/alpha-on-delta", "ibc/alpha-on-beta")},
+			},
+		},
 	}
+
+	routeIndex := router.NewRouteIndex()
+	if err := routeIndex.BuildIndex(diamond); err != nil {
+		t.Fatalf("failed to build route index: %v", err)
+	}
+	return routeIndex
+}
+
+func TestRouteIndex_WeightedIndirectRoute(t *testing.T) {
+	req := models.RouteRequest{
+		ChainFrom:      "alpha-1",
+		ChainTo:        "delta-1",
+		TokenFromDenom: "ualpha",
+		TokenToDenom:   "ibc/alpha-on-delta",
+		AmountIn:       "1000000",
+	}
+
+	t.Run("equal cost paths resolve deterministically", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		for range 10 {
+			route := routeIndex.FindIndirectRoute(req)
+			assert.NotNil(t, route)
+			assert.DeepEqual(t, route.Path, []string{"alpha-1", "beta-1", "delta-1"})
+		}
+	})
+
+	t.Run("channel penalty avoids the channel", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		cfg := router.DefaultEdgeCostConfig()
+		cfg.ChannelPenalties[router.ChannelKey("beta-1", "channel-3")] = 2
+		routeIndex.SetEdgeCostConfig(cfg)
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "gamma-1", "delta-1"})
+		assert.Equal(t, route.Routes[1].ChannelId, "channel-4")
+	})
+
+	t.Run("failing channel is avoided", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		health := routeIndex.ChannelHealth()
+		for range 10 {
+			health.RecordFailure("beta-1", "channel-3")
+			health.RecordSuccess("gamma-1", "channel-4")
+		}
+		assert.Equal(t, health.FailureRate("beta-1", "channel-3"), 1.0)
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "gamma-1", "delta-1"})
+	})
+
+	t.Run("configured failure rate avoids the channel", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		cfg := router.DefaultEdgeCostConfig()
+		cfg.ChannelFailureRates[router.ChannelKey("beta-1", "channel-3")] = 0.3
+		routeIndex.SetEdgeCostConfig(cfg)
+
+		// The failing channel costs the hop plus 10 times its failure rate
+		costs := routeIndex.WeightedEdgeCost(cfg)
+		failing := costs(router.Edge{FromChainId: "beta-1", Route: &router.BasicRoute{ChannelId: "channel-3"}})
+		healthy := costs(router.Edge{FromChainId: "gamma-1", Route: &router.BasicRoute{ChannelId: "channel-4"}})
+		assert.Equal(t, failing, 4.0)
+		assert.Equal(t, healthy, 1.0)
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "gamma-1", "delta-1"})
+
+		// Enough recorded transfers replace the configured rate
+		for range 10 {
+			routeIndex.ChannelHealth().RecordSuccess("beta-1", "channel-3")
+		}
+		route = routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "beta-1", "delta-1"})
+	})
+
+	t.Run("failure rate needs enough samples", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		routeIndex.ChannelHealth().RecordFailure("beta-1", "channel-3")
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "beta-1", "delta-1"})
+	})
+
+	t.Run("PFM chains are preferred", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, false, true)
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "gamma-1", "delta-1"})
+	})
+
+	t.Run("custom edge cost function", func(t *testing.T) {
+		routeIndex := setupDiamondRouteIndex(t, true, true)
+		routeIndex.SetEdgeCostFunc(func(edge router.Edge) float64 {
+			if edge.Route.ToChainId == "gamma-1" {
+				return math.Inf(1)
+			}
+			return 1
+		})
+
+		route := routeIndex.FindIndirectRoute(req)
+		assert.NotNil(t, route)
+		assert.DeepEqual(t, route.Path, []string{"alpha-1", "beta-1", "delta-1"})
+
+		routeIndex.SetEdgeCostFunc(func(edge router.Edge) float64 {
+			return math.Inf(1)
+		})
+		assert.Nil(t, routeIndex.FindIndirectRoute(req))
+	})
+}
+
+// setupMultiBrokerPathfinder extends the test chains with a second broker (Neutron) that can
+// also swap ATOM from the Cosmos Hub into JUNO, so both brokers compete for the same route
+func setupMultiBrokerPathfinder(t *testing.T, neutronSwap func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error)) *router.Pathfinder {
+	t.Helper()
+
+	multiBrokerChains := make([]router.PathfinderChain, 0, len(chains)+1)
+	for _, chain := range chains {
+		if chain.Id == "cosmoshub-4" {
+			chain.Routes = append(chain.Routes, router.BasicRoute{
+				ToChain:      "neutron",
+				ToChainId:    "neutron-1",
+				ConnectionId: "connection-9",
+				ChannelId:    "channel-9",
+				PortId:       "transfer",
+				AllowedTokens: map[string]router.TokenInfo{
+					"uatom": {
+						ChainDenom:  "uatom",
+						IbcDenom:    "ibc/uatom-neutron",
+						BaseDenom:   "uatom",
+						OriginChain: "cosmoshub-4",
+						Decimals:    6,
+					},
+				},
+			})
+		}
+		multiBrokerChains = append(multiBrokerChains, chain)
+	}
+	multiBrokerChains = append(multiBrokerChains, router.PathfinderChain{
+		Name:     "Neutron",
+		Id:       "neutron-1",
+		Broker:   true,
+		BrokerId: "astroport",
+		HasPFM:   true,
+		Routes: []router.BasicRoute{
+			{
+				ToChain:      "juno",
+				ToChainId:    "juno-1",
+				ConnectionId: "connection-10",
+				ChannelId:    "channel-10",
+				PortId:       "transfer",
+				AllowedTokens: map[string]router.TokenInfo{
+					"ibc/ujuno-neutron": {
+						ChainDenom:  "ibc/ujuno-neutron",
+						IbcDenom:    "ujuno",
+						BaseDenom:   "ujuno",
+						OriginChain: "juno-1",
+						Decimals:    6,
+					},
+				},
+			},
+		},
+	})
+
+	routeIndex := router.NewRouteIndex()
+	if err := routeIndex.BuildIndex(multiBrokerChains); err != nil {
+		t.Fatalf("failed to build index: %v", err)
+	}
+
+	brokerClients := map[string]brokers.BrokerClient{
+		"osmosis-sqs": &MockBrokerClient{
+			brokerType:      "osmosis-sqs",
+			contractAddress: "osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u",
+			swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+				return &brokers.SwapResult{
+					AmountIn:     amountIn,
+					AmountOut:    "980000",
+					PriceImpact:  "0.015",
+					EffectiveFee: "0.005",
+					RouteData: &MockRouteData{
+						operations:    []ibcmemo.SwapOperation{{Pool: "1", DenomIn: tokenIn, DenomOut: tokenOut}},
+						swapVenueName: "osmosis-poolmanager",
+					},
+				}, nil
+			},
+		},
+		"astroport": &MockBrokerClient{
+			brokerType:      "astroport",
+			contractAddress: "neutron1entrypoint",
+			swapFunc:        neutronSwap,
+		},
+	}
+
+	return router.NewPathfinder(multiBrokerChains, routeIndex, brokerClients)
+}
+
+func TestPathfinder_MultiBrokerBestQuote(t *testing.T) {
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "juno1receiver",
+	}
+
+	t.Run("best quote wins", func(t *testing.T) {
+		pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			return &brokers.SwapResult{
+				AmountIn:     amountIn,
+				AmountOut:    "995000",
+				PriceImpact:  "0.002",
+				EffectiveFee: "0.003",
+				RouteData: &MockRouteData{
+					operations:    []ibcmemo.SwapOperation{{Pool: "neutron1pair", DenomIn: tokenIn, DenomOut: tokenOut}},
+					swapVenueName: "neutron-astroport",
+				},
+			}, nil
+		})
+
+		response := pathfinder.FindPath(t.Context(), req)
+
+		t.Logf("Response: %+v", response)
+		assert.True(t, response.Success)
+		assert.Equal(t, response.RouteType, "broker_swap")
+		assert.NotNil(t, response.BrokerSwap)
+
+		brokerRoute := response.BrokerSwap
+		assert.Equal(t, brokerRoute.Swap.Broker, "astroport")
+		assert.Equal(t, brokerRoute.Swap.AmountOut, "995000")
+		assert.DeepEqual(t, brokerRoute.Path, []string{"cosmoshub-4", "neutron-1", "juno-1"})
+
+		// The Osmosis quote is still returned as an alternative
+		assert.Equal(t, len(brokerRoute.AlternativeQuotes), 1)
+		assert.Equal(t, brokerRoute.AlternativeQuotes[0].Broker, "osmosis-sqs")
+		assert.Equal(t, brokerRoute.AlternativeQuotes[0].AmountOut, "980000")
+	})
+
+	t.Run("failing broker falls back to the other", func(t *testing.T) {
+		pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			return nil, fmt.Errorf("no liquidity")
+		})
+
+		response := pathfinder.FindPath(t.Context(), req)
+
+		assert.True(t, response.Success)
+		assert.Equal(t, response.BrokerSwap.Swap.Broker, "osmosis-sqs")
+		assert.Equal(t, len(response.BrokerSwap.AlternativeQuotes), 0)
+	})
+}
+
+func TestPathfinder_FindPathsRanksOnNetOutput(t *testing.T) {
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "juno1receiver",
+	}
+
+	// Astroport charges the higher fee but still delivers more, its quote is already net of the fee
+	pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+		return &brokers.SwapResult{
+			AmountIn:     amountIn,
+			AmountOut:    "982000",
+			PriceImpact:  "0.002",
+			EffectiveFee: "0.01",
+			RouteData: &MockRouteData{
+				operations:    []ibcmemo.SwapOperation{{Pool: "neutron1pair", DenomIn: tokenIn, DenomOut: tokenOut}},
+				swapVenueName: "neutron-astroport",
+			},
+		}, nil
+	})
+
+	response := pathfinder.FindPaths(t.Context(), req)
+	assert.True(t, response.Success)
+	assert.True(t, len(response.Routes) >= 2)
+
+	best := response.Routes[0]
+	assert.Equal(t, best.Route.BrokerSwap.Swap.Broker, "astroport")
+	assert.Equal(t, best.EffectiveFee, "0.01")
+	assert.True(t, best.IsBestPrice)
+	assert.Equal(t, response.Routes[1].Route.BrokerSwap.Swap.Broker, "osmosis-sqs")
+}
+
+func TestPathfinder_CancelledRequestStopsBrokerRetries(t *testing.T) {
+	req := models.RouteRequest{
+		ChainFrom:       "cosmoshub-4",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  "uatom",
+		TokenToDenom:    "ujuno",
+		AmountIn:        "1000000",
+		SenderAddress:   "cosmos1sender",
+		ReceiverAddress: "juno1receiver",
+	}
+
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// The client goes away while Astroport is being queried
+	var calls atomic.Int32
+	pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+		calls.Add(1)
+		cancel()
+		return nil, context.Canceled
+	})
+
+	start := time.Now()
+	response := pathfinder.FindPath(ctx, req)
+
+	// Without cancellation the retries would back off for 3.5s
+	assert.Equal(t, calls.Load(), int32(1))
+	assert.True(t, time.Since(start) < time.Second)
+	assert.True(t, response.Success)
+	assert.Equal(t, response.BrokerSwap.Swap.Broker, "osmosis-sqs")
 }
@@ -0,0 +1,143 @@
+package router
+
+import (
+	"context"
+	"time"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/shopspring/decimal"
+)
+
+// DefaultWatchInterval is the time between two quotes of a watched route if the watch doesn't set one
+const DefaultWatchInterval = 10 * time.Second
+
+// QuoteWatch configures how WatchQuote re-quotes a route
+type QuoteWatch struct {
+	// Time between two quotes, DefaultWatchInterval when unset
+	Interval time.Duration
+	// Smallest change of the quoted amount in basis points that is sent, every change is sent when 0
+	ChangeThresholdBps uint32
+}
+
+/*
+WatchQuote finds the route of req and re-quotes it on the interval of watch until ctx is done.
+
+The first route is found and valued like in FindPath. After that only the broker that quoted the route
+is queried again, the other brokers and the USD valuation are left out, so re-quoted routes come without
+alternative quotes and valuation. The route is searched again when the chain config is reloaded or while
+no route is found, direct and indirect routes only change when the chain config is reloaded.
+send is called with the first route found and after that with every quote that changed: when the route
+succeeds or fails, changes type or error, or when the quoted amount of the swap moves by more than the
+threshold. The quoted amount is the net output of exact in swaps and the input of exact out swaps.
+
+Returns:
+- error: ctx.Err() once ctx is done, or the error of send, which stops the watch
+*/
+func (s *Pathfinder) WatchQuote(ctx context.Context, req models.RouteRequest, watch QuoteWatch, send func(models.RouteResponse) error) error {
+	interval := watch.Interval
+	if interval <= 0 {
+		interval = DefaultWatchInterval
+	}
+
+	watched := s.current()
+	last, candidate := watched.solvePath(ctx, req)
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+	if err := send(last); err != nil {
+		return err
+	}
+	found := last.Success
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+
+		reloaded := s.tables.Load() != watched.routingTables
+		if !reloaded && found && candidate == nil {
+			continue
+		}
+
+		var response models.RouteResponse
+		if !reloaded && candidate != nil {
+			response = watched.requote(ctx, req, candidate)
+		} else {
+			watched = s.latest()
+			response, candidate = watched.solvePath(ctx, req)
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+
+		// A broker that stopped quoting the route is not queried alone again, the next tick searches all routes
+		found = response.Success
+		if !found {
+			candidate = nil
+		}
+
+		if !quoteChanged(last, response, req.IsExactOut(), watch.ChangeThresholdBps) {
+			continue
+		}
+		if err := send(response); err != nil {
+			return err
+		}
+		last = response
+	}
+}
+
+// requote queries the broker of a watched broker route candidate again and rebuilds the route with the new quote
+func (s *Pathfinder) requote(ctx context.Context, req models.RouteRequest, candidate *MultiHopInfo) models.RouteResponse {
+	response, err := s.buildBrokerSwapResponse(ctx, req, candidate)
+	if err != nil {
+		pathfinderLog.Debug().Err(err).Str("broker", candidate.BrokerChain).Msg("Re-quote of watched route failed")
+		return brokerFailureResponse(ctx, err)
+	}
+	return response
+}
+
+// quoteChanged reports whether next differs enough from the last sent quote to be sent
+func quoteChanged(last, next models.RouteResponse, exactOut bool, thresholdBps uint32) bool {
+	if last.Success != next.Success || last.RouteType != next.RouteType || last.ErrorCode != next.ErrorCode {
+		return true
+	}
+
+	lastAmount, lastOk := quotedAmount(last, exactOut)
+	nextAmount, nextOk := quotedAmount(next, exactOut)
+	if !lastOk || !nextOk {
+		return lastOk != nextOk
+	}
+	if lastAmount.IsZero() {
+		return !nextAmount.IsZero()
+	}
+
+	// |next - last| / last > threshold / 10000
+	change := nextAmount.Sub(lastAmount).Abs().Mul(decimal.NewFromInt(10000))
+	return change.GreaterThan(lastAmount.Mul(decimal.NewFromInt(int64(thresholdBps))))
+}
+
+// quotedAmount returns the amount the swap of a broker route is quoted at, ok is false for routes without a swap
+func quotedAmount(response models.RouteResponse, exactOut bool) (decimal.Decimal, bool) {
+	if response.BrokerSwap == nil || response.BrokerSwap.Swap == nil {
+		return decimal.Zero, false
+	}
+
+	swap := response.BrokerSwap.Swap
+	quoted := swap.NetAmountOut
+	if quoted == "" {
+		quoted = swap.AmountOut
+	}
+	if exactOut {
+		quoted = swap.AmountIn
+	}
+
+	value, err := decimal.NewFromString(quoted)
+	if err != nil {
+		return decimal.Zero, false
+	}
+	return value, true
+}
@@ -0,0 +1,107 @@
+package router_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+)
+
+func TestPathfinder_WatchQuote(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// The quote moves by 5 bps, then by 1%, then stays, the watch is cancelled on the fourth quote
+	quotes := []string{"990000", "990500", "1000000", "1000000"}
+	queries := 0
+	pathfinder := newFeePathfinder(t, nil, &MockBrokerClient{
+		brokerType:      "osmosis-sqs",
+		contractAddress: entryPointContract,
+		swapFunc: func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+			quote := quotes[queries]
+			queries++
+			if queries == len(quotes) {
+				cancel()
+			}
+			return &brokers.SwapResult{AmountIn: amountIn, AmountOut: quote, PriceImpact: "0.001", RouteData: &MockRouteData{
+				operations: guardOperations, swapVenueName: "osmosis-poolmanager",
+			}}, nil
+		},
+	})
+
+	var sent []string
+	err := pathfinder.WatchQuote(ctx, guardRequest(t), router.QuoteWatch{Interval: time.Millisecond, ChangeThresholdBps: 50},
+		func(response models.RouteResponse) error {
+			assert.True(t, response.Success)
+			sent = append(sent, response.BrokerSwap.Swap.AmountOut)
+			return nil
+		})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, queries, len(quotes))
+	assert.DeepEqual(t, sent, []string{"990000", "1000000"})
+}
+
+func TestPathfinder_WatchQuoteStopsOnSendError(t *testing.T) {
+	queries := 0
+	pathfinder := newGuardPathfinder(t, "0.001", &MockRouteData{operations: guardOperations, swapVenueName: "osmosis-poolmanager"}, &queries)
+
+	sendErr := errors.New("client went away")
+	err := pathfinder.WatchQuote(t.Context(), guardRequest(t), router.QuoteWatch{Interval: time.Millisecond},
+		func(response models.RouteResponse) error {
+			return sendErr
+		})
+	assert.Equal(t, err, sendErr)
+	assert.Equal(t, queries, 1)
+}
+
+func TestPathfinder_WatchQuoteRequotesTheBrokerOfTheRoute(t *testing.T) {
+	ctx, cancel := context.WithCancel(t.Context())
+	defer cancel()
+
+	// Astroport quotes better than Osmosis every time, the watch is cancelled on its third quote
+	quotes := []string{"995000", "999000", "999000"}
+	queries := 0
+	pathfinder := setupMultiBrokerPathfinder(t, func(tokenIn, amountIn, tokenOut string, singleRoute *bool) (*brokers.SwapResult, error) {
+		quote := quotes[queries]
+		queries++
+		if queries == len(quotes) {
+			cancel()
+		}
+		return &brokers.SwapResult{AmountIn: amountIn, AmountOut: quote, PriceImpact: "0.002", RouteData: &MockRouteData{
+			operations:    []ibcmemo.SwapOperation{{Pool: "neutron1pair", DenomIn: tokenIn, DenomOut: tokenOut}},
+			swapVenueName: "neutron-astroport",
+		}}, nil
+	})
+	provider := &mockPriceProvider{prices: map[string]string{"uatom": "5"}}
+	pathfinder.SetPriceProvider(provider)
+
+	var sent []models.RouteResponse
+	err := pathfinder.WatchQuote(ctx, guardRequest(t), router.QuoteWatch{Interval: time.Millisecond},
+		func(response models.RouteResponse) error {
+			sent = append(sent, response)
+			return nil
+		})
+	assert.True(t, errors.Is(err, context.Canceled))
+	assert.Equal(t, queries, len(quotes))
+	assert.Equal(t, len(sent), 2)
+
+	// The first route is found by quoting every broker and valued
+	assert.Equal(t, sent[0].BrokerSwap.Swap.Broker, "astroport")
+	assert.Equal(t, len(sent[0].BrokerSwap.AlternativeQuotes), 1)
+	assert.NotNil(t, sent[0].Valuation)
+	priced := len(provider.tokens)
+
+	// Re-quotes only query Astroport and are not valued again
+	assert.Equal(t, sent[1].BrokerSwap.Swap.Broker, "astroport")
+	assert.Equal(t, sent[1].BrokerSwap.Swap.AmountOut, "999000")
+	assert.Equal(t, len(sent[1].BrokerSwap.AlternativeQuotes), 0)
+	assert.Nil(t, sent[1].Valuation)
+	assert.Equal(t, len(provider.tokens), priced)
+}
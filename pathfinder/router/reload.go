@@ -0,0 +1,119 @@
+package router
+
+import (
+	"fmt"
+)
+
+// routingTables holds everything the pathfinder derives from the chain config.
+// The tables are never modified once built, a config reload builds new ones.
+type routingTables struct {
+	chainsMap        map[string]PathfinderChain // mapped chainId -> PathfinderChain
+	routeIndex       *RouteIndex                // routeIndex from which all routes are found
+	denomResolver    *DenomResolver             // denomResolver for resolving denoms across chains
+	addressConverter *AddressConverter          // addressConverter for converting addresses across chains
+}
+
+func newRoutingTables(chains []PathfinderChain, routeIndex *RouteIndex) *routingTables {
+	chainMap := make(map[string]PathfinderChain, len(chains))
+	for _, chain := range chains {
+		chainMap[chain.Id] = chain
+	}
+
+	denomResolver := NewDenomResolver(routeIndex)
+	denomResolver.SetChains(chains)
+
+	return &routingTables{
+		chainsMap:        chainMap,
+		routeIndex:       routeIndex,
+		denomResolver:    denomResolver,
+		addressConverter: NewAddressConverter(chains),
+	}
+}
+
+// current returns a view of the pathfinder pinned to the latest routing tables, a pinned view returns itself.
+// Every request works on a single view, so a reload never mixes two configs within one request.
+func (s *Pathfinder) current() *Pathfinder {
+	if s.pinned {
+		return s
+	}
+	return s.latest()
+}
+
+// latest returns a view pinned to the latest routing tables, even if s is pinned to older ones
+func (s *Pathfinder) latest() *Pathfinder {
+	view := *s
+	view.routingTables = s.tables.Load()
+	view.pinned = true
+	return &view
+}
+
+// Snapshot returns the pathfinder pinned to the current chain config, reloads don't change what it sees.
+// Requests that resolve denoms or look up chains before they are routed take a snapshot first,
+// so the lookups and the route use the same config.
+func (s *Pathfinder) Snapshot() *Pathfinder {
+	return s.current()
+}
+
+// DenomResolver returns the denom resolver of the current chain config
+func (s *Pathfinder) DenomResolver() *DenomResolver {
+	return s.current().denomResolver
+}
+
+/*
+Reload swaps in the routes of a new chain config while requests are being served.
+Requests that are in flight finish with the config they started with.
+
+The route index must already be built from chains, it takes over the channel health
+observed with the previous config. If the chains fail validation nothing is swapped.
+*/
+func (s *Pathfinder) Reload(chains []PathfinderChain, routeIndex *RouteIndex) error {
+	if err := s.validateChains(chains); err != nil {
+		return fmt.Errorf("invalid chain config: %w", err)
+	}
+
+	tables := newRoutingTables(chains, routeIndex)
+	routeIndex.channelHealth = s.tables.Load().routeIndex.channelHealth
+	s.tables.Store(tables)
+
+	pathfinderLog.Info().Int("chains", len(chains)).Msg("Chain config reloaded")
+	return nil
+}
+
+// validateChains checks that the chains form a usable config:
+// chain ids are unique, every route leads to a known chain, PFM fees are fractions and every broker has a client
+func (s *Pathfinder) validateChains(chains []PathfinderChain) error {
+	if len(chains) == 0 {
+		return fmt.Errorf("no chains")
+	}
+
+	known := make(map[string]bool, len(chains))
+	for _, chain := range chains {
+		if chain.Id == "" {
+			return fmt.Errorf("chain %q has no id", chain.Name)
+		}
+		if known[chain.Id] {
+			return fmt.Errorf("duplicate chain %s", chain.Id)
+		}
+		known[chain.Id] = true
+	}
+
+	for _, chain := range chains {
+		for _, route := range chain.Routes {
+			if !known[route.ToChainId] {
+				return fmt.Errorf("chain %s has a route to unknown chain %s", chain.Id, route.ToChainId)
+			}
+		}
+		if chain.PFMFeePercentage != "" {
+			if _, err := parsePFMFee(chain.PFMFeePercentage); err != nil {
+				return fmt.Errorf("chain %s has an invalid PFM fee: %w", chain.Id, err)
+			}
+		}
+		if chain.Broker {
+			if _, ok := s.brokerClients[chain.BrokerId]; !ok {
+				return fmt.Errorf("chain %s uses broker %s which has no client configured", chain.Id, chain.BrokerId)
+			}
+		}
+	}
+
+	return nil
+}
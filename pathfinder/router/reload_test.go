@@ -0,0 +1,169 @@
+package router_test
+
+import (
+	"slices"
+	"sync"
+	"testing"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	router "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/zeebo/assert"
+)
+
+var hubToOsmosis = models.RouteRequest{
+	ChainFrom:       "cosmoshub-4",
+	ChainTo:         "osmosis-1",
+	TokenFromDenom:  "uatom",
+	TokenToDenom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+	AmountIn:        "1000000",
+	SenderAddress:   "cosmos1sender",
+	ReceiverAddress: "osmo1receiver",
+}
+
+// chainsWithHubChannel returns a copy of the test chains where the Cosmos Hub reaches Osmosis over channelId
+func chainsWithHubChannel(channelId string) []router.PathfinderChain {
+	reloaded := slices.Clone(chains)
+	for i, chain := range reloaded {
+		if chain.Id != "cosmoshub-4" {
+			continue
+		}
+		reloaded[i].Routes = slices.Clone(chain.Routes)
+		for j, route := range reloaded[i].Routes {
+			if route.ToChainId == "osmosis-1" {
+				reloaded[i].Routes[j].ChannelId = channelId
+			}
+		}
+	}
+	return reloaded
+}
+
+func buildIndex(t *testing.T, chains []router.PathfinderChain) *router.RouteIndex {
+	t.Helper()
+
+	routeIndex := router.NewRouteIndex()
+	assert.NoError(t, routeIndex.BuildIndex(chains))
+	return routeIndex
+}
+
+func TestPathfinder_Reload(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.Equal(t, response.Direct.Transfer.Channel, "channel-0")
+
+	reloaded := chainsWithHubChannel("channel-141")
+	assert.NoError(t, pathfinder.Reload(reloaded, buildIndex(t, reloaded)))
+
+	response = pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.Equal(t, response.Direct.Transfer.Channel, "channel-141")
+}
+
+func TestPathfinder_ReloadDropsRemovedChains(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	// Keep Osmosis and the Hub only, routes to the dropped chains have to go as well
+	reloaded := []router.PathfinderChain{}
+	for _, chain := range chainsWithHubChannel("channel-0") {
+		if chain.Id != "osmosis-1" && chain.Id != "cosmoshub-4" {
+			continue
+		}
+		chain.Routes = slices.DeleteFunc(slices.Clone(chain.Routes), func(route router.BasicRoute) bool {
+			return route.ToChainId != "osmosis-1" && route.ToChainId != "cosmoshub-4"
+		})
+		reloaded = append(reloaded, chain)
+	}
+	assert.NoError(t, pathfinder.Reload(reloaded, buildIndex(t, reloaded)))
+
+	_, err := pathfinder.GetChainInfo("juno-1")
+	assert.Error(t, err)
+	assert.Equal(t, len(pathfinder.GetAllChains()), 2)
+}
+
+func TestPathfinder_SnapshotKeepsItsConfig(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+	snapshot := pathfinder.Snapshot()
+
+	reloaded := []router.PathfinderChain{}
+	for _, chain := range chainsWithHubChannel("channel-141") {
+		if chain.Id != "osmosis-1" && chain.Id != "cosmoshub-4" {
+			continue
+		}
+		chain.Routes = slices.DeleteFunc(slices.Clone(chain.Routes), func(route router.BasicRoute) bool {
+			return route.ToChainId != "osmosis-1" && route.ToChainId != "cosmoshub-4"
+		})
+		reloaded = append(reloaded, chain)
+	}
+	assert.NoError(t, pathfinder.Reload(reloaded, buildIndex(t, reloaded)))
+
+	// Lookups and routes of the snapshot use the config it was taken with
+	_, err := snapshot.GetChainInfo("juno-1")
+	assert.NoError(t, err)
+	assert.Equal(t, len(snapshot.GetAllChains()), len(chains))
+	_, err = snapshot.DenomResolver().GetChainTokens("juno-1")
+	assert.NoError(t, err)
+	response := snapshot.FindPath(t.Context(), hubToOsmosis)
+	assert.Equal(t, response.Direct.Transfer.Channel, "channel-0")
+
+	response = pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.Equal(t, response.Direct.Transfer.Channel, "channel-141")
+	_, err = pathfinder.GetChainInfo("juno-1")
+	assert.Error(t, err)
+}
+
+func TestPathfinder_ReloadInvalidConfigKeepsRoutes(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+
+	cases := map[string][]router.PathfinderChain{
+		"duplicate chain": append(chainsWithHubChannel("channel-141"), chains[0]),
+		"unknown route destination": func() []router.PathfinderChain {
+			return slices.DeleteFunc(chainsWithHubChannel("channel-141"), func(chain router.PathfinderChain) bool {
+				return chain.Id == "juno-1"
+			})
+		}(),
+		"broker without client": func() []router.PathfinderChain {
+			reloaded := chainsWithHubChannel("channel-141")
+			reloaded[0].BrokerId = "unknown-broker"
+			return reloaded
+		}(),
+		"invalid PFM fee": func() []router.PathfinderChain {
+			reloaded := chainsWithHubChannel("channel-141")
+			reloaded[0].PFMFeePercentage = "1.5"
+			return reloaded
+		}(),
+	}
+
+	for name, reloaded := range cases {
+		t.Run(name, func(t *testing.T) {
+			assert.Error(t, pathfinder.Reload(reloaded, router.NewRouteIndex()))
+
+			response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+			assert.Equal(t, response.Direct.Transfer.Channel, "channel-0")
+		})
+	}
+}
+
+func TestPathfinder_ReloadWhileServing(t *testing.T) {
+	pathfinder, _ := setupTestPathfinder()
+	channels := []string{"channel-0", "channel-141"}
+
+	var wg sync.WaitGroup
+	for i := 0; i < 4; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for j := 0; j < 50; j++ {
+				response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+				if !response.Success || !slices.Contains(channels, response.Direct.Transfer.Channel) {
+					t.Errorf("unexpected response during reload: %+v", response)
+					return
+				}
+			}
+		}()
+	}
+
+	for j := 0; j < 50; j++ {
+		reloaded := chainsWithHubChannel(channels[j%2])
+		assert.NoError(t, pathfinder.Reload(reloaded, buildIndex(t, reloaded)))
+	}
+	wg.Wait()
+}
@@ -1,13 +1,20 @@
 package router
 
 import (
-	"container/list"
+	"strings"
 
 	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
 )
 
-// FindIndirectRoute finds multi-hop paths without swaps using BFS
+// FindIndirectRoute finds multi-hop paths without swaps using a weighted path search
 // It looks for paths where the same token (by origin) can travel through intermediate chains
+// and picks the cheapest one according to the configured edge cost function, see WeightedEdgeCost
+//
+// The search follows the denom the token has on every chain, a token that is forwarded instead of sent back
+// over the channel it came from picks up another hop in its trace. Only paths delivering exactly the requested
+// denom are taken, so a token that would arrive with another trace is sent back towards its origin first.
+// If every path delivers the token with another denom the path is still returned with that DeliveredDenom,
+// the caller decides what to do with it.
 func (ri *RouteIndex) FindIndirectRoute(req models.RouteRequest) *IndirectRouteInfo {
 	// Get source and destination token info
 	sourceToken := ri.denomToTokenInfo[req.ChainFrom][req.TokenFromDenom]
@@ -22,77 +29,65 @@ func (ri *RouteIndex) FindIndirectRoute(req models.RouteRequest) *IndirectRouteI
 		return nil
 	}
 
-	// BFS to find shortest path
-	type pathNode struct {
-		chainId string
-		route   *BasicRoute // route used to reach this chain
-		prev    *pathNode
+	// The token can travel on an edge if the route allows the denom it has on the chain
+	exactStep := func(edge Edge, denom string) (*TokenInfo, bool) {
+		token, allowed := edge.Route.AllowedTokens[denom]
+		return &token, allowed
 	}
-
-	queue := list.New()
-	queue.PushBack(&pathNode{chainId: req.ChainFrom, route: nil, prev: nil})
-	visited := map[string]bool{req.ChainFrom: true}
-
-	for queue.Len() > 0 {
-		element := queue.Front()
-		current := element.Value.(*pathNode)
-		queue.Remove(element)
-
-		// Check if we reached destination
-		if current.chainId == req.ChainTo {
-			// Reconstruct path
-			path := []string{}
-			routes := []*BasicRoute{}
-			node := current
-
-			for node != nil {
-				path = append([]string{node.chainId}, path...)
-				if node.route != nil {
-					routes = append([]*BasicRoute{node.route}, routes...)
-				}
-				node = node.prev
-			}
-
-			return &IndirectRouteInfo{
-				Path:   path,
-				Routes: routes,
-				Token:  sourceToken,
-			}
-		}
-
-		// Explore neighbors
-		for nextChainId, route := range ri.chainRoutes[current.chainId] {
-			if visited[nextChainId] {
-				continue
+	found, ok := ri.findWeightedPath(req.ChainFrom, req.TokenFromDenom, req.ChainTo, exactStep, func(denom string) bool {
+		return denom == req.TokenToDenom
+	})
+
+	if !ok {
+		// Configs without consistent denoms along a path can only be matched by the origin of the token,
+		// the path may then deliver the token with another trace
+		originStep := func(edge Edge, denom string) (*TokenInfo, bool) {
+			if token, allowed := edge.Route.AllowedTokens[denom]; allowed {
+				return &token, true
 			}
-
-			// Check if our token can travel on this route
-			// The token needs to be in AllowedTokens on the current chain
-			currentToken := ri.denomToTokenInfo[current.chainId][req.TokenFromDenom]
-			if current.chainId != req.ChainFrom {
-				// For intermediate chains, find the token by origin
-				currentToken = ri.findTokenByOrigin(current.chainId, sourceToken.OriginChain, sourceToken.BaseDenom)
+			if edge.FirstHop {
+				return nil, false
 			}
-
-			if currentToken == nil {
-				continue
+			current := ri.findTokenByOrigin(edge.FromChainId, sourceToken.OriginChain, sourceToken.BaseDenom)
+			if current == nil {
+				return nil, false
 			}
-
-			// Check if this token is allowed on the route
-			if _, allowed := route.AllowedTokens[currentToken.ChainDenom]; !allowed {
-				continue
-			}
-
-			visited[nextChainId] = true
-			queue.PushBack(&pathNode{
-				chainId: nextChainId,
-				route:   route,
-				prev:    current,
-			})
+			token, allowed := edge.Route.AllowedTokens[current.ChainDenom]
+			return &token, allowed
+		}
+		found, ok = ri.findWeightedPath(req.ChainFrom, req.TokenFromDenom, req.ChainTo, originStep, func(string) bool {
+			return true
+		})
+		if !ok {
+			return nil
 		}
 	}
 
-	return nil
+	routeInfo := &IndirectRouteInfo{
+		Path:           found.Path,
+		Routes:         found.Routes,
+		Token:          sourceToken,
+		Tokens:         found.Tokens,
+		DeliveredDenom: found.Denom,
+	}
+	if len(found.Tokens) > 0 {
+		routeInfo.DeliveredPath = found.Tokens[len(found.Tokens)-1].IbcPath
+	}
+	return routeInfo
+}
+
+// deliversOtherDenom reports whether the route is known to deliver the token with another trace than requested.
+// A delivered denom the destination doesn't know can only be judged by its trace path, configs without
+// trace paths keep routing it like before.
+func (ri *RouteIndex) deliversOtherDenom(req models.RouteRequest, routeInfo *IndirectRouteInfo) bool {
+	if routeInfo.DeliveredDenom == "" || routeInfo.DeliveredDenom == req.TokenToDenom {
+		return false
+	}
+	if routeInfo.DeliveredPath != "" {
+		return true
+	}
+	_, known := ri.denomToTokenInfo[req.ChainTo][routeInfo.DeliveredDenom]
+	return known
 }
 
 // findTokenByOrigin finds a token on a chain by its origin chain and base denom
@@ -104,3 +99,35 @@ func (ri *RouteIndex) findTokenByOrigin(chainId, originChain, baseDenom string)
 	}
 	return nil
 }
+
+// canonicalDenom returns the denom a token has on a chain when it came the shortest way from its origin:
+// the base denom on the origin chain, otherwise the denom with the shortest trace path.
+// known is false if the chain has several denoms of the token and not all their trace paths are in the config.
+func (ri *RouteIndex) canonicalDenom(chainId, originChain, baseDenom string) (denom string, known bool) {
+	if chainId == originChain {
+		return baseDenom, true
+	}
+
+	candidates := make([]*TokenInfo, 0)
+	for _, tokenInfo := range ri.denomToTokenInfo[chainId] {
+		if tokenInfo.OriginChain == originChain && tokenInfo.BaseDenom == baseDenom {
+			candidates = append(candidates, tokenInfo)
+		}
+	}
+	if len(candidates) == 1 {
+		return candidates[0].ChainDenom, true
+	}
+
+	bestHops := 0
+	for _, candidate := range candidates {
+		if candidate.ChainPath == "" {
+			return "", false
+		}
+		// Every hop of a trace path is a port and a channel
+		hops := (strings.Count(candidate.ChainPath, "/") + 1) / 2
+		if denom == "" || hops < bestHops || (hops == bestHops && candidate.ChainDenom < denom) {
+			denom, bestHops = candidate.ChainDenom, hops
+		}
+	}
+	return denom, denom != ""
+}
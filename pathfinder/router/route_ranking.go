@@ -0,0 +1,197 @@
+package router
+
+import (
+	"context"
+	"sort"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// hopPenalty is subtracted from the score for every IBC transfer in a route.
+// It is small enough that a better price always wins, but breaks ties in favour of shorter routes.
+const hopPenalty = 0.001
+
+// FindPaths evaluates every route candidate and returns all viable routes ranked by score.
+// Unlike FindPath it does not stop at the first success: the direct route, the indirect route
+// and every broker candidate are evaluated, with broker candidates queried in parallel.
+//
+// Routes are scored by expected output, which is net of the swap fees, and hop count, see scoreRoutes.
+func (s *Pathfinder) FindPaths(ctx context.Context, req models.RouteRequest) models.RankedRoutesResponse {
+	s = s.current()
+	ctx, span := tracer.Start(ctx, "Pathfinder.FindPaths", trace.WithAttributes(routeRequestAttributes(req)...))
+	defer span.End()
+
+	pathfinderLog.Info().
+		Str("chainFrom", req.ChainFrom).
+		Str("chainTo", req.ChainTo).
+		Str("tokenFrom", req.TokenFromDenom).
+		Str("tokenTo", req.TokenToDenom).
+		Str("amount", req.AmountIn).
+		Str("amountOut", req.AmountOut).
+		Msg("Solving all routes")
+
+	// Same as FindPath, routes without a swap send exactly the requested output
+	if req.IsExactOut() {
+		req.AmountIn = req.AmountOut
+	}
+
+	candidates := []models.RouteResponse{}
+
+	if directRoute := s.routeIndex.FindDirectRoute(req); directRoute != nil {
+		candidates = append(candidates, s.buildDirectResponse(req, directRoute))
+	}
+
+	// A single hop indirect route is the direct route again, only keep real multi-hop paths
+	if indirectRoute := s.routeIndex.FindIndirectRoute(req); indirectRoute != nil && len(indirectRoute.Path) > 2 {
+		if response := s.buildIndirectResponse(req, indirectRoute); response.Success {
+			candidates = append(candidates, response)
+		}
+	}
+
+	brokerRoutes := s.routeIndex.FindMultiHopRoute(req)
+	var lastErr error
+	if len(brokerRoutes) > 0 {
+		var quoted []models.RouteResponse
+		quoted, _, lastErr = s.quoteBrokerRoutes(ctx, req, brokerRoutes)
+		candidates = append(candidates, quoted...)
+	}
+
+	if len(candidates) == 0 {
+		errMsg := "No route found between chains for the requested tokens"
+		if lastErr != nil {
+			errMsg = brokerFailureMessage(lastErr)
+		}
+		pathfinderLog.Warn().Err(lastErr).Msg("No route found")
+		return models.RankedRoutesResponse{
+			Success:      false,
+			ErrorMessage: errMsg,
+		}
+	}
+
+	ranked := scoreRoutes(req, candidates)
+	valuer := s.newValuer()
+	for i := range ranked {
+		valuer.attachValuation(ctx, req, &ranked[i].Route)
+	}
+	pathfinderLog.Info().Int("routes", len(ranked)).Msg("Ranked route candidates")
+
+	return models.RankedRoutesResponse{
+		Success: true,
+		Routes:  ranked,
+	}
+}
+
+// scoreRoutes computes the metrics for each candidate and returns them sorted from best to worst.
+//
+// All candidates deliver the same token on the destination chain, so their outputs are comparable.
+// The score is:
+//
+//	score = expectedOutput / bestOutput - hopPenalty * hopCount
+//
+// The best priced route scores close to 1 and every hop lowers it. The expected output is what the
+// broker quotes after its fees, so the swap fees are already in the price term.
+// For exact out requests every route delivers the same output, so the price term is
+// bestInput / expectedInput instead: the route asking for the least input scores best.
+func scoreRoutes(req models.RouteRequest, candidates []models.RouteResponse) []models.RankedRoute {
+	ranked := make([]models.RankedRoute, len(candidates))
+	outputs := make([]decimal.Decimal, len(candidates))
+	bestOutput := decimal.Zero
+	minHops := -1
+
+	for i, candidate := range candidates {
+		expectedOutput, hopCount, effectiveFee := routeMetrics(req, candidate)
+
+		output, err := decimal.NewFromString(expectedOutput)
+		if err != nil {
+			output = decimal.Zero
+		}
+		// Rate of output per input, the input only differs between routes for exact out requests
+		if req.IsExactOut() {
+			input := routeInput(req, candidate)
+			if input.IsPositive() {
+				output = output.Div(input)
+			} else {
+				output = decimal.Zero
+			}
+		}
+		outputs[i] = output
+		if output.GreaterThan(bestOutput) {
+			bestOutput = output
+		}
+		if minHops == -1 || hopCount < minHops {
+			minHops = hopCount
+		}
+
+		ranked[i] = models.RankedRoute{
+			Route:          candidate,
+			ExpectedOutput: expectedOutput,
+			HopCount:       hopCount,
+			EffectiveFee:   effectiveFee,
+		}
+	}
+
+	for i := range ranked {
+		score := decimal.Zero
+		if bestOutput.IsPositive() {
+			score = outputs[i].Div(bestOutput)
+		}
+		score = score.Sub(decimal.NewFromFloat(hopPenalty).Mul(decimal.NewFromInt(int64(ranked[i].HopCount))))
+
+		ranked[i].Score = score.InexactFloat64()
+		ranked[i].IsBestPrice = bestOutput.IsPositive() && outputs[i].Equal(bestOutput)
+		ranked[i].IsFastest = ranked[i].HopCount == minHops
+	}
+
+	sort.SliceStable(ranked, func(a, b int) bool {
+		if ranked[a].Score != ranked[b].Score {
+			return ranked[a].Score > ranked[b].Score
+		}
+		return ranked[a].HopCount < ranked[b].HopCount
+	})
+
+	for i := range ranked {
+		ranked[i].Rank = i + 1
+	}
+
+	return ranked
+}
+
+// routeMetrics extracts the expected output, hop count and effective fee from a route response
+func routeMetrics(req models.RouteRequest, route models.RouteResponse) (string, int, string) {
+	switch {
+	case route.Direct != nil:
+		return req.AmountIn, 1, "0"
+	case route.Indirect != nil:
+		// The last leg is what is left after the PFM fees of the forwarding chains
+		output := req.AmountIn
+		if legs := route.Indirect.Legs; len(legs) > 0 && legs[len(legs)-1] != nil {
+			output = legs[len(legs)-1].Amount
+		}
+		return output, len(route.Indirect.Legs), "0"
+	case route.BrokerSwap != nil:
+		hops := len(route.BrokerSwap.InboundLegs) + len(route.BrokerSwap.OutboundLegs)
+		if route.BrokerSwap.Swap == nil {
+			return "0", hops, "0"
+		}
+		fee := route.BrokerSwap.Swap.EffectiveFee
+		if fee == "" {
+			fee = "0"
+		}
+		return brokerNetOutput(route.BrokerSwap).String(), hops, fee
+	}
+	return "0", 0, "0"
+}
+
+// routeInput returns the amount the sender spends on a route, before slippage
+func routeInput(req models.RouteRequest, route models.RouteResponse) decimal.Decimal {
+	if route.BrokerSwap != nil {
+		return brokerInput(route.BrokerSwap)
+	}
+	input, err := decimal.NewFromString(req.AmountIn)
+	if err != nil {
+		return decimal.Zero
+	}
+	return input
+}
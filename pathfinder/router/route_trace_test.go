@@ -0,0 +1,168 @@
+package router_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+)
+
+// Denoms of ATOM on the trace chains, ATOM forwarded from Osmosis to Juno is another token than ATOM sent from the Hub
+const (
+	traceAtomOnOsmosis     = "ibc/atom-osmo"
+	traceAtomOnJuno        = "ibc/atom-juno"
+	traceAtomOnJunoViaOsmo = "ibc/atom-osmo-juno"
+
+	traceOsmosisPath     = "transfer/channel-osmo-hub"
+	traceJunoPath        = "transfer/channel-juno-hub"
+	traceJunoViaOsmoPath = "transfer/channel-juno-osmo/transfer/channel-osmo-hub"
+)
+
+// traceAtom returns the allowed tokens of a route carrying ATOM from chainDenom to ibcDenom
+func traceAtom(chainDenom, chainPath, ibcDenom, ibcPath string) map[string]router.TokenInfo {
+	return map[string]router.TokenInfo{
+		chainDenom: {
+			ChainDenom:  chainDenom,
+			ChainPath:   chainPath,
+			IbcDenom:    ibcDenom,
+			IbcPath:     ibcPath,
+			BaseDenom:   "uatom",
+			OriginChain: "hub-1",
+			Symbol:      "ATOM",
+			Decimals:    6,
+		},
+	}
+}
+
+// setupTraceChains builds chains where ATOM can be sent from the Hub to Osmosis and Juno and forwarded from
+// Osmosis to Juno. Without hubToJuno ATOM only reaches Juno over Osmosis.
+func setupTraceChains(hubToJuno bool) []router.PathfinderChain {
+	hubRoutes := []router.BasicRoute{
+		{ToChain: "Osmosis", ToChainId: "osmo-1", ChannelId: "channel-hub-osmo", PortId: "transfer",
+			AllowedTokens: traceAtom("uatom", "", traceAtomOnOsmosis, traceOsmosisPath)},
+	}
+	if hubToJuno {
+		hubRoutes = append(hubRoutes, router.BasicRoute{
+			ToChain: "Juno", ToChainId: "juno-1", ChannelId: "channel-hub-juno", PortId: "transfer",
+			AllowedTokens: traceAtom("uatom", "", traceAtomOnJuno, traceJunoPath),
+		})
+	}
+
+	junoRoutes := []router.BasicRoute{
+		{ToChain: "Osmosis", ToChainId: "osmo-1", ChannelId: "channel-juno-osmo", PortId: "transfer",
+			AllowedTokens: traceAtom(traceAtomOnJunoViaOsmo, traceJunoViaOsmoPath, traceAtomOnOsmosis, traceOsmosisPath)},
+	}
+	if hubToJuno {
+		junoRoutes = append(junoRoutes, router.BasicRoute{
+			ToChain: "Cosmos Hub", ToChainId: "hub-1", ChannelId: "channel-juno-hub", PortId: "transfer",
+			AllowedTokens: traceAtom(traceAtomOnJuno, traceJunoPath, "uatom", ""),
+		})
+	}
+
+	return []router.PathfinderChain{
+		{Name: "Cosmos Hub", Id: "hub-1", HasPFM: true, Bech32Prefix: "cosmos", Routes: hubRoutes},
+		{
+			Name:         "Osmosis",
+			Id:           "osmo-1",
+			HasPFM:       true,
+			Bech32Prefix: "osmo",
+			Routes: []router.BasicRoute{
+				{ToChain: "Cosmos Hub", ToChainId: "hub-1", ChannelId: "channel-osmo-hub", PortId: "transfer",
+					AllowedTokens: traceAtom(traceAtomOnOsmosis, traceOsmosisPath, "uatom", "")},
+				{ToChain: "Juno", ToChainId: "juno-1", ChannelId: "channel-osmo-juno", PortId: "transfer",
+					AllowedTokens: traceAtom(traceAtomOnOsmosis, traceOsmosisPath, traceAtomOnJunoViaOsmo, traceJunoViaOsmoPath)},
+			},
+		},
+		{Name: "Juno", Id: "juno-1", HasPFM: true, Bech32Prefix: "juno", Routes: junoRoutes},
+	}
+}
+
+func newTracePathfinder(t *testing.T, hubToJuno bool) *router.Pathfinder {
+	t.Helper()
+
+	chains := setupTraceChains(hubToJuno)
+	return router.NewPathfinder(chains, buildIndex(t, chains), nil)
+}
+
+func traceRequest(t *testing.T, tokenTo string) models.RouteRequest {
+	t.Helper()
+
+	return models.RouteRequest{
+		ChainFrom:       "osmo-1",
+		ChainTo:         "juno-1",
+		TokenFromDenom:  traceAtomOnOsmosis,
+		TokenToDenom:    tokenTo,
+		AmountIn:        "1000000",
+		SenderAddress:   addressOn(t, "osmo"),
+		ReceiverAddress: addressOn(t, "juno"),
+	}
+}
+
+func TestRouteIndex_IndirectRouteUnwindsToOrigin(t *testing.T) {
+	chains := setupTraceChains(true)
+	routeIndex := buildIndex(t, chains)
+
+	route := routeIndex.FindIndirectRoute(traceRequest(t, traceAtomOnJuno))
+	assert.NotNil(t, route)
+	assert.DeepEqual(t, route.Path, []string{"osmo-1", "hub-1", "juno-1"})
+	assert.Equal(t, route.DeliveredDenom, traceAtomOnJuno)
+	assert.Equal(t, route.DeliveredPath, traceJunoPath)
+
+	// The token is unwound on the Hub before it is sent to Juno
+	assert.Equal(t, len(route.Tokens), 2)
+	assert.Equal(t, route.Tokens[0].ChainDenom, traceAtomOnOsmosis)
+	assert.Equal(t, route.Tokens[0].IbcDenom, "uatom")
+	assert.Equal(t, route.Tokens[1].ChainDenom, "uatom")
+}
+
+func TestPathfinder_NonCanonicalDenom(t *testing.T) {
+	t.Run("unwinds over the origin", func(t *testing.T) {
+		pathfinder := newTracePathfinder(t, true)
+
+		response := pathfinder.FindPath(context.Background(), traceRequest(t, traceAtomOnJuno))
+		assert.True(t, response.Success)
+		assert.Equal(t, response.RouteType, "indirect")
+		assert.DeepEqual(t, response.Indirect.Path, []string{"osmo-1", "hub-1", "juno-1"})
+		assert.Equal(t, len(response.Indirect.Legs), 2)
+		assert.Equal(t, response.Indirect.Legs[1].Token.ChainDenom, "uatom")
+		assert.Equal(t, len(response.Warnings), 0)
+	})
+
+	t.Run("forwarding only is refused", func(t *testing.T) {
+		chains := setupTraceChains(false)
+		// Juno still knows the ATOM from the Hub, e.g. from a route added by another config
+		chains[2].Routes = append(chains[2].Routes, router.BasicRoute{
+			ToChain: "Cosmos Hub", ToChainId: "hub-1", ChannelId: "channel-juno-hub", PortId: "transfer",
+			AllowedTokens: traceAtom(traceAtomOnJuno, traceJunoPath, "uatom", ""),
+		})
+		pathfinder := router.NewPathfinder(chains, buildIndex(t, chains), nil)
+
+		response := pathfinder.FindPath(context.Background(), traceRequest(t, traceAtomOnJuno))
+		assert.False(t, response.Success)
+		assert.Equal(t, response.ErrorCode, models.ErrorCodeNonCanonicalDenom)
+		assert.True(t, strings.Contains(response.ErrorMessage, traceAtomOnJunoViaOsmo))
+	})
+
+	t.Run("non-canonical requested denom warns", func(t *testing.T) {
+		pathfinder := newTracePathfinder(t, true)
+
+		response := pathfinder.FindPath(context.Background(), traceRequest(t, traceAtomOnJunoViaOsmo))
+		assert.True(t, response.Success)
+		assert.Equal(t, response.RouteType, "direct")
+		assert.Equal(t, len(response.Warnings), 1)
+		assert.Equal(t, response.Warnings[0].Code, models.WarningCodeNonCanonicalDenom)
+		assert.True(t, strings.Contains(response.Warnings[0].Message, traceAtomOnJuno))
+	})
+
+	t.Run("canonical denom of the only route does not warn", func(t *testing.T) {
+		pathfinder := newTracePathfinder(t, false)
+
+		response := pathfinder.FindPath(context.Background(), traceRequest(t, traceAtomOnJunoViaOsmo))
+		assert.True(t, response.Success)
+		assert.Equal(t, len(response.Warnings), 0)
+	})
+}
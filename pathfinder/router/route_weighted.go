@@ -0,0 +1,334 @@
+package router
+
+import (
+	"container/heap"
+	"math"
+	"sort"
+	"sync"
+)
+
+// Edge is a single IBC hop considered by the weighted path search
+type Edge struct {
+	// Chain ID the token is sent from
+	FromChainId string
+	// Route used for the transfer
+	Route *BasicRoute
+	// True if FromChainId is the chain the user starts from
+	FirstHop bool
+}
+
+// EdgeCostFunc returns the cost of traversing an edge.
+// Costs must be non-negative, return math.Inf(1) to exclude the edge from the search.
+type EdgeCostFunc func(edge Edge) float64
+
+// EdgeCostConfig holds the weights used by the default edge cost function
+type EdgeCostConfig struct {
+	// Base cost of every IBC hop
+	HopCost float64
+	// Added when the token has to be forwarded from a chain without PFM,
+	// such a hop can't be done in a single transaction
+	NonPFMPenalty float64
+	// Multiplied by the observed failure rate (0-1) of the channel
+	FailureRateWeight float64
+	// Fixed penalties per channel, keyed by ChannelKey(chainId, channelId)
+	ChannelPenalties map[string]float64
+	// Failure rates (0-1) observed outside the pathfinder per channel, keyed by ChannelKey(chainId, channelId).
+	// They are used until enough transfers over the channel are recorded in the ChannelHealth.
+	ChannelFailureRates map[string]float64
+}
+
+// DefaultEdgeCostConfig returns weights where one hop costs 1, a forward without PFM
+// costs half a hop and a channel that always fails costs 10 hops
+func DefaultEdgeCostConfig() EdgeCostConfig {
+	return EdgeCostConfig{
+		HopCost:             1,
+		NonPFMPenalty:       0.5,
+		FailureRateWeight:   10,
+		ChannelPenalties:    map[string]float64{},
+		ChannelFailureRates: map[string]float64{},
+	}
+}
+
+// ChannelKey builds the key used to identify a channel on a chain, e.g. "osmosis-1/channel-0"
+func ChannelKey(chainId, channelId string) string {
+	return chainId + "/" + channelId
+}
+
+// minChannelSamples is the number of observed transfers needed before the failure rate is used
+const minChannelSamples = 10
+
+// ChannelHealth tracks observed transfer results per channel.
+// It is safe for concurrent use.
+type ChannelHealth struct {
+	mu    sync.RWMutex
+	stats map[string]*channelStats
+}
+
+type channelStats struct {
+	successes uint64
+	failures  uint64
+}
+
+// NewChannelHealth creates an empty ChannelHealth tracker
+func NewChannelHealth() *ChannelHealth {
+	return &ChannelHealth{
+		stats: make(map[string]*channelStats),
+	}
+}
+
+// RecordSuccess records a successful transfer over the channel
+func (ch *ChannelHealth) RecordSuccess(chainId, channelId string) {
+	ch.record(chainId, channelId, true)
+}
+
+// RecordFailure records a failed or timed out transfer over the channel
+func (ch *ChannelHealth) RecordFailure(chainId, channelId string) {
+	ch.record(chainId, channelId, false)
+}
+
+func (ch *ChannelHealth) record(chainId, channelId string, success bool) {
+	ch.mu.Lock()
+	defer ch.mu.Unlock()
+
+	key := ChannelKey(chainId, channelId)
+	stats, ok := ch.stats[key]
+	if !ok {
+		stats = &channelStats{}
+		ch.stats[key] = stats
+	}
+	if success {
+		stats.successes++
+	} else {
+		stats.failures++
+	}
+}
+
+// FailureRate returns the observed failure rate (0-1) of the channel.
+// Returns 0 until enough transfers have been observed to make the rate meaningful.
+func (ch *ChannelHealth) FailureRate(chainId, channelId string) float64 {
+	rate, _ := ch.recordedFailureRate(chainId, channelId)
+	return rate
+}
+
+// recordedFailureRate returns the failure rate of the channel, ok is false until enough transfers were recorded
+func (ch *ChannelHealth) recordedFailureRate(chainId, channelId string) (float64, bool) {
+	ch.mu.RLock()
+	defer ch.mu.RUnlock()
+
+	stats, ok := ch.stats[ChannelKey(chainId, channelId)]
+	if !ok {
+		return 0, false
+	}
+	total := stats.successes + stats.failures
+	if total < minChannelSamples {
+		return 0, false
+	}
+	return float64(stats.failures) / float64(total), true
+}
+
+// SetEdgeCostFunc replaces the edge cost function used by the weighted path search
+func (ri *RouteIndex) SetEdgeCostFunc(costFunc EdgeCostFunc) {
+	ri.edgeCost = costFunc
+}
+
+// SetEdgeCostConfig configures the default edge cost function with the given weights
+func (ri *RouteIndex) SetEdgeCostConfig(cfg EdgeCostConfig) {
+	ri.edgeCost = ri.WeightedEdgeCost(cfg)
+}
+
+// ChannelHealth returns the channel health tracker used by the default edge cost function
+func (ri *RouteIndex) ChannelHealth() *ChannelHealth {
+	return ri.channelHealth
+}
+
+// WeightedEdgeCost returns the default edge cost function.
+// The cost of an edge is the hop cost, plus the configured channel penalty,
+// plus the weighted failure rate of the channel, plus the PFM penalty if the token
+// has to be forwarded from an intermediate chain that doesn't support PFM.
+// The failure rate recorded in the ChannelHealth wins over the configured one once it has enough samples.
+func (ri *RouteIndex) WeightedEdgeCost(cfg EdgeCostConfig) EdgeCostFunc {
+	return func(edge Edge) float64 {
+		key := ChannelKey(edge.FromChainId, edge.Route.ChannelId)
+		cost := cfg.HopCost
+		cost += cfg.ChannelPenalties[key]
+
+		failureRate := cfg.ChannelFailureRates[key]
+		if ri.channelHealth != nil {
+			if recorded, ok := ri.channelHealth.recordedFailureRate(edge.FromChainId, edge.Route.ChannelId); ok {
+				failureRate = recorded
+			}
+		}
+		cost += cfg.FailureRateWeight * failureRate
+
+		if !edge.FirstHop && !ri.pfmChains[edge.FromChainId] {
+			cost += cfg.NonPFMPenalty
+		}
+
+		return cost
+	}
+}
+
+// weightedNode is an entry in the priority queue of the weighted path search
+type weightedNode struct {
+	chainId string
+	denom   string     // denom the token has on chainId
+	token   *TokenInfo // token sent over route to reach this chain
+	cost    float64
+	hops    int
+	route   *BasicRoute // route used to reach this chain
+	prev    *weightedNode
+}
+
+// onPath reports whether the path to the node already went through the chain
+func (n *weightedNode) onPath(chainId string) bool {
+	for node := n; node != nil; node = node.prev {
+		if node.chainId == chainId {
+			return true
+		}
+	}
+	return false
+}
+
+// weightedQueue is a min-heap of nodes ordered by cost, then hops, then chain ID and denom
+// so that the search result is deterministic
+type weightedQueue []*weightedNode
+
+func (q weightedQueue) Len() int { return len(q) }
+func (q weightedQueue) Less(i, j int) bool {
+	if q[i].cost != q[j].cost {
+		return q[i].cost < q[j].cost
+	}
+	if q[i].hops != q[j].hops {
+		return q[i].hops < q[j].hops
+	}
+	if q[i].chainId != q[j].chainId {
+		return q[i].chainId < q[j].chainId
+	}
+	return q[i].denom < q[j].denom
+}
+func (q weightedQueue) Swap(i, j int) { q[i], q[j] = q[j], q[i] }
+func (q *weightedQueue) Push(x any)   { *q = append(*q, x.(*weightedNode)) }
+func (q *weightedQueue) Pop() any {
+	old := *q
+	n := len(old)
+	node := old[n-1]
+	*q = old[:n-1]
+	return node
+}
+
+// tokenStepFunc returns the token sent over an edge when the token has the given denom on the
+// edge's chain, or false if the token can't travel on the edge
+type tokenStepFunc func(edge Edge, denom string) (*TokenInfo, bool)
+
+// weightedPath is a path found by the weighted path search
+type weightedPath struct {
+	Path   []string      // Chain IDs in order
+	Routes []*BasicRoute // Routes between consecutive chains
+	Tokens []*TokenInfo  // Token sent over each route
+	Denom  string        // Denom the token arrives with on the last chain
+}
+
+// findWeightedPath finds the cheapest path that moves a token from one chain to another using Dijkstra's algorithm.
+// The search follows the denom of the token: step decides which token an edge sends for the denom the token has
+// on its chain and the token arrives with the IbcDenom of the sent token. accept decides if the denom the token
+// arrives with on the destination is the wanted one, a path never visits a chain twice.
+// The edge cost function decides the cost of an edge. Returns false if the destination is unreachable.
+func (ri *RouteIndex) findWeightedPath(
+	fromChainId, fromDenom, toChainId string,
+	step tokenStepFunc,
+	accept func(denom string) bool,
+) (weightedPath, bool) {
+	costFunc := ri.edgeCost
+	if costFunc == nil {
+		costFunc = ri.WeightedEdgeCost(DefaultEdgeCostConfig())
+	}
+
+	// The token can have another denom on a chain depending on the path it took there
+	stateKey := func(chainId, denom string) string {
+		return chainId + ":" + denom
+	}
+
+	queue := &weightedQueue{{chainId: fromChainId, denom: fromDenom}}
+	settled := map[string]bool{}
+	bestCost := map[string]float64{stateKey(fromChainId, fromDenom): 0}
+
+	for queue.Len() > 0 {
+		current := heap.Pop(queue).(*weightedNode)
+		key := stateKey(current.chainId, current.denom)
+		if settled[key] {
+			continue
+		}
+		settled[key] = true
+
+		// Check if we reached destination, a path can't leave it and come back
+		if current.chainId == toChainId {
+			if !accept(current.denom) {
+				continue
+			}
+			found := weightedPath{Denom: current.denom}
+			for node := current; node != nil; node = node.prev {
+				found.Path = append([]string{node.chainId}, found.Path...)
+				if node.route != nil {
+					found.Routes = append([]*BasicRoute{node.route}, found.Routes...)
+					found.Tokens = append([]*TokenInfo{node.token}, found.Tokens...)
+				}
+			}
+			return found, true
+		}
+
+		// Explore neighbors in a stable order so equal cost paths resolve the same way every time
+		neighbors := ri.chainRoutes[current.chainId]
+		nextChainIds := make([]string, 0, len(neighbors))
+		for nextChainId := range neighbors {
+			nextChainIds = append(nextChainIds, nextChainId)
+		}
+		sort.Strings(nextChainIds)
+
+		for _, nextChainId := range nextChainIds {
+			route := neighbors[nextChainId]
+			if current.onPath(nextChainId) {
+				continue
+			}
+
+			edge := Edge{
+				FromChainId: current.chainId,
+				Route:       route,
+				FirstHop:    current.prev == nil,
+			}
+			token, ok := step(edge, current.denom)
+			if !ok {
+				continue
+			}
+			nextKey := stateKey(nextChainId, token.IbcDenom)
+			if settled[nextKey] {
+				continue
+			}
+
+			edgeCost := costFunc(edge)
+			if math.IsInf(edgeCost, 1) || math.IsNaN(edgeCost) {
+				continue
+			}
+			if edgeCost < 0 {
+				edgeCost = 0
+			}
+
+			cost := current.cost + edgeCost
+			if known, ok := bestCost[nextKey]; ok && known <= cost {
+				continue
+			}
+			bestCost[nextKey] = cost
+
+			heap.Push(queue, &weightedNode{
+				chainId: nextChainId,
+				denom:   token.IbcDenom,
+				token:   token,
+				cost:    cost,
+				hops:    current.hops + 1,
+				route:   route,
+				prev:    current,
+			})
+		}
+	}
+
+	return weightedPath{}, false
+}
@@ -0,0 +1,269 @@
+package router
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
+	txbuilder "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/tx_builder"
+)
+
+/*
+BuildTransactions builds the unsigned transactions that execute a route found by FindPath or FindPaths.
+
+The transactions are returned in the order they have to be broadcast. A transaction after the first one
+can only be sent once the transfer before it has been received, this is only the case for indirect routes
+without PFM support where every leg is its own transfer. Signers and intermediate receivers on other chains
+are derived from the sender address.
+
+Broker swaps are executed with their execution data, so they have to be found with a smart route.
+*/
+func (s *Pathfinder) BuildTransactions(req models.TransactionRequest) ([]models.UnsignedTransaction, error) {
+	s = s.current()
+
+	if !req.Route.Success {
+		return nil, fmt.Errorf("the route was not found, there is nothing to execute")
+	}
+
+	timeout := transferTimeout(req)
+
+	var transactions []models.UnsignedTransaction
+	var err error
+	switch req.Route.RouteType {
+	case "direct":
+		transactions, err = s.buildDirectTransactions(req, timeout)
+	case "indirect":
+		transactions, err = s.buildIndirectTransactions(req, timeout)
+	case "broker_swap":
+		transactions, err = s.buildBrokerSwapTransactions(req, timeout)
+	default:
+		return nil, fmt.Errorf("unsupported route type: %s", req.Route.RouteType)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	// The first transaction is signed by the sender as given, the others by addresses derived from it
+	source := transactions[0].ChainID
+	if prefix, ok := s.addressConverter.GetPrefix(source); ok && !strings.HasPrefix(req.SenderAddress, prefix+"1") {
+		return nil, fmt.Errorf("sender address %s is not an address on %s", req.SenderAddress, source)
+	}
+
+	return transactions, nil
+}
+
+// ibcTimeout is the timeout set on every MsgTransfer of a route
+type ibcTimeout struct {
+	height    txbuilder.Height
+	timestamp uint64
+}
+
+// transferTimeout returns the timeout of the request, by default transfers time out in 15 minutes
+func transferTimeout(req models.TransactionRequest) ibcTimeout {
+	timeout := ibcTimeout{timestamp: req.TimeoutTimestamp}
+	if req.TimeoutHeight != nil {
+		timeout.height = txbuilder.Height{
+			RevisionNumber: req.TimeoutHeight.RevisionNumber,
+			RevisionHeight: req.TimeoutHeight.RevisionHeight,
+		}
+	}
+	if timeout.timestamp == 0 && timeout.height.RevisionHeight == 0 {
+		timeout.timestamp = uint64(ibcmemo.DefaultTimeoutTimestamp())
+	}
+	return timeout
+}
+
+// buildDirectTransactions sends the token straight to the receiver
+func (s *Pathfinder) buildDirectTransactions(req models.TransactionRequest, timeout ibcTimeout) ([]models.UnsignedTransaction, error) {
+	direct := req.Route.Direct
+	if direct == nil || direct.Transfer == nil {
+		return nil, fmt.Errorf("direct route has no transfer")
+	}
+
+	msg, err := newMsgTransfer(direct.Transfer, req.SenderAddress, req.ReceiverAddress, "", timeout)
+	if err != nil {
+		return nil, err
+	}
+
+	transaction, err := newUnsignedTransaction(direct.Transfer.FromChain, req.SenderAddress, req.Memo, msg)
+	if err != nil {
+		return nil, err
+	}
+	return []models.UnsignedTransaction{transaction}, nil
+}
+
+// buildIndirectTransactions uses a single transfer with the PFM memo if the route supports it,
+// otherwise every leg is transferred on its own
+func (s *Pathfinder) buildIndirectTransactions(req models.TransactionRequest, timeout ibcTimeout) ([]models.UnsignedTransaction, error) {
+	indirect := req.Route.Indirect
+	if indirect == nil || len(indirect.Legs) == 0 {
+		return nil, fmt.Errorf("indirect route has no legs")
+	}
+
+	if indirect.SupportsPFM && indirect.PFMMemo != "" {
+		firstLeg := indirect.Legs[0]
+		// PFM forwards from the first intermediate chain, the transfer is received there
+		receiver, err := s.addressConverter.ConvertAddress(req.SenderAddress, firstLeg.ToChain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the receiver on %s: %w", firstLeg.ToChain, err)
+		}
+		msg, err := newMsgTransfer(firstLeg, req.SenderAddress, receiver, indirect.PFMMemo, timeout)
+		if err != nil {
+			return nil, err
+		}
+		transaction, err := newUnsignedTransaction(firstLeg.FromChain, req.SenderAddress, req.Memo, msg)
+		if err != nil {
+			return nil, err
+		}
+		return []models.UnsignedTransaction{transaction}, nil
+	}
+
+	transactions := make([]models.UnsignedTransaction, len(indirect.Legs))
+	for i, leg := range indirect.Legs {
+		if leg == nil {
+			return nil, fmt.Errorf("indirect route leg %d is empty", i)
+		}
+
+		signer, err := s.addressConverter.ConvertAddress(req.SenderAddress, leg.FromChain)
+		if err != nil {
+			return nil, fmt.Errorf("failed to derive the signer on %s: %w", leg.FromChain, err)
+		}
+
+		// Intermediate legs go to the sender, who then signs the next leg
+		receiver := req.ReceiverAddress
+		if i < len(indirect.Legs)-1 {
+			if receiver, err = s.addressConverter.ConvertAddress(req.SenderAddress, leg.ToChain); err != nil {
+				return nil, fmt.Errorf("failed to derive the receiver on %s: %w", leg.ToChain, err)
+			}
+		}
+
+		msg, err := newMsgTransfer(leg, signer, receiver, "", timeout)
+		if err != nil {
+			return nil, err
+		}
+		if transactions[i], err = newUnsignedTransaction(leg.FromChain, signer, req.Memo, msg); err != nil {
+			return nil, err
+		}
+	}
+	return transactions, nil
+}
+
+// buildBrokerSwapTransactions executes the swap with the execution data of the route.
+// Routes starting on the broker chain call the entry point contract directly,
+// other routes send the first inbound transfer with the wasm or PFM memo.
+func (s *Pathfinder) buildBrokerSwapTransactions(req models.TransactionRequest, timeout ibcTimeout) ([]models.UnsignedTransaction, error) {
+	route := req.Route.BrokerSwap
+	if route == nil || len(route.Path) == 0 {
+		return nil, fmt.Errorf("broker swap route has no path")
+	}
+	execution := route.Execution
+	if execution == nil {
+		return nil, fmt.Errorf("broker swap route has no execution data, find the route with smart_route set")
+	}
+
+	if len(route.InboundLegs) == 0 {
+		if execution.SmartContractData == nil || execution.SmartContractData.Wasm == nil {
+			return nil, fmt.Errorf("broker swap route has no smart contract data")
+		}
+		if route.Swap == nil || route.Swap.TokenIn == nil {
+			return nil, fmt.Errorf("broker swap route has no swap")
+		}
+
+		wasm := execution.SmartContractData.Wasm
+		contractMsg, err := json.Marshal(wasm.Msg)
+		if err != nil {
+			return nil, fmt.Errorf("failed to encode the contract message: %w", err)
+		}
+
+		// Exact out swaps send the input plus slippage, the unused part is refunded
+		amount := route.Swap.AmountIn
+		if execution.MaxInputAmount != "" {
+			amount = execution.MaxInputAmount
+		}
+
+		msg := &txbuilder.MsgExecuteContract{
+			Sender:   req.SenderAddress,
+			Contract: wasm.Contract,
+			Msg:      contractMsg,
+			Funds:    []txbuilder.Coin{{Denom: route.Swap.TokenIn.ChainDenom, Amount: amount}},
+		}
+		transaction, err := newUnsignedTransaction(route.Path[0], req.SenderAddress, req.Memo, msg)
+		if err != nil {
+			return nil, err
+		}
+		return []models.UnsignedTransaction{transaction}, nil
+	}
+
+	if execution.Memo == nil || execution.IBCReceiver == nil {
+		return nil, fmt.Errorf("broker swap route has no memo for the inbound transfer")
+	}
+
+	firstLeg := route.InboundLegs[0]
+	if firstLeg == nil {
+		return nil, fmt.Errorf("broker swap route inbound leg is empty")
+	}
+	msg, err := newMsgTransfer(firstLeg, req.SenderAddress, *execution.IBCReceiver, *execution.Memo, timeout)
+	if err != nil {
+		return nil, err
+	}
+	transaction, err := newUnsignedTransaction(firstLeg.FromChain, req.SenderAddress, req.Memo, msg)
+	if err != nil {
+		return nil, err
+	}
+	return []models.UnsignedTransaction{transaction}, nil
+}
+
+// newMsgTransfer builds the MsgTransfer of an IBC leg
+func newMsgTransfer(leg *models.IBCLeg, sender, receiver, memo string, timeout ibcTimeout) (*txbuilder.MsgTransfer, error) {
+	if leg.Token == nil || leg.Token.ChainDenom == "" {
+		return nil, fmt.Errorf("leg from %s to %s has no token", leg.FromChain, leg.ToChain)
+	}
+	if leg.Channel == "" {
+		return nil, fmt.Errorf("leg from %s to %s has no channel", leg.FromChain, leg.ToChain)
+	}
+	if receiver == "" {
+		return nil, fmt.Errorf("leg from %s to %s has no receiver", leg.FromChain, leg.ToChain)
+	}
+
+	port := leg.Port
+	if port == "" {
+		port = ibcmemo.DefaultPort()
+	}
+
+	return &txbuilder.MsgTransfer{
+		SourcePort:       port,
+		SourceChannel:    leg.Channel,
+		Token:            txbuilder.Coin{Denom: leg.Token.ChainDenom, Amount: leg.Amount},
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    timeout.height,
+		TimeoutTimestamp: timeout.timestamp,
+		Memo:             memo,
+	}, nil
+}
+
+// newUnsignedTransaction encodes msgs in every form a signer can use
+func newUnsignedTransaction(chainId, signer, memo string, msgs ...txbuilder.Msg) (models.UnsignedTransaction, error) {
+	messages := make([]models.UnsignedMessage, len(msgs))
+	for i, msg := range msgs {
+		aminoJSON, err := msg.AminoJSON()
+		if err != nil {
+			return models.UnsignedTransaction{}, fmt.Errorf("failed to encode %s as amino JSON: %w", msg.TypeURL(), err)
+		}
+		messages[i] = models.UnsignedMessage{
+			TypeURL:   msg.TypeURL(),
+			Value:     msg.Marshal(),
+			AminoJSON: string(aminoJSON),
+		}
+	}
+
+	return models.UnsignedTransaction{
+		ChainID:   chainId,
+		Signer:    signer,
+		Messages:  messages,
+		Memo:      memo,
+		BodyBytes: txbuilder.TxBody(memo, msgs...),
+	}, nil
+}
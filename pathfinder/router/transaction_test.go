@@ -0,0 +1,318 @@
+package router_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/zeebo/assert"
+	"google.golang.org/protobuf/encoding/protowire"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	router "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers"
+	txbuilder "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/tx_builder"
+)
+
+const entryPointContract = "osmo10a3k4hvk37cc4hnxctw4p95fhscd2z6h2rmx0aukc6rm8u9qqx9smfsh7u"
+
+// setupPrefixedPathfinder returns a pathfinder whose chains have bech32 prefixes,
+// signers and receivers on other chains are derived from the sender
+func setupPrefixedPathfinder(t *testing.T) *router.Pathfinder {
+	t.Helper()
+
+	prefixes := map[string]string{
+		"osmosis-1": "osmo", "cosmoshub-4": "cosmos", "juno-1": "juno", "atomone-1": "atone", "noble-1": "noble",
+	}
+	prefixedChains := make([]router.PathfinderChain, len(chains))
+	for i, chain := range chains {
+		chain.Bech32Prefix = prefixes[chain.Id]
+		prefixedChains[i] = chain
+	}
+	return router.NewPathfinder(prefixedChains, buildIndex(t, prefixedChains), map[string]brokers.BrokerClient{
+		"osmosis-sqs": &MockBrokerClient{brokerType: "osmosis-sqs", contractAddress: entryPointContract},
+	})
+}
+
+// addressOn returns the test account on the chain with prefix
+func addressOn(t *testing.T, prefix string) string {
+	t.Helper()
+
+	address, err := router.ConvertBech32Address(entryPointContract, prefix)
+	assert.NoError(t, err)
+	return address
+}
+
+func TestPathfinder_BuildDirectTransaction(t *testing.T) {
+	pathfinder := setupPrefixedPathfinder(t)
+	sender, receiver := addressOn(t, "cosmos"), addressOn(t, "osmo")
+
+	req := hubToOsmosis
+	req.SenderAddress, req.ReceiverAddress = sender, receiver
+	route := pathfinder.FindPath(t.Context(), req)
+	assert.True(t, route.Success)
+
+	before := uint64(time.Now().UnixNano())
+	transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{
+		Route:           route,
+		SenderAddress:   sender,
+		ReceiverAddress: receiver,
+		Memo:            "Spectra IBC transfer",
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, len(transactions), 1)
+
+	transaction := transactions[0]
+	assert.Equal(t, transaction.ChainID, "cosmoshub-4")
+	assert.Equal(t, transaction.Signer, sender)
+	assert.Equal(t, transaction.Memo, "Spectra IBC transfer")
+	assert.Equal(t, len(transaction.Messages), 1)
+
+	// Without a timeout preference the transfer times out in 15 minutes
+	msg := transaction.Messages[0]
+	assert.Equal(t, msg.TypeURL, txbuilder.MsgTransferTypeURL)
+	assert.True(t, strings.Contains(msg.AminoJSON, `"receiver":"`+receiver+`"`))
+	assert.True(t, strings.Contains(msg.AminoJSON, `"source_channel":"channel-0"`))
+	assert.True(t, strings.Contains(msg.AminoJSON, `"token":{"amount":"1000000","denom":"uatom"}`))
+
+	expected := &txbuilder.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Token:         txbuilder.Coin{Denom: "uatom", Amount: "1000000"},
+		Sender:        sender,
+		Receiver:      receiver,
+	}
+	timeout := timeoutOf(t, msg.Value, expected)
+	assert.True(t, timeout >= before+uint64(14*time.Minute))
+	assert.True(t, timeout <= uint64(time.Now().Add(16*time.Minute).UnixNano()))
+	assert.Equal(t, transaction.BodyBytes, txbuilder.TxBody("Spectra IBC transfer", expected))
+}
+
+// timeoutOf returns the timeout timestamp of the encoded transfer and checks the other fields against expected
+func timeoutOf(t *testing.T, value []byte, expected *txbuilder.MsgTransfer) uint64 {
+	t.Helper()
+
+	var timestamp uint64
+	for b := value; len(b) > 0; {
+		num, typ, n := protowire.ConsumeTag(b)
+		assert.True(t, n > 0)
+		b = b[n:]
+		if num == 7 {
+			timestamp, n = protowire.ConsumeVarint(b)
+		} else {
+			n = protowire.ConsumeFieldValue(num, typ, b)
+		}
+		assert.True(t, n > 0)
+		b = b[n:]
+	}
+
+	expected.TimeoutTimestamp = timestamp
+	assert.Equal(t, value, expected.Marshal())
+	return timestamp
+}
+
+func TestPathfinder_BuildTransactionTimeoutPreferences(t *testing.T) {
+	pathfinder := setupPrefixedPathfinder(t)
+	sender, receiver := addressOn(t, "cosmos"), addressOn(t, "osmo")
+
+	req := hubToOsmosis
+	req.SenderAddress, req.ReceiverAddress = sender, receiver
+	route := pathfinder.FindPath(t.Context(), req)
+
+	transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{
+		Route:            route,
+		SenderAddress:    sender,
+		ReceiverAddress:  receiver,
+		TimeoutTimestamp: 1760000000000000000,
+		TimeoutHeight:    &models.IBCHeight{RevisionNumber: 1, RevisionHeight: 30000000},
+	})
+	assert.NoError(t, err)
+
+	expected := &txbuilder.MsgTransfer{
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-0",
+		Token:            txbuilder.Coin{Denom: "uatom", Amount: "1000000"},
+		Sender:           sender,
+		Receiver:         receiver,
+		TimeoutHeight:    txbuilder.Height{RevisionNumber: 1, RevisionHeight: 30000000},
+		TimeoutTimestamp: 1760000000000000000,
+	}
+	assert.Equal(t, transactions[0].Messages[0].Value, expected.Marshal())
+
+	// A timeout height alone doesn't get the default timestamp
+	transactions, err = pathfinder.BuildTransactions(models.TransactionRequest{
+		Route:           route,
+		SenderAddress:   sender,
+		ReceiverAddress: receiver,
+		TimeoutHeight:   &models.IBCHeight{RevisionNumber: 1, RevisionHeight: 30000000},
+	})
+	assert.NoError(t, err)
+	expected.TimeoutTimestamp = 0
+	assert.Equal(t, transactions[0].Messages[0].Value, expected.Marshal())
+}
+
+func TestPathfinder_BuildIndirectTransactions(t *testing.T) {
+	pathfinder := setupPrefixedPathfinder(t)
+	sender, receiver := addressOn(t, "juno"), addressOn(t, "osmo")
+
+	// USDC from Juno through Noble to Osmosis
+	route := pathfinder.FindPath(t.Context(), models.RouteRequest{
+		ChainFrom:       "juno-1",
+		ChainTo:         "osmosis-1",
+		TokenFromDenom:  "ibc/EAC38D55372F38F1AFD68DF7FE9EF762DCF69F26520643CF3F9D292A738D8034",
+		TokenToDenom:    "ibc/498A0751C798A0D9A389AA3691123DADA57DAA4FE165D5C75894505B876BA6E4",
+		AmountIn:        "5000000",
+		SenderAddress:   sender,
+		ReceiverAddress: receiver,
+	})
+	assert.True(t, route.Success)
+	assert.True(t, route.Indirect.SupportsPFM)
+
+	t.Run("with PFM", func(t *testing.T) {
+		transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{
+			Route:           route,
+			SenderAddress:   sender,
+			ReceiverAddress: receiver,
+		})
+		assert.NoError(t, err)
+
+		// One transfer to Noble, PFM forwards it to Osmosis
+		assert.Equal(t, len(transactions), 1)
+		assert.Equal(t, transactions[0].ChainID, "juno-1")
+		aminoJSON := transactions[0].Messages[0].AminoJSON
+		assert.True(t, strings.Contains(aminoJSON, `"receiver":"`+addressOn(t, "noble")+`"`))
+		assert.True(t, strings.Contains(aminoJSON, `"memo":`))
+	})
+
+	t.Run("without PFM", func(t *testing.T) {
+		manual := route
+		indirect := *route.Indirect
+		indirect.SupportsPFM = false
+		manual.Indirect = &indirect
+
+		transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{
+			Route:           manual,
+			SenderAddress:   sender,
+			ReceiverAddress: receiver,
+		})
+		assert.NoError(t, err)
+
+		// Every leg is sent on its own, the sender signs the second leg on Noble
+		assert.Equal(t, len(transactions), 2)
+		assert.Equal(t, transactions[0].ChainID, "juno-1")
+		assert.Equal(t, transactions[0].Signer, sender)
+		assert.True(t, strings.Contains(transactions[0].Messages[0].AminoJSON, `"receiver":"`+addressOn(t, "noble")+`"`))
+		assert.False(t, strings.Contains(transactions[0].Messages[0].AminoJSON, `"memo":`))
+
+		assert.Equal(t, transactions[1].ChainID, "noble-1")
+		assert.Equal(t, transactions[1].Signer, addressOn(t, "noble"))
+		assert.True(t, strings.Contains(transactions[1].Messages[0].AminoJSON, `"receiver":"`+receiver+`"`))
+	})
+}
+
+func TestPathfinder_BuildBrokerSwapTransactions(t *testing.T) {
+	pathfinder := setupPrefixedPathfinder(t)
+	smartRoute := true
+
+	t.Run("inbound transfer", func(t *testing.T) {
+		sender, receiver := addressOn(t, "cosmos"), addressOn(t, "juno")
+		route := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "cosmoshub-4",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uatom",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   sender,
+			ReceiverAddress: receiver,
+			SmartRoute:      &smartRoute,
+		})
+		assert.True(t, route.Success)
+
+		transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{Route: route, SenderAddress: sender})
+		assert.NoError(t, err)
+		assert.Equal(t, len(transactions), 1)
+
+		// The transfer goes to the entry point contract with the wasm memo
+		aminoJSON := transactions[0].Messages[0].AminoJSON
+		assert.Equal(t, transactions[0].ChainID, "cosmoshub-4")
+		assert.True(t, strings.Contains(aminoJSON, `"receiver":"`+entryPointContract+`"`))
+		assert.True(t, strings.Contains(aminoJSON, `"memo":"{\"wasm\":`))
+	})
+
+	t.Run("contract execution", func(t *testing.T) {
+		sender, receiver := addressOn(t, "osmo"), addressOn(t, "juno")
+		route := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "osmosis-1",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uosmo",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   sender,
+			ReceiverAddress: receiver,
+			SmartRoute:      &smartRoute,
+		})
+		assert.True(t, route.Success)
+		assert.Equal(t, len(route.BrokerSwap.InboundLegs), 0)
+
+		transactions, err := pathfinder.BuildTransactions(models.TransactionRequest{Route: route, SenderAddress: sender})
+		assert.NoError(t, err)
+		assert.Equal(t, len(transactions), 1)
+
+		// The entry point contract is called with the swap input as funds
+		msg := transactions[0].Messages[0]
+		assert.Equal(t, transactions[0].ChainID, "osmosis-1")
+		assert.Equal(t, msg.TypeURL, txbuilder.MsgExecuteContractTypeURL)
+		assert.True(t, strings.HasPrefix(msg.AminoJSON, `{"type":"wasm/MsgExecuteContract","value":{"contract":"`+entryPointContract+`",`+
+			`"funds":[{"amount":"1000000","denom":"uosmo"}],"msg":{"swap_and_action":{`))
+	})
+
+	t.Run("without execution data", func(t *testing.T) {
+		sender := addressOn(t, "cosmos")
+		route := pathfinder.FindPath(t.Context(), models.RouteRequest{
+			ChainFrom:       "cosmoshub-4",
+			ChainTo:         "juno-1",
+			TokenFromDenom:  "uatom",
+			TokenToDenom:    "ujuno",
+			AmountIn:        "1000000",
+			SenderAddress:   sender,
+			ReceiverAddress: addressOn(t, "juno"),
+		})
+		assert.True(t, route.Success)
+
+		_, err := pathfinder.BuildTransactions(models.TransactionRequest{Route: route, SenderAddress: sender})
+		assert.Error(t, err)
+	})
+}
+
+func TestPathfinder_BuildTransactionsInvalidRequest(t *testing.T) {
+	pathfinder := setupPrefixedPathfinder(t)
+	sender, receiver := addressOn(t, "cosmos"), addressOn(t, "osmo")
+
+	req := hubToOsmosis
+	req.SenderAddress, req.ReceiverAddress = sender, receiver
+	route := pathfinder.FindPath(t.Context(), req)
+
+	cases := map[string]models.TransactionRequest{
+		"route not found": {
+			Route:           models.RouteResponse{Success: false, RouteType: "impossible"},
+			SenderAddress:   sender,
+			ReceiverAddress: receiver,
+		},
+		"sender on another chain": {
+			Route:           route,
+			SenderAddress:   addressOn(t, "osmo"),
+			ReceiverAddress: receiver,
+		},
+		"missing receiver": {
+			Route:         route,
+			SenderAddress: sender,
+		},
+	}
+
+	for name, req := range cases {
+		t.Run(name, func(t *testing.T) {
+			_, err := pathfinder.BuildTransactions(req)
+			assert.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,39 @@
+/*
+Package txbuilder encodes the Cosmos SDK messages the pathfinder routes are executed with.
+
+A route is executed with an IBC MsgTransfer (optionally carrying a PFM or wasm memo) or, when the route
+starts on the broker chain, with a CosmWasm MsgExecuteContract calling the entry point contract.
+Clients that don't want to assemble these messages themselves get them ready to sign from the
+BuildTransaction RPC.
+
+Every message is available in the forms a signer needs:
+
+  - the protobuf encoding, the value of the google.protobuf.Any in the transaction body
+  - the legacy Amino JSON, as it appears in a SIGN_MODE_LEGACY_AMINO_JSON sign doc
+  - the encoded cosmos.tx.v1beta1.TxBody (see TxBody), the body_bytes of a SIGN_MODE_DIRECT sign doc
+
+The messages are encoded with protowire instead of the generated Cosmos SDK, ibc-go and wasmd types,
+so the pathfinder doesn't depend on those modules. The encoding follows the field numbers of:
+
+  - ibc.applications.transfer.v1.MsgTransfer
+  - cosmwasm.wasm.v1.MsgExecuteContract
+  - cosmos.base.v1beta1.Coin
+  - ibc.core.client.v1.Height
+  - cosmos.tx.v1beta1.TxBody
+
+For example the transfer of 1 ATOM to Osmosis over channel-141:
+
+	msg := &txbuilder.MsgTransfer{
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-141",
+		Token:            txbuilder.Coin{Denom: "uatom", Amount: "1000000"},
+		Sender:           "cosmos1...",
+		Receiver:         "osmo1...",
+		TimeoutTimestamp: uint64(time.Now().Add(15 * time.Minute).UnixNano()),
+	}
+	bodyBytes := txbuilder.TxBody("", msg)
+
+The Amino JSON has sorted keys and leaves out zero values the same way the chains do.
+The rest of the sign doc (fee, sequence, account number) is up to the signer.
+*/
+package txbuilder
@@ -0,0 +1,219 @@
+package txbuilder
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+)
+
+// Type URLs of the messages built by this package
+const (
+	MsgTransferTypeURL        = "/ibc.applications.transfer.v1.MsgTransfer"
+	MsgExecuteContractTypeURL = "/cosmwasm.wasm.v1.MsgExecuteContract"
+)
+
+// Amino names of the messages, used as the type in Amino JSON
+const (
+	msgTransferAminoName        = "cosmos-sdk/MsgTransfer"
+	msgExecuteContractAminoName = "wasm/MsgExecuteContract"
+)
+
+// Msg is a Cosmos SDK message that can be signed in direct and in legacy Amino JSON sign mode
+type Msg interface {
+	// TypeURL returns the type URL of the message in a google.protobuf.Any
+	TypeURL() string
+	// Marshal returns the protobuf encoding of the message
+	Marshal() []byte
+	// AminoJSON returns the message as it appears in the msgs of an Amino JSON sign doc
+	AminoJSON() ([]byte, error)
+}
+
+// Ensure the messages implement Msg
+var (
+	_ Msg = (*MsgTransfer)(nil)
+	_ Msg = (*MsgExecuteContract)(nil)
+)
+
+// Coin is cosmos.base.v1beta1.Coin
+type Coin struct {
+	Denom  string
+	Amount string
+}
+
+// Height is ibc.core.client.v1.Height, the zero height disables the timeout height
+type Height struct {
+	RevisionNumber uint64
+	RevisionHeight uint64
+}
+
+// MsgTransfer is ibc.applications.transfer.v1.MsgTransfer
+type MsgTransfer struct {
+	SourcePort    string
+	SourceChannel string
+	Token         Coin
+	Sender        string
+	Receiver      string
+	TimeoutHeight Height
+	// TimeoutTimestamp is the timeout in unix nanoseconds, zero disables it
+	TimeoutTimestamp uint64
+	// Memo is the IBC memo, e.g. PFM forwarding or a wasm hook
+	Memo string
+}
+
+// MsgExecuteContract is cosmwasm.wasm.v1.MsgExecuteContract
+type MsgExecuteContract struct {
+	Sender   string
+	Contract string
+	// Msg is the JSON message passed to the contract
+	Msg   json.RawMessage
+	Funds []Coin
+}
+
+func (m *MsgTransfer) TypeURL() string {
+	return MsgTransferTypeURL
+}
+
+func (m *MsgTransfer) Marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.SourcePort)
+	b = appendString(b, 2, m.SourceChannel)
+	// The token and the timeout height are not nullable, they are always encoded
+	b = appendMessage(b, 3, m.Token.marshal())
+	b = appendString(b, 4, m.Sender)
+	b = appendString(b, 5, m.Receiver)
+	b = appendMessage(b, 6, m.TimeoutHeight.marshal())
+	b = appendUint64(b, 7, m.TimeoutTimestamp)
+	b = appendString(b, 8, m.Memo)
+	return b
+}
+
+func (m *MsgTransfer) AminoJSON() ([]byte, error) {
+	// Fields are in alphabetical order, the sign doc needs sorted keys
+	type aminoHeight struct {
+		RevisionHeight string `json:"revision_height,omitempty"`
+		RevisionNumber string `json:"revision_number,omitempty"`
+	}
+	type aminoMsgTransfer struct {
+		Memo             string      `json:"memo,omitempty"`
+		Receiver         string      `json:"receiver"`
+		Sender           string      `json:"sender"`
+		SourceChannel    string      `json:"source_channel"`
+		SourcePort       string      `json:"source_port"`
+		TimeoutHeight    aminoHeight `json:"timeout_height"`
+		TimeoutTimestamp string      `json:"timeout_timestamp,omitempty"`
+		Token            aminoCoin   `json:"token"`
+	}
+
+	return aminoJSON(msgTransferAminoName, aminoMsgTransfer{
+		Memo:          m.Memo,
+		Receiver:      m.Receiver,
+		Sender:        m.Sender,
+		SourceChannel: m.SourceChannel,
+		SourcePort:    m.SourcePort,
+		TimeoutHeight: aminoHeight{
+			RevisionHeight: aminoUint64(m.TimeoutHeight.RevisionHeight),
+			RevisionNumber: aminoUint64(m.TimeoutHeight.RevisionNumber),
+		},
+		TimeoutTimestamp: aminoUint64(m.TimeoutTimestamp),
+		Token:            newAminoCoin(m.Token),
+	})
+}
+
+func (m *MsgExecuteContract) TypeURL() string {
+	return MsgExecuteContractTypeURL
+}
+
+func (m *MsgExecuteContract) Marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, m.Sender)
+	b = appendString(b, 2, m.Contract)
+	b = appendBytes(b, 3, m.Msg)
+	for _, coin := range m.Funds {
+		b = appendMessage(b, 5, coin.marshal())
+	}
+	return b
+}
+
+func (m *MsgExecuteContract) AminoJSON() ([]byte, error) {
+	type aminoMsgExecuteContract struct {
+		Contract string      `json:"contract"`
+		Funds    []aminoCoin `json:"funds"`
+		Msg      any         `json:"msg"`
+		Sender   string      `json:"sender"`
+	}
+
+	// The contract message is embedded as JSON, decoding it into maps sorts its keys as well.
+	// Numbers are kept as they are, timestamps in nanoseconds don't fit a float64.
+	decoder := json.NewDecoder(bytes.NewReader(m.Msg))
+	decoder.UseNumber()
+	var msg any
+	if err := decoder.Decode(&msg); err != nil {
+		return nil, fmt.Errorf("contract message is not valid JSON: %w", err)
+	}
+
+	funds := make([]aminoCoin, len(m.Funds))
+	for i, coin := range m.Funds {
+		funds[i] = newAminoCoin(coin)
+	}
+
+	return aminoJSON(msgExecuteContractAminoName, aminoMsgExecuteContract{
+		Contract: m.Contract,
+		Funds:    funds,
+		Msg:      msg,
+		Sender:   m.Sender,
+	})
+}
+
+// TxBody returns the encoded cosmos.tx.v1beta1.TxBody with msgs and memo.
+// These are the body_bytes of a SIGN_MODE_DIRECT sign doc and of the signed TxRaw.
+func TxBody(memo string, msgs ...Msg) []byte {
+	var b []byte
+	for _, msg := range msgs {
+		// google.protobuf.Any
+		var anyMsg []byte
+		anyMsg = appendString(anyMsg, 1, msg.TypeURL())
+		anyMsg = appendBytes(anyMsg, 2, msg.Marshal())
+		b = appendMessage(b, 1, anyMsg)
+	}
+	b = appendString(b, 2, memo)
+	return b
+}
+
+func (c Coin) marshal() []byte {
+	var b []byte
+	b = appendString(b, 1, c.Denom)
+	b = appendString(b, 2, c.Amount)
+	return b
+}
+
+func (h Height) marshal() []byte {
+	var b []byte
+	b = appendUint64(b, 1, h.RevisionNumber)
+	b = appendUint64(b, 2, h.RevisionHeight)
+	return b
+}
+
+type aminoCoin struct {
+	Amount string `json:"amount"`
+	Denom  string `json:"denom"`
+}
+
+func newAminoCoin(c Coin) aminoCoin {
+	return aminoCoin{Amount: c.Amount, Denom: c.Denom}
+}
+
+// aminoUint64 encodes a uint64 as a string the way Amino JSON does, zero is left out
+func aminoUint64(v uint64) string {
+	if v == 0 {
+		return ""
+	}
+	return strconv.FormatUint(v, 10)
+}
+
+func aminoJSON(name string, value any) ([]byte, error) {
+	return json.Marshal(struct {
+		Type  string `json:"type"`
+		Value any    `json:"value"`
+	}{Type: name, Value: value})
+}
@@ -0,0 +1,252 @@
+package txbuilder_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/zeebo/assert"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	txbuilder "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/tx_builder"
+)
+
+// The messages are checked against the Go protobuf encoding of a copy of their definitions
+
+func field(name string, number int32, kind descriptorpb.FieldDescriptorProto_Type, typeName string, repeated bool) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
+	if repeated {
+		label = descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	}
+	f := &descriptorpb.FieldDescriptorProto{
+		Name:     proto.String(name),
+		JsonName: proto.String(name),
+		Number:   proto.Int32(number),
+		Type:     kind.Enum(),
+		Label:    label.Enum(),
+	}
+	if typeName != "" {
+		f.TypeName = proto.String(".txtest." + typeName)
+	}
+	return f
+}
+
+func messageTypes(t *testing.T) map[string]protoreflect.MessageType {
+	t.Helper()
+
+	const (
+		str     = descriptorpb.FieldDescriptorProto_TYPE_STRING
+		byt     = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+		u64     = descriptorpb.FieldDescriptorProto_TYPE_UINT64
+		message = descriptorpb.FieldDescriptorProto_TYPE_MESSAGE
+	)
+	messages := []*descriptorpb.DescriptorProto{
+		{Name: proto.String("Coin"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("denom", 1, str, "", false),
+			field("amount", 2, str, "", false),
+		}},
+		{Name: proto.String("Height"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("revision_number", 1, u64, "", false),
+			field("revision_height", 2, u64, "", false),
+		}},
+		{Name: proto.String("MsgTransfer"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("source_port", 1, str, "", false),
+			field("source_channel", 2, str, "", false),
+			field("token", 3, message, "Coin", false),
+			field("sender", 4, str, "", false),
+			field("receiver", 5, str, "", false),
+			field("timeout_height", 6, message, "Height", false),
+			field("timeout_timestamp", 7, u64, "", false),
+			field("memo", 8, str, "", false),
+		}},
+		{Name: proto.String("MsgExecuteContract"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("sender", 1, str, "", false),
+			field("contract", 2, str, "", false),
+			field("msg", 3, byt, "", false),
+			field("funds", 5, message, "Coin", true),
+		}},
+		{Name: proto.String("Any"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("type_url", 1, str, "", false),
+			field("value", 2, byt, "", false),
+		}},
+		{Name: proto.String("TxBody"), Field: []*descriptorpb.FieldDescriptorProto{
+			field("messages", 1, message, "Any", true),
+			field("memo", 2, str, "", false),
+		}},
+	}
+
+	file, err := protodesc.NewFile(&descriptorpb.FileDescriptorProto{
+		Name:        proto.String("txtest.proto"),
+		Package:     proto.String("txtest"),
+		Syntax:      proto.String("proto3"),
+		MessageType: messages,
+	}, nil)
+	assert.NoError(t, err)
+
+	types := map[string]protoreflect.MessageType{}
+	for i := 0; i < file.Messages().Len(); i++ {
+		desc := file.Messages().Get(i)
+		types[string(desc.Name())] = dynamicpb.NewMessageType(desc)
+	}
+	return types
+}
+
+// newMessage builds a dynamic message, string and message values are set by field name
+func newMessage(messageType protoreflect.MessageType, values map[string]any) protoreflect.Message {
+	msg := messageType.New()
+	fields := msg.Descriptor().Fields()
+	for name, value := range values {
+		fd := fields.ByName(protoreflect.Name(name))
+		switch v := value.(type) {
+		case []protoreflect.Message:
+			list := msg.Mutable(fd).List()
+			for _, item := range v {
+				list.Append(protoreflect.ValueOfMessage(item))
+			}
+		case protoreflect.Message:
+			msg.Set(fd, protoreflect.ValueOfMessage(v))
+		default:
+			msg.Set(fd, protoreflect.ValueOf(v))
+		}
+	}
+	return msg
+}
+
+func marshal(t *testing.T, msg protoreflect.Message) []byte {
+	t.Helper()
+
+	b, err := proto.MarshalOptions{Deterministic: true}.Marshal(msg.Interface())
+	assert.NoError(t, err)
+	return b
+}
+
+func TestMsgTransfer_Marshal(t *testing.T) {
+	types := messageTypes(t)
+	msg := &txbuilder.MsgTransfer{
+		SourcePort:       "transfer",
+		SourceChannel:    "channel-141",
+		Token:            txbuilder.Coin{Denom: "uatom", Amount: "1000000"},
+		Sender:           "cosmos1sender",
+		Receiver:         "osmo1receiver",
+		TimeoutTimestamp: 1760000000000000000,
+		Memo:             `{"forward":{"receiver":"juno1receiver","port":"transfer","channel":"channel-42"}}`,
+	}
+
+	expected := newMessage(types["MsgTransfer"], map[string]any{
+		"source_port":    "transfer",
+		"source_channel": "channel-141",
+		"token": newMessage(types["Coin"], map[string]any{
+			"denom":  "uatom",
+			"amount": "1000000",
+		}),
+		"sender":   "cosmos1sender",
+		"receiver": "osmo1receiver",
+		// The zero height is still encoded
+		"timeout_height":    newMessage(types["Height"], nil),
+		"timeout_timestamp": uint64(1760000000000000000),
+		"memo":              msg.Memo,
+	})
+	assert.Equal(t, msg.Marshal(), marshal(t, expected))
+	assert.Equal(t, msg.TypeURL(), "/ibc.applications.transfer.v1.MsgTransfer")
+}
+
+func TestMsgTransfer_AminoJSON(t *testing.T) {
+	msg := &txbuilder.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-141",
+		Token:         txbuilder.Coin{Denom: "uatom", Amount: "1000000"},
+		Sender:        "cosmos1sender",
+		Receiver:      "osmo1receiver",
+		TimeoutHeight: txbuilder.Height{RevisionNumber: 1, RevisionHeight: 25000000},
+	}
+
+	aminoJSON, err := msg.AminoJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(aminoJSON), `{"type":"cosmos-sdk/MsgTransfer","value":{`+
+		`"receiver":"osmo1receiver","sender":"cosmos1sender","source_channel":"channel-141","source_port":"transfer",`+
+		`"timeout_height":{"revision_height":"25000000","revision_number":"1"},"token":{"amount":"1000000","denom":"uatom"}}}`)
+
+	// Zero values are left out, the timeout height stays as an empty object
+	msg.TimeoutHeight = txbuilder.Height{}
+	msg.TimeoutTimestamp = 1760000000000000000
+	msg.Memo = `{"wasm":{}}`
+	aminoJSON, err = msg.AminoJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(aminoJSON), `{"type":"cosmos-sdk/MsgTransfer","value":{"memo":"{\"wasm\":{}}",`+
+		`"receiver":"osmo1receiver","sender":"cosmos1sender","source_channel":"channel-141","source_port":"transfer",`+
+		`"timeout_height":{},"timeout_timestamp":"1760000000000000000","token":{"amount":"1000000","denom":"uatom"}}}`)
+}
+
+func TestMsgExecuteContract_Marshal(t *testing.T) {
+	types := messageTypes(t)
+	msg := &txbuilder.MsgExecuteContract{
+		Sender:   "osmo1sender",
+		Contract: "osmo1contract",
+		Msg:      json.RawMessage(`{"swap_and_action":{}}`),
+		Funds: []txbuilder.Coin{
+			{Denom: "uosmo", Amount: "1000"},
+			{Denom: "uatom", Amount: "20"},
+		},
+	}
+
+	expected := newMessage(types["MsgExecuteContract"], map[string]any{
+		"sender":   "osmo1sender",
+		"contract": "osmo1contract",
+		"msg":      []byte(`{"swap_and_action":{}}`),
+		"funds": []protoreflect.Message{
+			newMessage(types["Coin"], map[string]any{"denom": "uosmo", "amount": "1000"}),
+			newMessage(types["Coin"], map[string]any{"denom": "uatom", "amount": "20"}),
+		},
+	})
+	assert.Equal(t, msg.Marshal(), marshal(t, expected))
+	assert.Equal(t, msg.TypeURL(), "/cosmwasm.wasm.v1.MsgExecuteContract")
+}
+
+func TestMsgExecuteContract_AminoJSON(t *testing.T) {
+	msg := &txbuilder.MsgExecuteContract{
+		Sender:   "osmo1sender",
+		Contract: "osmo1contract",
+		Msg:      json.RawMessage(`{"swap_and_action":{"timeout_timestamp":1760000000000000001,"affiliates":[],"min_asset":{"native":{"denom":"uatom","amount":"1"}}}}`),
+		Funds:    []txbuilder.Coin{{Denom: "uosmo", Amount: "1000"}},
+	}
+
+	// The contract message keys are sorted and the timestamp keeps its precision
+	aminoJSON, err := msg.AminoJSON()
+	assert.NoError(t, err)
+	assert.Equal(t, string(aminoJSON), `{"type":"wasm/MsgExecuteContract","value":{"contract":"osmo1contract",`+
+		`"funds":[{"amount":"1000","denom":"uosmo"}],`+
+		`"msg":{"swap_and_action":{"affiliates":[],"min_asset":{"native":{"amount":"1","denom":"uatom"}},"timeout_timestamp":1760000000000000001}},`+
+		`"sender":"osmo1sender"}}`)
+
+	msg.Msg = json.RawMessage(`{"swap_and_action":`)
+	_, err = msg.AminoJSON()
+	assert.Error(t, err)
+}
+
+func TestTxBody(t *testing.T) {
+	types := messageTypes(t)
+	transfer := &txbuilder.MsgTransfer{
+		SourcePort:    "transfer",
+		SourceChannel: "channel-0",
+		Token:         txbuilder.Coin{Denom: "uosmo", Amount: "1"},
+		Sender:        "osmo1sender",
+		Receiver:      "cosmos1receiver",
+	}
+	execute := &txbuilder.MsgExecuteContract{
+		Sender:   "osmo1sender",
+		Contract: "osmo1contract",
+		Msg:      json.RawMessage(`{}`),
+	}
+
+	expected := newMessage(types["TxBody"], map[string]any{
+		"messages": []protoreflect.Message{
+			newMessage(types["Any"], map[string]any{"type_url": transfer.TypeURL(), "value": transfer.Marshal()}),
+			newMessage(types["Any"], map[string]any{"type_url": execute.TypeURL(), "value": execute.Marshal()}),
+		},
+		"memo": "Spectra",
+	})
+	assert.Equal(t, txbuilder.TxBody("Spectra", transfer, execute), marshal(t, expected))
+}
@@ -32,12 +32,32 @@ type PathfinderChain struct {
 	HasPFM   bool // has packet forwarding middleware
 	Broker   bool
 	BrokerId string
+	// PFMFeePercentage is the fraction PFM keeps from forwarded transfers (e.g., "0.001"), empty if unknown
+	PFMFeePercentage string
 	// IBCHooksContract is the wasm contract address for swap operations (e.g., Osmosis entry point)
 	IBCHooksContract string
 	// Bech32Prefix for address conversion (e.g., "osmo", "cosmos")
 	Bech32Prefix string
-	NativeTokens []TokenInfo
-	Routes       []BasicRoute
+	// FeeCurrencies the chain accepts for transaction fees, the first one is used for fee estimates
+	FeeCurrencies []FeeCurrency
+	NativeTokens  []TokenInfo
+	Routes        []BasicRoute
+}
+
+// FeeCurrency is a token transaction fees can be paid with
+type FeeCurrency struct {
+	Denom    string
+	Symbol   string
+	Decimals int
+	// Gas prices in Denom per unit of gas
+	GasPriceStep GasPriceStep
+}
+
+// GasPriceStep contains the low, average and high gas prices of a fee currency
+type GasPriceStep struct {
+	Low     float64
+	Average float64
+	High    float64
 }
 
 // BasicRoute represents a route between two chains.
@@ -54,8 +74,12 @@ type BasicRoute struct {
 type TokenInfo struct {
 	// Denom on the current chain in the route context (native or IBC)
 	ChainDenom string
+	// ICS-20 trace path of ChainDenom (e.g., "transfer/channel-0"), empty for native tokens or if unknown
+	ChainPath string
 	// Denom on the destination chain (after IBC transfer)
 	IbcDenom string
+	// ICS-20 trace path of IbcDenom on the destination chain, empty if it arrives native or if unknown
+	IbcPath string
 	// Original native denom on the token's origin chain
 	BaseDenom string
 	// Chain ID where this token is native
@@ -64,6 +88,8 @@ type TokenInfo struct {
 	Symbol string
 	// Number of decimal places
 	Decimals int
+	// CoinGecko ID for price lookups, only set on native tokens
+	CoinGeckoID string
 }
 
 // RouteIndex with denom mapping should be internal logic for the router
@@ -76,6 +102,8 @@ type RouteIndex struct {
 	brokerChains        map[string]string                 // chainId -> brokerId (for chains that are brokers)
 	pfmChains           map[string]bool                   // chainId -> supports PFM
 	chainRoutes         map[string]map[string]*BasicRoute // chainId -> toChainId -> BasicRoute (all routes from a chain)
+	edgeCost            EdgeCostFunc                      // cost of a hop in the weighted path search
+	channelHealth       *ChannelHealth                    // observed transfer results per channel
 }
 
 // MultiHopInfo represents a route that goes through a broker with token swaps
@@ -116,6 +144,12 @@ type IndirectRouteInfo struct {
 	Path   []string      // Chain IDs in order
 	Routes []*BasicRoute // Routes between consecutive chains
 	Token  *TokenInfo    // Token that travels through all chains
+	// Tokens holds the token sent over each route, as it is on the chain it is sent from
+	Tokens []*TokenInfo
+	// DeliveredDenom is the denom the token arrives with on the destination
+	DeliveredDenom string
+	// DeliveredPath is the trace path of DeliveredDenom, empty if it arrives native or the config has no trace paths
+	DeliveredPath string
 }
 
 // MultiHopInboundResult contains the result of finding a multi-hop inbound route
@@ -0,0 +1,217 @@
+package router
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	models "github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
+	"github.com/shopspring/decimal"
+)
+
+// ErrPricesNotConfigured is returned by TokenPrices when the pathfinder has no price provider
+var ErrPricesNotConfigured = errors.New("USD prices are not configured")
+
+// usdDecimals is the number of decimal places USD values are rounded to
+const usdDecimals = 6
+
+// SetPriceProvider sets the provider routes are valued in USD with, nil turns the valuation off.
+// It must be called before the pathfinder serves requests.
+func (s *Pathfinder) SetPriceProvider(provider prices.Provider) {
+	s.priceProvider = provider
+}
+
+/*
+TokenPrices returns the USD prices of the given tokens in the same order.
+Denoms are resolved like the denoms of a route request, so they can be human-readable.
+A token without a price has the reason in its error, the other tokens are still priced.
+*/
+func (s *Pathfinder) TokenPrices(ctx context.Context, queries []models.TokenPriceQuery) ([]models.TokenPrice, error) {
+	s = s.current()
+	if s.priceProvider == nil {
+		return nil, ErrPricesNotConfigured
+	}
+
+	v := s.newValuer()
+	tokenPrices := make([]models.TokenPrice, len(queries))
+	for i, query := range queries {
+		tokenPrice := models.TokenPrice{ChainID: query.ChainID, Denom: query.Denom}
+
+		denom, err := s.denomResolver.ResolveToChainDenom(query.ChainID, query.Denom)
+		if err != nil {
+			tokenPrice.Error = err.Error()
+			tokenPrices[i] = tokenPrice
+			continue
+		}
+		tokenPrice.Denom = denom
+
+		price, tokenInfo, err := v.price(ctx, query.ChainID, denom)
+		if tokenInfo != nil {
+			tokenPrice.Symbol = tokenInfo.Symbol
+			tokenPrice.Decimals = tokenInfo.Decimals
+		}
+		if err != nil {
+			tokenPrice.Error = err.Error()
+		} else {
+			tokenPrice.PriceUSD = price.USD.String()
+			tokenPrice.Source = price.Source
+		}
+		tokenPrices[i] = tokenPrice
+	}
+	return tokenPrices, nil
+}
+
+// valuer values the routes of a single request, every token is priced once
+type valuer struct {
+	s      *Pathfinder
+	prices map[string]pricedToken // chainId/denom -> price
+}
+
+type pricedToken struct {
+	price     prices.Price
+	tokenInfo *TokenInfo
+	err       error
+}
+
+// newValuer returns a valuer for the pathfinder, nil if no price provider is set
+func (s *Pathfinder) newValuer() *valuer {
+	if s.priceProvider == nil {
+		return nil
+	}
+	return &valuer{s: s, prices: make(map[string]pricedToken)}
+}
+
+// attachValuation sets the USD values of a successful route on it, amounts that can't be priced are noted
+func (v *valuer) attachValuation(ctx context.Context, req models.RouteRequest, response *models.RouteResponse) {
+	if v == nil || !response.Success {
+		return
+	}
+
+	valuation := &models.RouteValuation{}
+	amountIn, amountOut := routeAmounts(req, *response)
+
+	inUSD, inErr := v.usdValue(ctx, req.ChainFrom, req.TokenFromDenom, amountIn)
+	if inErr == nil {
+		valuation.AmountInUSD = inUSD.Round(usdDecimals).String()
+	} else {
+		valuation.Notes = append(valuation.Notes, valuationNote(req.ChainFrom, req.TokenFromDenom, inErr))
+	}
+
+	outUSD, outErr := v.usdValue(ctx, req.ChainTo, req.TokenToDenom, amountOut)
+	if outErr == nil {
+		valuation.AmountOutUSD = outUSD.Round(usdDecimals).String()
+	} else {
+		valuation.Notes = append(valuation.Notes, valuationNote(req.ChainTo, req.TokenToDenom, outErr))
+	}
+
+	feesUSD := decimal.Zero
+	if response.Fees != nil {
+		for _, fee := range response.Fees.Total {
+			if fee.Denom == "" {
+				continue
+			}
+			feeUSD, err := v.usdValue(ctx, fee.ChainID, fee.Denom, fee.Amount)
+			if err != nil {
+				valuation.Notes = append(valuation.Notes, valuationNote(fee.ChainID, fee.Denom, err))
+				continue
+			}
+			feesUSD = feesUSD.Add(feeUSD)
+		}
+		valuation.FeesUSD = feesUSD.Round(usdDecimals).String()
+	}
+
+	// The loss compares what the sender gives up, including the fees, with what the receiver gets
+	if inErr == nil && outErr == nil {
+		spent := inUSD.Add(feesUSD)
+		if spent.IsPositive() {
+			loss := spent.Sub(outUSD).Div(spent).Mul(decimal.NewFromInt(100))
+			valuation.ValueLossPercent = loss.Round(2).String()
+		}
+	}
+
+	response.Valuation = valuation
+}
+
+// usdValue returns the USD value of amount of the token denom on chainId
+func (v *valuer) usdValue(ctx context.Context, chainId, denom, amount string) (decimal.Decimal, error) {
+	price, tokenInfo, err := v.price(ctx, chainId, denom)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	value, err := decimal.NewFromString(amount)
+	if err != nil {
+		return decimal.Zero, fmt.Errorf("invalid amount %q", amount)
+	}
+	return value.Shift(-int32(tokenInfo.Decimals)).Mul(price.USD), nil
+}
+
+// valuationNote explains why an amount of the token denom on chainId has no USD value
+func valuationNote(chainId, denom string, err error) string {
+	return fmt.Sprintf("No USD value for %s on %s: %v", denom, chainId, err)
+}
+
+// price returns the price of the token denom on chainId together with its token info.
+// The token info is returned whenever the token is known, even if it has no price.
+func (v *valuer) price(ctx context.Context, chainId, denom string) (prices.Price, *TokenInfo, error) {
+	key := chainId + "/" + denom
+	if priced, ok := v.prices[key]; ok {
+		return priced.price, priced.tokenInfo, priced.err
+	}
+
+	var priced pricedToken
+	token, tokenInfo, err := v.s.priceToken(chainId, denom)
+	priced.tokenInfo = tokenInfo
+	if err != nil {
+		priced.err = err
+	} else {
+		priced.price, priced.err = v.s.priceProvider.Price(ctx, token)
+	}
+	v.prices[key] = priced
+	return priced.price, priced.tokenInfo, priced.err
+}
+
+// priceToken builds the token the price providers look up for the token denom on chainId.
+// The CoinGecko id comes from the native token on the origin chain.
+func (s *Pathfinder) priceToken(chainId, denom string) (prices.Token, *TokenInfo, error) {
+	tokenInfo, ok := s.routeIndex.denomToTokenInfo[chainId][denom]
+	if !ok {
+		return prices.Token{}, nil, fmt.Errorf("token %s is not known on %s", denom, chainId)
+	}
+
+	token := prices.Token{
+		BaseDenom:   tokenInfo.BaseDenom,
+		OriginChain: tokenInfo.OriginChain,
+		Symbol:      tokenInfo.Symbol,
+		Denoms:      map[string]string{},
+	}
+	chainDenoms, _ := s.denomResolver.GetTokenDenomsAcrossChains(tokenInfo.BaseDenom, tokenInfo.OriginChain, "")
+	for _, chainDenom := range chainDenoms {
+		token.Denoms[chainDenom.ChainID] = chainDenom.Denom
+	}
+	for _, nativeToken := range s.chainsMap[tokenInfo.OriginChain].NativeTokens {
+		if nativeToken.ChainDenom == tokenInfo.BaseDenom {
+			token.CoinGeckoID = nativeToken.CoinGeckoID
+			break
+		}
+	}
+	return token, tokenInfo, nil
+}
+
+// routeAmounts returns the amount the sender spends and the amount the receiver gets on a route
+func routeAmounts(req models.RouteRequest, route models.RouteResponse) (string, string) {
+	amountOut, _, _ := routeMetrics(req, route)
+
+	amountIn := req.AmountIn
+	switch {
+	case route.Indirect != nil && len(route.Indirect.Legs) > 0 && route.Indirect.Legs[0] != nil:
+		amountIn = route.Indirect.Legs[0].Amount
+	case route.BrokerSwap != nil:
+		if legs := route.BrokerSwap.InboundLegs; len(legs) > 0 && legs[0] != nil {
+			amountIn = legs[0].Amount
+		} else if route.BrokerSwap.Swap != nil {
+			amountIn = route.BrokerSwap.Swap.AmountIn
+		}
+	}
+	return amountIn, amountOut
+}
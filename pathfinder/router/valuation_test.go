@@ -0,0 +1,140 @@
+package router_test
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/zeebo/assert"
+
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/prices"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+)
+
+// mockPriceProvider prices tokens by their base denom and records the tokens it was asked for
+type mockPriceProvider struct {
+	prices map[string]string
+
+	mu     sync.Mutex
+	tokens []prices.Token
+}
+
+func (m *mockPriceProvider) Name() string {
+	return "mock"
+}
+
+func (m *mockPriceProvider) Price(ctx context.Context, token prices.Token) (prices.Price, error) {
+	m.mu.Lock()
+	m.tokens = append(m.tokens, token)
+	m.mu.Unlock()
+
+	price, ok := m.prices[token.BaseDenom]
+	if !ok {
+		return prices.Price{}, fmt.Errorf("%w %s", prices.ErrNoPrice, token.Key())
+	}
+	return prices.Price{USD: decimal.RequireFromString(price), Source: "mock"}, nil
+}
+
+func TestPathfinder_RouteValuation(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+	provider := &mockPriceProvider{prices: map[string]string{"uatom": "5", "uosmo": "0.5"}}
+	pathfinder.SetPriceProvider(provider)
+
+	response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.True(t, response.Success)
+
+	// 1 ATOM is sent and received, the 3750 uatom fee is the only loss
+	assert.DeepEqual(t, response.Valuation, &models.RouteValuation{
+		AmountInUSD:      "5",
+		AmountOutUSD:     "5",
+		FeesUSD:          "0.01875",
+		ValueLossPercent: "0.37",
+	})
+
+	// Every token is priced once per request
+	assert.Equal(t, len(provider.tokens), 2)
+	assert.Equal(t, provider.tokens[0].Key(), "uatom@cosmoshub-4")
+	assert.Equal(t, provider.tokens[0].Denoms["osmosis-1"], "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2")
+
+	ranked := pathfinder.FindPaths(t.Context(), hubToOsmosis)
+	assert.True(t, ranked.Success)
+	assert.Equal(t, ranked.Routes[0].Route.Valuation.AmountOutUSD, "5")
+}
+
+func TestPathfinder_RouteValuationWithoutPrice(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+	pathfinder.SetPriceProvider(&mockPriceProvider{prices: map[string]string{"uatom": "5"}})
+
+	response := pathfinder.FindPath(t.Context(), models.RouteRequest{
+		ChainFrom:       "osmosis-1",
+		ChainTo:         "cosmoshub-4",
+		TokenFromDenom:  "uosmo",
+		TokenToDenom:    "ibc/ED07A3391A112B175915CD8FAF43A2DA8E4790EDE12566649D0C2F97716B8518",
+		AmountIn:        "1000000",
+		SenderAddress:   addressOn(t, "osmo"),
+		ReceiverAddress: addressOn(t, "cosmos"),
+	})
+	assert.True(t, response.Success)
+
+	// Without a price for OSMO neither the amounts nor the loss can be valued
+	valuation := response.Valuation
+	assert.Equal(t, valuation.AmountInUSD, "")
+	assert.Equal(t, valuation.AmountOutUSD, "")
+	assert.Equal(t, valuation.ValueLossPercent, "")
+	assert.Equal(t, len(valuation.Notes), 3)
+	assert.Equal(t, valuation.Notes[0], "No USD value for uosmo on osmosis-1: no price for the token uosmo@osmosis-1")
+}
+
+func TestPathfinder_RouteValuationDisabled(t *testing.T) {
+	pathfinder := setupFeePathfinder(t)
+
+	response := pathfinder.FindPath(t.Context(), hubToOsmosis)
+	assert.True(t, response.Success)
+	assert.Nil(t, response.Valuation)
+
+	_, err := pathfinder.TokenPrices(t.Context(), []models.TokenPriceQuery{{ChainID: "cosmoshub-4", Denom: "uatom"}})
+	assert.True(t, errors.Is(err, router.ErrPricesNotConfigured))
+}
+
+func TestPathfinder_TokenPrices(t *testing.T) {
+	// The CoinGecko id is taken from the native token on the origin chain
+	priceChains := make([]router.PathfinderChain, len(chains))
+	copy(priceChains, chains)
+	for i, chain := range priceChains {
+		if chain.Id == "cosmoshub-4" {
+			priceChains[i].NativeTokens = []router.TokenInfo{
+				{ChainDenom: "uatom", BaseDenom: "uatom", OriginChain: "cosmoshub-4", Symbol: "ATOM", Decimals: 6, CoinGeckoID: "cosmos"},
+			}
+		}
+	}
+	pathfinder := router.NewPathfinder(priceChains, buildIndex(t, priceChains), nil)
+	provider := &mockPriceProvider{prices: map[string]string{"uatom": "4.52"}}
+	pathfinder.SetPriceProvider(provider)
+
+	tokenPrices, err := pathfinder.TokenPrices(t.Context(), []models.TokenPriceQuery{
+		{ChainID: "osmosis-1", Denom: "uatom"},
+		{ChainID: "juno-1", Denom: "ujuno"},
+		{ChainID: "juno-1", Denom: "unknown"},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, len(tokenPrices), 3)
+
+	// Human-readable denoms are resolved
+	assert.DeepEqual(t, tokenPrices[0], models.TokenPrice{
+		ChainID:  "osmosis-1",
+		Denom:    "ibc/27394FB092D2ECCD56123C74F36E4C1F926001CEADA9CA97EA622B25F41E5EB2",
+		Decimals: 6,
+		PriceUSD: "4.52",
+		Source:   "mock",
+	})
+	assert.Equal(t, provider.tokens[0].CoinGeckoID, "cosmos")
+
+	assert.Equal(t, tokenPrices[1].PriceUSD, "")
+	assert.Equal(t, tokenPrices[1].Error, "no price for the token ujuno@juno-1")
+	assert.Equal(t, tokenPrices[2].Denom, "unknown")
+	assert.True(t, tokenPrices[2].Error != "")
+}
@@ -2,9 +2,12 @@ package rpc
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"time"
 
 	"connectrpc.com/connect"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/amount"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
 	v1 "github.com/Cogwheel-Validator/spectra-portal/pathfinder/rpc/v1"
@@ -12,20 +15,19 @@ import (
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
-// PathfinderServer implements the ConnectRPC PathfinderServiceHandler interface
+// PathfinderServer implements the ConnectRPC PathfinderServiceHandler interface.
+// Denoms are resolved with the denom resolver of the pathfinder, so chain config reloads apply to both.
 type PathfinderServer struct {
-	pathfinder    *router.Pathfinder
-	denomResolver *router.DenomResolver
+	pathfinder *router.Pathfinder
 }
 
 // Verify that PathfinderServer implements the interface
 var _ v1connect.PathfinderServiceHandler = (*PathfinderServer)(nil)
 
 // NewPathfinderServer creates a new PathfinderServer
-func NewPathfinderServer(pathfinder *router.Pathfinder, denomResolver *router.DenomResolver) *PathfinderServer {
+func NewPathfinderServer(pathfinder *router.Pathfinder) *PathfinderServer {
 	return &PathfinderServer{
-		pathfinder:    pathfinder,
-		denomResolver: denomResolver,
+		pathfinder: pathfinder,
 	}
 }
 
@@ -48,75 +50,332 @@ func (s *PathfinderServer) FindPath(
 		req.Msg,
 	)
 
+	// Validation, denom resolution and routing all use the same chain config, even if it is reloaded meanwhile
+	pathfinder := s.pathfinder.Snapshot()
+
 	// Step 0: Validate input parameters (returns 400 for validation errors)
-	if err := s.validateFindPathRequest(req.Msg); err != nil {
+	if err := validateFindPathRequest(pathfinder, req.Msg); err != nil {
 		return nil, err
 	}
 
-	// Step 1: Resolve token_from_denom (could be human-readable)
-	resolvedFromDenom, err := s.denomResolver.ResolveToChainDenom(req.Msg.ChainFrom, req.Msg.TokenFromDenom)
+	// Step 1: Resolve denoms and build the internal request
+	internalReq, err := resolveRouteRequest(pathfinder, req.Msg)
 	if err != nil {
-		return nil, connect.NewError(connect.CodeInvalidArgument,
+		return nil, err
+	}
+
+	// Step 2: Call pathfinder with resolved denoms, broker queries stop if the client goes away
+	internalResp := pathfinder.FindPath(ctx, internalReq)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	// Step 3: Convert to proto response
+	// Note: "No route found" returns 200 with success=false (valid query, valid answer)
+	protoResp := convertToProtoResponse(&internalResp)
+
+	return connect.NewResponse(protoResp), nil
+}
+
+// FindPaths implements the ConnectRPC handler for ranked route discovery.
+// It accepts the same request as FindPath, but instead of returning the first route found
+// it evaluates every viable route and returns them ordered by score.
+//
+// Returns:
+// - 400 Bad Request: Invalid input (bad address format, unknown chain, etc.)
+// - 200 OK with success=false: Valid query but no route exists
+// - 200 OK with success=true: At least one route found
+func (s *PathfinderServer) FindPaths(
+	ctx context.Context,
+	req *connect.Request[v1.FindPathRequest],
+) (*connect.Response[v1.FindPathsResponse], error) {
+
+	Logger.Info().Msgf(
+		"Request data for find paths; %+v",
+		req.Msg,
+	)
+
+	pathfinder := s.pathfinder.Snapshot()
+	if err := validateFindPathRequest(pathfinder, req.Msg); err != nil {
+		return nil, err
+	}
+
+	internalReq, err := resolveRouteRequest(pathfinder, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	internalResp := pathfinder.FindPaths(ctx, internalReq)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(convertToProtoRankedRoutesResponse(&internalResp)), nil
+}
+
+// BatchFindPath implements the ConnectRPC handler for finding the routes of several requests at once.
+// Every request is validated and resolved the same as in FindPath, the valid ones are routed concurrently
+// and share their broker quotes.
+//
+// Returns:
+// - 400 Bad Request: The batch is empty, too large or a request breaks the proto validation rules
+// - 200 OK: A result per request, invalid requests (unknown chain, denom, bad address, ...) have an error set
+func (s *PathfinderServer) BatchFindPath(
+	ctx context.Context,
+	req *connect.Request[v1.BatchFindPathRequest],
+) (*connect.Response[v1.BatchFindPathResponse], error) {
+
+	Logger.Info().Msgf(
+		"Request data for batch find path; %d requests",
+		len(req.Msg.Requests),
+	)
+
+	pathfinder := s.pathfinder.Snapshot()
+	results := make([]*v1.BatchFindPathResult, len(req.Msg.Requests))
+	internalReqs := make([]models.RouteRequest, 0, len(req.Msg.Requests))
+	routed := make([]int, 0, len(req.Msg.Requests))
+	for i, findPathReq := range req.Msg.Requests {
+		results[i] = &v1.BatchFindPathResult{}
+		if err := validateFindPathRequest(pathfinder, findPathReq); err != nil {
+			results[i].Error = connectErrorMessage(err)
+			continue
+		}
+		internalReq, err := resolveRouteRequest(pathfinder, findPathReq)
+		if err != nil {
+			results[i].Error = connectErrorMessage(err)
+			continue
+		}
+		internalReqs = append(internalReqs, internalReq)
+		routed = append(routed, i)
+	}
+
+	internalResps := pathfinder.BatchFindPath(ctx, internalReqs, router.DefaultBatchWorkers)
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	for j, i := range routed {
+		results[i].Route = convertToProtoResponse(&internalResps[j])
+	}
+
+	return connect.NewResponse(&v1.BatchFindPathResponse{Results: results}), nil
+}
+
+// PlanConsolidation implements the ConnectRPC handler for planning the consolidation of balances.
+// Denoms of the balances and the target token can be human-readable, balances whose denom can't be
+// resolved are reported in their route the same as balances without a route.
+//
+// Returns:
+// - 400 Bad Request: Unknown destination chain or token, invalid addresses or amounts
+// - 200 OK: A route per balance and the transactions that execute them
+func (s *PathfinderServer) PlanConsolidation(
+	ctx context.Context,
+	req *connect.Request[v1.PlanConsolidationRequest],
+) (*connect.Response[v1.PlanConsolidationResponse], error) {
+
+	Logger.Info().Msgf(
+		"Request data for plan consolidation; %d balances to %s %s",
+		len(req.Msg.Balances), req.Msg.ChainTo, req.Msg.TokenToDenom,
+	)
+
+	pathfinder := s.pathfinder.Snapshot()
+	internalReq, err := resolveConsolidationRequest(pathfinder, req.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	plan, err := pathfinder.PlanConsolidation(ctx, internalReq)
+	if ctxErr := ctx.Err(); ctxErr != nil {
+		return nil, ctxErr
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+
+	return connect.NewResponse(convertToProtoConsolidationPlan(plan)), nil
+}
+
+// resolveConsolidationRequest validates the addresses and amounts of the request and resolves its denoms
+func resolveConsolidationRequest(pathfinder *router.Pathfinder, req *v1.PlanConsolidationRequest) (models.ConsolidationRequest, error) {
+	destChain, err := pathfinder.GetChainInfo(req.ChainTo)
+	if err != nil {
+		return models.ConsolidationRequest{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("unknown destination chain: %s", req.ChainTo))
+	}
+
+	// The sender can be on any chain, the senders on the source chains are derived from it
+	if _, err := validateBech32Address(req.SenderAddress); err != nil {
+		return models.ConsolidationRequest{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid sender address '%s': %w", req.SenderAddress, err))
+	}
+	receiverPrefix, err := validateBech32Address(req.ReceiverAddress)
+	if err != nil {
+		return models.ConsolidationRequest{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid receiver address '%s': %w", req.ReceiverAddress, err))
+	}
+	if destChain.Bech32Prefix != "" && receiverPrefix != destChain.Bech32Prefix {
+		return models.ConsolidationRequest{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("receiver address prefix '%s' does not match destination chain '%s' (expected prefix: %s)",
+				receiverPrefix, req.ChainTo, destChain.Bech32Prefix))
+	}
+
+	denomResolver := pathfinder.DenomResolver()
+	resolvedToDenom, err := denomResolver.ResolveToChainDenom(req.ChainTo, req.TokenToDenom)
+	if err != nil {
+		return models.ConsolidationRequest{}, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("could not resolve destination token '%s' on chain '%s': %w",
+				req.TokenToDenom, req.ChainTo, err))
+	}
+
+	balances := make([]models.Balance, len(req.Balances))
+	for i, balance := range req.Balances {
+		if err := validateAmount(fmt.Sprintf("amount of balance %d", i), balance.Amount); err != nil {
+			return models.ConsolidationRequest{}, err
+		}
+
+		// Unresolved denoms are kept, the planner reports them as unavailable on their chain
+		denom := balance.Denom
+		if resolved, err := denomResolver.ResolveToChainDenom(balance.ChainId, balance.Denom); err == nil {
+			denom = resolved
+		}
+		balances[i] = models.Balance{ChainID: balance.ChainId, Denom: denom, Amount: balance.Amount}
+	}
+
+	return models.ConsolidationRequest{
+		Balances:        balances,
+		ChainTo:         req.ChainTo,
+		TokenToDenom:    resolvedToDenom,
+		SenderAddress:   req.SenderAddress,
+		ReceiverAddress: req.ReceiverAddress,
+		SlippageBps:     &req.SlippageBps,
+	}, nil
+}
+
+// connectErrorMessage returns the message of err without the code prefix of connect errors
+func connectErrorMessage(err error) string {
+	var connectErr *connect.Error
+	if errors.As(err, &connectErr) {
+		return connectErr.Message()
+	}
+	return err.Error()
+}
+
+// watchQuoteMaxDuration is the longest a WatchQuote stream runs, clients open a new one after it ends
+const watchQuoteMaxDuration = 15 * time.Minute
+
+// WatchQuote implements the ConnectRPC handler for streaming route updates.
+// The route is validated and resolved the same as in FindPath, then the first route found is sent
+// and after that every quote that changed beyond the threshold of the request.
+//
+// Returns:
+// - 400 Bad Request: Invalid input (bad address format, unknown chain, etc.)
+// - Stream of routes, success=false when no route exists. The stream ends without an error
+// when the client cancels it or after watchQuoteMaxDuration
+func (s *PathfinderServer) WatchQuote(
+	ctx context.Context,
+	req *connect.Request[v1.WatchQuoteRequest],
+	stream *connect.ServerStream[v1.FindPathResponse],
+) error {
+
+	Logger.Info().Msgf(
+		"Request data for watch quote; %+v",
+		req.Msg,
+	)
+
+	// The first route is found with the chain config the request was resolved with, reloads after it are picked up
+	pathfinder := s.pathfinder.Snapshot()
+	if err := validateFindPathRequest(pathfinder, req.Msg.Route); err != nil {
+		return err
+	}
+
+	internalReq, err := resolveRouteRequest(pathfinder, req.Msg.Route)
+	if err != nil {
+		return err
+	}
+
+	watch := router.QuoteWatch{ChangeThresholdBps: req.Msg.ChangeThresholdBps}
+	if req.Msg.IntervalSeconds != nil {
+		watch.Interval = time.Duration(*req.Msg.IntervalSeconds) * time.Second
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, watchQuoteMaxDuration)
+	defer cancel()
+
+	err = pathfinder.WatchQuote(ctx, internalReq, watch, func(response models.RouteResponse) error {
+		return stream.Send(convertToProtoResponse(&response))
+	})
+	if errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded) {
+		return nil
+	}
+	return err
+}
+
+// resolveRouteRequest resolves the token denoms of a FindPathRequest and builds the internal request.
+// token_from_denom can be human-readable, token_to_denom can also be empty to infer the same token.
+// Returns a ConnectRPC error (which translates to HTTP 400) if a denom can't be resolved
+func resolveRouteRequest(pathfinder *router.Pathfinder, req *v1.FindPathRequest) (models.RouteRequest, error) {
+	denomResolver := pathfinder.DenomResolver()
+
+	// Resolve token_from_denom (could be human-readable)
+	resolvedFromDenom, err := denomResolver.ResolveToChainDenom(req.ChainFrom, req.TokenFromDenom)
+	if err != nil {
+		return models.RouteRequest{}, connect.NewError(connect.CodeInvalidArgument,
 			fmt.Errorf("could not resolve source token '%s' on chain '%s': %w",
-				req.Msg.TokenFromDenom, req.Msg.ChainFrom, err))
+				req.TokenFromDenom, req.ChainFrom, err))
 	}
 
-	// Step 2: Resolve token_to_denom (could be empty or human-readable)
+	// Resolve token_to_denom (could be empty or human-readable)
 	var resolvedToDenom string
-	if req.Msg.TokenToDenom == "" {
+	if req.TokenToDenom == "" {
 		// Empty → infer same token on destination chain
-		resolvedToDenom, err = s.denomResolver.InferTokenToDenom(
-			req.Msg.ChainFrom,
+		resolvedToDenom, err = denomResolver.InferTokenToDenom(
+			req.ChainFrom,
 			resolvedFromDenom,
-			req.Msg.ChainTo,
+			req.ChainTo,
 		)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument,
+			return models.RouteRequest{}, connect.NewError(connect.CodeInvalidArgument,
 				fmt.Errorf("could not infer destination token: %w", err))
 		}
 	} else {
 		// Resolve human-readable denom if needed
-		resolvedToDenom, err = s.denomResolver.ResolveToChainDenom(req.Msg.ChainTo, req.Msg.TokenToDenom)
+		resolvedToDenom, err = denomResolver.ResolveToChainDenom(req.ChainTo, req.TokenToDenom)
 		if err != nil {
-			return nil, connect.NewError(connect.CodeInvalidArgument,
+			return models.RouteRequest{}, connect.NewError(connect.CodeInvalidArgument,
 				fmt.Errorf("could not resolve destination token '%s' on chain '%s': %w",
-					req.Msg.TokenToDenom, req.Msg.ChainTo, err))
+					req.TokenToDenom, req.ChainTo, err))
 		}
 	}
 
-	// Step 3: Build internal request with resolved denoms
-	internalReq := models.RouteRequest{
-		ChainFrom:       req.Msg.ChainFrom,
-		TokenFromDenom:  resolvedFromDenom,
-		AmountIn:        req.Msg.AmountIn,
-		ChainTo:         req.Msg.ChainTo,
-		TokenToDenom:    resolvedToDenom,
-		SenderAddress:   req.Msg.SenderAddress,
-		ReceiverAddress: req.Msg.ReceiverAddress,
-		SmartRoute:      &req.Msg.SmartRoute,
-		SlippageBps:     &req.Msg.SlippageBps,
-	}
-
-	// Step 4: Call pathfinder with resolved denoms
-	internalResp := s.pathfinder.FindPath(internalReq)
-
-	// Step 5: Convert to proto response
-	// Note: "No route found" returns 200 with success=false (valid query, valid answer)
-	protoResp := convertToProtoResponse(&internalResp)
-
-	return connect.NewResponse(protoResp), nil
+	// Build internal request with resolved denoms
+	return models.RouteRequest{
+		ChainFrom:         req.ChainFrom,
+		TokenFromDenom:    resolvedFromDenom,
+		AmountIn:          req.AmountIn,
+		AmountOut:         req.AmountOut,
+		ChainTo:           req.ChainTo,
+		TokenToDenom:      resolvedToDenom,
+		SenderAddress:     req.SenderAddress,
+		ReceiverAddress:   req.ReceiverAddress,
+		SmartRoute:        &req.SmartRoute,
+		SlippageBps:       &req.SlippageBps,
+		MaxPriceImpact:    req.MaxPriceImpact,
+		MinLiquidityUSD:   req.MinLiquidityUsd,
+		MaxHops:           req.MaxHops,
+		IBCTimeoutSeconds: req.IbcTimeoutSeconds,
+		PFMRetries:        req.PfmRetries,
+	}, nil
 }
 
 // validateFindPathRequest validates the request parameters
 // Returns a ConnectRPC error (which translates to HTTP 400) for invalid input
-func (s *PathfinderServer) validateFindPathRequest(req *v1.FindPathRequest) error {
+func validateFindPathRequest(pathfinder *router.Pathfinder, req *v1.FindPathRequest) error {
 	// Validate chain IDs exist and get chain info for prefix validation
-	sourceChain, err := s.pathfinder.GetChainInfo(req.ChainFrom)
+	sourceChain, err := pathfinder.GetChainInfo(req.ChainFrom)
 	if err != nil {
 		return connect.NewError(connect.CodeInvalidArgument,
 			fmt.Errorf("unknown source chain: %s", req.ChainFrom))
 	}
-	destChain, err := s.pathfinder.GetChainInfo(req.ChainTo)
+	destChain, err := pathfinder.GetChainInfo(req.ChainTo)
 	if err != nil {
 		return connect.NewError(connect.CodeInvalidArgument,
 			fmt.Errorf("unknown destination chain: %s", req.ChainTo))
@@ -150,12 +409,30 @@ func (s *PathfinderServer) validateFindPathRequest(req *v1.FindPathRequest) erro
 				receiverPrefix, req.ChainTo, destChain.Bech32Prefix))
 	}
 
-	// Validate amount is positive
-	if req.AmountIn == "" || req.AmountIn == "0" {
+	// Validate exactly one of the amounts is set, amount_out asks for an exact output route
+	if req.AmountIn != "" && req.AmountOut != "" {
 		return connect.NewError(connect.CodeInvalidArgument,
-			fmt.Errorf("amount_in must be a positive number"))
+			fmt.Errorf("only one of amount_in and amount_out can be set"))
+	}
+	if req.AmountOut != "" {
+		return validateAmount("amount_out", req.AmountOut)
 	}
 
+	// Validate amount is positive
+	return validateAmount("amount_in", req.AmountIn)
+}
+
+// validateAmount checks that an amount of the request is a positive whole number of base units.
+// Amounts can have any size, so tokens with 18 decimals don't overflow.
+func validateAmount(field, value string) error {
+	parsed, err := amount.Parse(value)
+	if err != nil {
+		return connect.NewError(connect.CodeInvalidArgument, fmt.Errorf("invalid %s: %w", field, err))
+	}
+	if parsed.IsZero() {
+		return connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("%s must be a positive number", field))
+	}
 	return nil
 }
 
@@ -166,7 +443,8 @@ func (s *PathfinderServer) LookupDenom(
 	ctx context.Context,
 	req *connect.Request[v1.LookupDenomRequest],
 ) (*connect.Response[v1.LookupDenomResponse], error) {
-	denomInfo, err := s.denomResolver.ResolveDenom(req.Msg.ChainId, req.Msg.Denom)
+	denomResolver := s.pathfinder.DenomResolver()
+	denomInfo, err := denomResolver.ResolveDenom(req.Msg.ChainId, req.Msg.Denom)
 
 	Logger.Info().Msgf(
 		"Request data for lookup denom; %+v",
@@ -179,7 +457,7 @@ func (s *PathfinderServer) LookupDenom(
 	}
 
 	// Get where else this token is available
-	availableOn := s.denomResolver.GetAvailableOn(denomInfo.BaseDenom, denomInfo.OriginChain)
+	availableOn := denomResolver.GetAvailableOn(denomInfo.BaseDenom, denomInfo.OriginChain)
 	protoAvailableOn := make([]*v1.ChainDenom, len(availableOn))
 	for i, cd := range availableOn {
 		protoAvailableOn[i] = &v1.ChainDenom{
@@ -213,7 +491,7 @@ func (s *PathfinderServer) GetTokenDenoms(
 		req.Msg,
 	)
 
-	denoms, found := s.denomResolver.GetTokenDenomsAcrossChains(
+	denoms, found := s.pathfinder.DenomResolver().GetTokenDenomsAcrossChains(
 		req.Msg.BaseDenom,
 		req.Msg.OriginChain,
 		req.Msg.OnChainId, // Optional filter
@@ -257,7 +535,7 @@ func (s *PathfinderServer) GetChainTokens(
 		req.Msg,
 	)
 
-	tokens, err := s.denomResolver.GetChainTokens(req.Msg.ChainId)
+	tokens, err := s.pathfinder.DenomResolver().GetChainTokens(req.Msg.ChainId)
 	if err != nil {
 		return nil, connect.NewError(connect.CodeNotFound, err)
 	}
@@ -338,3 +616,98 @@ func (s *PathfinderServer) ListSupportedChains(
 		ChainIds: chains,
 	}), nil
 }
+
+// BuildTransaction implements the ConnectRPC handler for building the unsigned transactions of a route.
+// The route is the response of FindPath (or one of the FindPaths routes) passed back as it is.
+//
+// Returns:
+// - 400 Bad Request: Invalid input (bad address, a route that can't be executed, etc.)
+// - 200 OK: The transactions in the order they have to be broadcast
+func (s *PathfinderServer) BuildTransaction(
+	ctx context.Context,
+	req *connect.Request[v1.BuildTransactionRequest],
+) (*connect.Response[v1.BuildTransactionResponse], error) {
+
+	Logger.Info().Msgf(
+		"Request data for build transaction; %+v",
+		req.Msg,
+	)
+
+	if _, err := validateBech32Address(req.Msg.SenderAddress); err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument,
+			fmt.Errorf("invalid sender address '%s': %w", req.Msg.SenderAddress, err))
+	}
+	if req.Msg.ReceiverAddress != "" {
+		if _, err := validateBech32Address(req.Msg.ReceiverAddress); err != nil {
+			return nil, connect.NewError(connect.CodeInvalidArgument,
+				fmt.Errorf("invalid receiver address '%s': %w", req.Msg.ReceiverAddress, err))
+		}
+	}
+
+	internalReq := models.TransactionRequest{
+		Route:           convertFromProtoResponse(req.Msg.Route),
+		SenderAddress:   req.Msg.SenderAddress,
+		ReceiverAddress: req.Msg.ReceiverAddress,
+		Memo:            req.Msg.Memo,
+	}
+
+	// A relative timeout is turned into a timestamp here, so it counts from when the request was made
+	if timeout := req.Msg.Timeout; timeout != nil {
+		internalReq.TimeoutTimestamp = timeout.Timestamp
+		if internalReq.TimeoutTimestamp == 0 && timeout.Seconds > 0 {
+			internalReq.TimeoutTimestamp = uint64(time.Now().Add(time.Duration(timeout.Seconds) * time.Second).UnixNano())
+		}
+		if timeout.Height != nil {
+			internalReq.TimeoutHeight = &models.IBCHeight{
+				RevisionNumber: timeout.Height.RevisionNumber,
+				RevisionHeight: timeout.Height.RevisionHeight,
+			}
+		}
+	}
+
+	transactions, err := s.pathfinder.BuildTransactions(internalReq)
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInvalidArgument, err)
+	}
+
+	return connect.NewResponse(&v1.BuildTransactionResponse{
+		Transactions: convertToProtoUnsignedTransactions(transactions),
+	}), nil
+}
+
+// GetTokenPrices implements the ConnectRPC handler for USD token prices.
+// Accepts human-readable denoms (e.g., "uatone") or IBC denoms, like FindPath.
+//
+// Returns:
+// - 400 Failed Precondition: No price provider is configured
+// - 200 OK: A price per requested token, tokens without a price have an error set
+func (s *PathfinderServer) GetTokenPrices(
+	ctx context.Context,
+	req *connect.Request[v1.GetTokenPricesRequest],
+) (*connect.Response[v1.GetTokenPricesResponse], error) {
+
+	Logger.Info().Msgf(
+		"Request data for get token prices; %+v",
+		req.Msg,
+	)
+
+	queries := make([]models.TokenPriceQuery, len(req.Msg.Tokens))
+	for i, token := range req.Msg.Tokens {
+		queries[i] = models.TokenPriceQuery{ChainID: token.ChainId, Denom: token.Denom}
+	}
+
+	tokenPrices, err := s.pathfinder.TokenPrices(ctx, queries)
+	if errors.Is(err, router.ErrPricesNotConfigured) {
+		return nil, connect.NewError(connect.CodeFailedPrecondition, err)
+	}
+	if err != nil {
+		return nil, connect.NewError(connect.CodeInternal, err)
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&v1.GetTokenPricesResponse{
+		Prices: convertToProtoTokenPrices(tokenPrices),
+	}), nil
+}
@@ -8,6 +8,7 @@ import (
 
 	"buf.build/go/protovalidate"
 	"connectrpc.com/connect"
+	v1connect "github.com/Cogwheel-Validator/spectra-portal/pathfinder/rpc/v1/v1connect"
 	"github.com/go-chi/chi/v5/middleware"
 	"github.com/rs/cors"
 	"google.golang.org/protobuf/proto"
@@ -35,6 +36,52 @@ func zerologMiddleware(next http.Handler) http.Handler {
 	})
 }
 
+// streamingProcedures are the procedures that stream responses for longer than a request may take
+var streamingProcedures = map[string]bool{
+	v1connect.PathfinderServiceWatchQuoteProcedure: true,
+}
+
+// requestTimeoutMiddleware cancels requests that take longer than timeout.
+// Streams end on their own, they are not cancelled and their write deadline is cleared
+// so the WriteTimeout of the server doesn't cut them off either.
+func requestTimeoutMiddleware(timeout time.Duration) func(http.Handler) http.Handler {
+	withTimeout := middleware.Timeout(timeout)
+	return func(next http.Handler) http.Handler {
+		timed := withTimeout(next)
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if !streamingProcedures[r.URL.Path] {
+				timed.ServeHTTP(w, r)
+				return
+			}
+			if err := http.NewResponseController(w).SetWriteDeadline(time.Time{}); err != nil {
+				Logger.Warn().Err(err).Str("path", r.URL.Path).Msg("Failed to clear the write deadline of a stream")
+			}
+			next.ServeHTTP(w, r)
+		})
+	}
+}
+
+// throttleMiddleware limits the requests served at once, 0 leaves them unlimited.
+// Streams stay open for minutes, they get their own limit so open streams don't take the slots of other requests.
+func throttleMiddleware(maxRequests, maxStreams int) func(http.Handler) http.Handler {
+	return func(next http.Handler) http.Handler {
+		requests, streams := next, next
+		if maxRequests > 0 {
+			requests = middleware.Throttle(maxRequests)(next)
+		}
+		if maxStreams > 0 {
+			streams = middleware.Throttle(maxStreams)(next)
+		}
+		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			if streamingProcedures[r.URL.Path] {
+				streams.ServeHTTP(w, r)
+				return
+			}
+			requests.ServeHTTP(w, r)
+		})
+	}
+}
+
 // realIPMiddleware sets the remote address to the real IP address of the client
 // This is useful for logging and rate limiting
 func realIPMiddleware(next http.Handler) http.Handler {
@@ -181,22 +228,60 @@ func noCacheInterceptor() connect.UnaryInterceptorFunc {
 // validationInterceptor validates incoming requests using protovalidate.
 // It checks all validation rules defined in the proto files (required fields,
 // string length, numeric ranges, etc.) and returns InvalidArgument if validation fails.
-func validationInterceptor(validator protovalidate.Validator) connect.UnaryInterceptorFunc {
-	return func(next connect.UnaryFunc) connect.UnaryFunc {
-		return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
-			// Validate the request message if it implements the proto.Message interface
-			if msgAny := req.Any(); msgAny != nil {
-				if msg, ok := msgAny.(proto.Message); ok {
-					if err := validator.Validate(msg); err != nil {
-						Logger.Debug().
-							Str("procedure", req.Spec().Procedure).
-							Err(err).
-							Msg("Request validation failed")
-						return nil, connect.NewError(connect.CodeInvalidArgument, err)
-					}
-				}
-			}
-			return next(ctx, req)
+// Requests of streams are validated when the handler receives them.
+func validationInterceptor(validator protovalidate.Validator) connect.Interceptor {
+	return &validatingInterceptor{validator: validator}
+}
+
+// validatingInterceptor is the connect.Interceptor returned by validationInterceptor
+type validatingInterceptor struct {
+	validator protovalidate.Validator
+}
+
+func (i *validatingInterceptor) WrapUnary(next connect.UnaryFunc) connect.UnaryFunc {
+	return func(ctx context.Context, req connect.AnyRequest) (connect.AnyResponse, error) {
+		if err := i.validate(req.Spec().Procedure, req.Any()); err != nil {
+			return nil, err
 		}
+		return next(ctx, req)
+	}
+}
+
+func (i *validatingInterceptor) WrapStreamingClient(next connect.StreamingClientFunc) connect.StreamingClientFunc {
+	return next
+}
+
+func (i *validatingInterceptor) WrapStreamingHandler(next connect.StreamingHandlerFunc) connect.StreamingHandlerFunc {
+	return func(ctx context.Context, conn connect.StreamingHandlerConn) error {
+		return next(ctx, &validatingConn{StreamingHandlerConn: conn, interceptor: i})
+	}
+}
+
+// validate validates the request message if it implements the proto.Message interface
+func (i *validatingInterceptor) validate(procedure string, msgAny any) error {
+	msg, ok := msgAny.(proto.Message)
+	if !ok || msg == nil {
+		return nil
+	}
+	if err := i.validator.Validate(msg); err != nil {
+		Logger.Debug().
+			Str("procedure", procedure).
+			Err(err).
+			Msg("Request validation failed")
+		return connect.NewError(connect.CodeInvalidArgument, err)
+	}
+	return nil
+}
+
+// validatingConn validates every message a stream handler receives
+type validatingConn struct {
+	connect.StreamingHandlerConn
+	interceptor *validatingInterceptor
+}
+
+func (c *validatingConn) Receive(msg any) error {
+	if err := c.StreamingHandlerConn.Receive(msg); err != nil {
+		return err
 	}
+	return c.interceptor.validate(c.Spec().Procedure, msg)
 }
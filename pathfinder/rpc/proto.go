@@ -6,6 +6,7 @@ import (
 
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/models"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router"
+	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/astroport"
 	"github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/brokers/osmosis"
 	ibcmemo "github.com/Cogwheel-Validator/spectra-portal/pathfinder/router/ibc_memo"
 	v1 "github.com/Cogwheel-Validator/spectra-portal/pathfinder/rpc/v1"
@@ -32,6 +33,10 @@ func convertToProtoResponse(resp *models.RouteResponse) *v1.FindPathResponse {
 	protoResp := &v1.FindPathResponse{
 		Success:      resp.Success,
 		ErrorMessage: resp.ErrorMessage,
+		Fees:         convertToProtoRouteFees(resp.Fees),
+		Valuation:    convertToProtoRouteValuation(resp.Valuation),
+		ErrorCode:    resp.ErrorCode,
+		Warnings:     convertToProtoRouteWarnings(resp.Warnings),
 	}
 
 	// Convert Direct route if present (using protobuf oneof)
@@ -58,6 +63,43 @@ func convertToProtoResponse(resp *models.RouteResponse) *v1.FindPathResponse {
 	return protoResp
 }
 
+/*
+Converts internal models.RankedRoutesResponse to v1.FindPathsResponse
+Each ranked route wraps a regular FindPathResponse together with its ranking metrics.
+
+Parameters:
+- resp: *models.RankedRoutesResponse
+
+Returns:
+- *v1.FindPathsResponse
+
+Errors:
+- None
+*/
+func convertToProtoRankedRoutesResponse(resp *models.RankedRoutesResponse) *v1.FindPathsResponse {
+	protoResp := &v1.FindPathsResponse{
+		Success:      resp.Success,
+		ErrorMessage: resp.ErrorMessage,
+		Routes:       make([]*v1.RankedRoute, 0, len(resp.Routes)),
+	}
+
+	for i := range resp.Routes {
+		ranked := &resp.Routes[i]
+		protoResp.Routes = append(protoResp.Routes, &v1.RankedRoute{
+			Route:          convertToProtoResponse(&ranked.Route),
+			Rank:           uint32(ranked.Rank),
+			Score:          ranked.Score,
+			ExpectedOutput: ranked.ExpectedOutput,
+			HopCount:       uint32(ranked.HopCount),
+			EffectiveFee:   ranked.EffectiveFee,
+			IsBestPrice:    ranked.IsBestPrice,
+			IsFastest:      ranked.IsFastest,
+		})
+	}
+
+	return protoResp
+}
+
 /*
 Converts internal models.DirectRoute to v1.DirectRoute
 
@@ -131,12 +173,19 @@ func convertToProtoBrokerSwapRoute(brokerSwap *models.BrokerRoute) *v1.BrokerSwa
 		OutboundSupportsPfm: brokerSwap.OutboundSupportsPFM,
 	}
 
+	for _, quote := range brokerSwap.AlternativeQuotes {
+		result.AlternativeQuotes = append(result.AlternativeQuotes, convertToProtoSwapQuote(quote))
+	}
+
 	// Add execution data if available
 	if brokerSwap.Execution != nil {
 		execData := &v1.BrokerExecutionData{
-			MinOutputAmount: brokerSwap.Execution.MinOutputAmount,
-			UsesWasm:        brokerSwap.Execution.UsesWasm,
-			Description:     brokerSwap.Execution.Description,
+			MinOutputAmount:   brokerSwap.Execution.MinOutputAmount,
+			MinReceivedAmount: brokerSwap.Execution.MinReceivedAmount,
+			MaxInputAmount:    brokerSwap.Execution.MaxInputAmount,
+			UsesWasm:          brokerSwap.Execution.UsesWasm,
+			Description:       brokerSwap.Execution.Description,
+			Fee:               convertToProtoFeeEstimate(brokerSwap.Execution.Fee),
 		}
 		if brokerSwap.Execution.Memo != nil {
 			execData.Memo = brokerSwap.Execution.Memo
@@ -156,6 +205,137 @@ func convertToProtoBrokerSwapRoute(brokerSwap *models.BrokerRoute) *v1.BrokerSwa
 	return result
 }
 
+/*
+Converts internal models.RouteFees to v1.RouteFees
+
+Parameters:
+- fees: *models.RouteFees
+
+Returns:
+- *v1.RouteFees, nil if the route has no fee estimate
+
+Errors:
+- None
+*/
+func convertToProtoRouteFees(fees *models.RouteFees) *v1.RouteFees {
+	if fees == nil {
+		return nil
+	}
+	protoFees := &v1.RouteFees{
+		Total: make([]*v1.FeeEstimate, len(fees.Total)),
+		Notes: fees.Notes,
+	}
+	for i, fee := range fees.Total {
+		protoFees.Total[i] = convertToProtoFeeEstimate(fee)
+	}
+	return protoFees
+}
+
+// convertToProtoRouteWarnings converts the route guard warnings of a route
+func convertToProtoRouteWarnings(warnings []models.RouteWarning) []*v1.RouteWarning {
+	protoWarnings := make([]*v1.RouteWarning, len(warnings))
+	for i, warning := range warnings {
+		protoWarnings[i] = &v1.RouteWarning{
+			Code:    warning.Code,
+			Message: warning.Message,
+		}
+	}
+	return protoWarnings
+}
+
+/*
+Converts internal models.RouteValuation to v1.RouteValuation
+
+Parameters:
+- valuation: *models.RouteValuation
+
+Returns:
+- *v1.RouteValuation, nil if the route was not valued
+
+Errors:
+- None
+*/
+func convertToProtoRouteValuation(valuation *models.RouteValuation) *v1.RouteValuation {
+	if valuation == nil {
+		return nil
+	}
+	return &v1.RouteValuation{
+		AmountInUsd:      valuation.AmountInUSD,
+		AmountOutUsd:     valuation.AmountOutUSD,
+		FeesUsd:          valuation.FeesUSD,
+		ValueLossPercent: valuation.ValueLossPercent,
+		Notes:            valuation.Notes,
+	}
+}
+
+// convertToProtoTokenPrices converts the token prices of GetTokenPrices
+func convertToProtoTokenPrices(tokenPrices []models.TokenPrice) []*v1.TokenPrice {
+	protoPrices := make([]*v1.TokenPrice, len(tokenPrices))
+	for i, tokenPrice := range tokenPrices {
+		protoPrices[i] = &v1.TokenPrice{
+			ChainId:  tokenPrice.ChainID,
+			Denom:    tokenPrice.Denom,
+			Symbol:   tokenPrice.Symbol,
+			Decimals: int32(tokenPrice.Decimals),
+			PriceUsd: tokenPrice.PriceUSD,
+			Source:   tokenPrice.Source,
+			Error:    tokenPrice.Error,
+		}
+	}
+	return protoPrices
+}
+
+func convertToProtoConsolidationPlan(plan models.ConsolidationPlan) *v1.PlanConsolidationResponse {
+	routes := make([]*v1.ConsolidationRoute, len(plan.Routes))
+	for i, route := range plan.Routes {
+		routes[i] = &v1.ConsolidationRoute{
+			Balance: &v1.Balance{
+				ChainId: route.Balance.ChainID,
+				Denom:   route.Balance.Denom,
+				Amount:  route.Balance.Amount,
+			},
+			Error: route.Error,
+		}
+		if route.Route != nil {
+			routes[i].Route = convertToProtoResponse(route.Route)
+		}
+	}
+
+	transactions := make([]*v1.PlannedTransaction, len(plan.Transactions))
+	for i, transaction := range plan.Transactions {
+		routeIndexes := make([]uint32, len(transaction.Routes))
+		for j, index := range transaction.Routes {
+			routeIndexes[j] = uint32(index)
+		}
+		dependsOn := make([]uint32, len(transaction.DependsOn))
+		for j, index := range transaction.DependsOn {
+			dependsOn[j] = uint32(index)
+		}
+		transactions[i] = &v1.PlannedTransaction{
+			Step:      uint32(transaction.Step),
+			ChainId:   transaction.ChainID,
+			Signer:    transaction.Signer,
+			Routes:    routeIndexes,
+			DependsOn: dependsOn,
+		}
+	}
+
+	return &v1.PlanConsolidationResponse{Routes: routes, Transactions: transactions}
+}
+
+func convertToProtoFeeEstimate(fee *models.FeeEstimate) *v1.FeeEstimate {
+	if fee == nil {
+		return nil
+	}
+	return &v1.FeeEstimate{
+		ChainId:  fee.ChainID,
+		Gas:      fee.Gas,
+		Denom:    fee.Denom,
+		Amount:   fee.Amount,
+		GasPrice: fee.GasPrice,
+	}
+}
+
 /*
 Converts internal models.IBCLeg to v1.IBCLeg
 
@@ -181,6 +361,7 @@ func convertToProtoIBCLeg(legs []*models.IBCLeg) []*v1.IBCLeg {
 			Port:      leg.Port,
 			Token:     convertToProtoTokenMapping(leg.Token),
 			Amount:    leg.Amount,
+			Fee:       convertToProtoFeeEstimate(leg.Fee),
 		}
 	}
 	return protoLegs
@@ -235,6 +416,8 @@ func convertToProtoSwapQuote(swap *models.SwapQuote) *v1.SwapQuote {
 		AmountOut:    swap.AmountOut,
 		PriceImpact:  swap.PriceImpact,
 		EffectiveFee: swap.EffectiveFee,
+		TakerFee:     swap.TakerFee,
+		NetAmountOut: swap.NetAmountOut,
 	}
 
 	// Convert broker-specific RouteData based on broker type
@@ -246,8 +429,13 @@ func convertToProtoSwapQuote(swap *models.SwapQuote) *v1.SwapQuote {
 				OsmosisRouteData: convertOsmosisRouteData(osmosisData),
 			}
 		}
-		// Add more brokers here as you implement them:
-		// case "astroport" for example:
+	case "astroport":
+		if astroportData, ok := swap.RouteData.(*astroport.RouteData); ok {
+			protoSwap.RouteData = &v1.SwapQuote_AstroportRouteData{
+				AstroportRouteData: convertAstroportRouteData(astroportData),
+			}
+		}
+		// Add more brokers here as you implement them
 	}
 
 	return protoSwap
@@ -299,6 +487,41 @@ func convertOsmosisRouteData(data *osmosis.RouteData) *v1.OsmosisRouteData {
 	}
 }
 
+/*
+Converts Astroport RouteData to v1.AstroportRouteData
+
+Parameters:
+- data: *astroport.RouteData
+
+Returns:
+- *v1.AstroportRouteData
+
+Errors:
+- None
+*/
+func convertAstroportRouteData(data *astroport.RouteData) *v1.AstroportRouteData {
+	if data == nil {
+		return nil
+	}
+
+	hops := make([]*v1.AstroportHop, len(data.Hops))
+	for i, hop := range data.Hops {
+		hops[i] = &v1.AstroportHop{
+			PairAddress: hop.PairAddress,
+			PairType:    hop.PairType,
+			DenomIn:     hop.DenomIn,
+			DenomOut:    hop.DenomOut,
+			FeeRate:     hop.FeeRate,
+		}
+	}
+
+	return &v1.AstroportRouteData{
+		Hops:      hops,
+		AmountIn:  data.AmountIn,
+		AmountOut: data.AmountOut,
+	}
+}
+
 func convertToProtoChainInfo(chain *router.PathfinderChain, showSymbols *bool) *v1.ChainInfo {
 	return &v1.ChainInfo{
 		ChainId:   chain.Id,
@@ -412,12 +635,6 @@ func convertToProtoSwapAndAction(swapAndAction *ibcmemo.SwapAndAction) *v1.SwapA
 		return nil
 	}
 
-	userSwap := v1.UserSwap{
-		SwapExactAssetIn: &v1.SwapExactAssetIn{
-			SwapVenueName: swapAndAction.UserSwap.SwapExactAssetIn.SwapVenueName,
-			Operations:    convertToProtoSwapOperations(swapAndAction.UserSwap.SwapExactAssetIn.Operations),
-		},
-	}
 	minAsset := v1.MinAsset{
 		Native: &v1.Asset{
 			Amount: swapAndAction.MinAsset.Native.Amount,
@@ -425,7 +642,7 @@ func convertToProtoSwapAndAction(swapAndAction *ibcmemo.SwapAndAction) *v1.SwapA
 		},
 	}
 	return &v1.SwapAndAction{
-		UserSwap:         &userSwap,
+		UserSwap:         convertToProtoUserSwap(swapAndAction.UserSwap),
 		MinAsset:         &minAsset,
 		TimeoutTimestamp: swapAndAction.TimeoutTimestamp,
 		PostSwapAction:   convertToProtoPostSwapAction(swapAndAction.PostSwapAction),
@@ -434,6 +651,55 @@ func convertToProtoSwapAndAction(swapAndAction *ibcmemo.SwapAndAction) *v1.SwapA
 	}
 }
 
+func convertToProtoUserSwap(userSwap *ibcmemo.UserSwap) *v1.UserSwap {
+	if userSwap == nil {
+		return nil
+	}
+
+	protoUserSwap := &v1.UserSwap{}
+	if userSwap.SwapExactAssetIn != nil {
+		protoUserSwap.SwapExactAssetIn = &v1.SwapExactAssetIn{
+			SwapVenueName: userSwap.SwapExactAssetIn.SwapVenueName,
+			Operations:    convertToProtoSwapOperations(userSwap.SwapExactAssetIn.Operations),
+		}
+	}
+	if userSwap.SwapExactAssetOut != nil {
+		protoUserSwap.SwapExactAssetOut = &v1.SwapExactAssetOut{
+			SwapVenueName: userSwap.SwapExactAssetOut.SwapVenueName,
+			Operations:    convertToProtoSwapOperations(userSwap.SwapExactAssetOut.Operations),
+			RefundAddress: userSwap.SwapExactAssetOut.RefundAddress,
+		}
+	}
+	if userSwap.SmartSwapExactAssetIn != nil {
+		protoUserSwap.SmartSwapExactAssetIn = &v1.SmartSwapExactAssetIn{
+			SwapVenueName: userSwap.SmartSwapExactAssetIn.SwapVenueName,
+			Routes:        convertToProtoSwapRoutes(userSwap.SmartSwapExactAssetIn.Routes),
+		}
+	}
+	return protoUserSwap
+}
+
+func convertToProtoSwapRoutes(routes []ibcmemo.SwapRoute) []*v1.SwapRoute {
+	if routes == nil {
+		return nil
+	}
+	protoRoutes := make([]*v1.SwapRoute, len(routes))
+	for i, route := range routes {
+		protoRoutes[i] = &v1.SwapRoute{
+			Operations: convertToProtoSwapOperations(route.Operations),
+		}
+		if route.OfferAsset != nil && route.OfferAsset.Native != nil {
+			protoRoutes[i].OfferAsset = &v1.OfferAsset{
+				Native: &v1.Asset{
+					Amount: route.OfferAsset.Native.Amount,
+					Denom:  route.OfferAsset.Native.Denom,
+				},
+			}
+		}
+	}
+	return protoRoutes
+}
+
 func convertToProtoSwapOperations(operations []ibcmemo.SwapOperation) []*v1.SwapOperation {
 	if operations == nil {
 		return nil
@@ -499,3 +765,287 @@ func convertToProtoTransfer(transfer *ibcmemo.Transfer) *v1.Transfer {
 		ToAddress: transfer.ToAddress,
 	}
 }
+
+// Converts from protobuf
+// BuildTransaction takes a route returned by FindPath back, these turn it into the internal models again.
+// Broker route data is left out, it isn't needed to execute a route.
+
+/*
+Converts v1.FindPathResponse back to internal models.RouteResponse
+
+Parameters:
+- resp: *v1.FindPathResponse
+
+Returns:
+- models.RouteResponse
+
+Errors:
+- None, a response without a route gets an empty route type
+*/
+func convertFromProtoResponse(resp *v1.FindPathResponse) models.RouteResponse {
+	route := models.RouteResponse{
+		Success:      resp.GetSuccess(),
+		ErrorMessage: resp.GetErrorMessage(),
+	}
+
+	switch r := resp.GetRoute().(type) {
+	case *v1.FindPathResponse_Direct:
+		route.RouteType = "direct"
+		route.Direct = &models.DirectRoute{
+			Transfer: convertFromProtoIBCLeg(r.Direct.GetTransfer()),
+		}
+	case *v1.FindPathResponse_Indirect:
+		route.RouteType = "indirect"
+		route.Indirect = &models.IndirectRoute{
+			Path:          r.Indirect.GetPath(),
+			Legs:          convertFromProtoIBCLegs(r.Indirect.GetLegs()),
+			SupportsPFM:   r.Indirect.GetSupportsPfm(),
+			PFMStartChain: r.Indirect.GetPfmStartChain(),
+			PFMMemo:       r.Indirect.GetPfmMemo(),
+		}
+	case *v1.FindPathResponse_BrokerSwap:
+		route.RouteType = "broker_swap"
+		route.BrokerSwap = convertFromProtoBrokerSwapRoute(r.BrokerSwap)
+	}
+
+	return route
+}
+
+func convertFromProtoBrokerSwapRoute(brokerSwap *v1.BrokerSwapRoute) *models.BrokerRoute {
+	route := &models.BrokerRoute{
+		Path:                brokerSwap.GetPath(),
+		InboundLegs:         convertFromProtoIBCLegs(brokerSwap.GetInboundLegs()),
+		OutboundLegs:        convertFromProtoIBCLegs(brokerSwap.GetOutboundLegs()),
+		OutboundSupportsPFM: brokerSwap.GetOutboundSupportsPfm(),
+	}
+
+	if swap := brokerSwap.GetSwap(); swap != nil {
+		route.Swap = &models.SwapQuote{
+			Broker:       swap.GetBroker(),
+			TokenIn:      convertFromProtoTokenMapping(swap.GetTokenIn()),
+			TokenOut:     convertFromProtoTokenMapping(swap.GetTokenOut()),
+			AmountIn:     swap.GetAmountIn(),
+			AmountOut:    swap.GetAmountOut(),
+			PriceImpact:  swap.GetPriceImpact(),
+			EffectiveFee: swap.GetEffectiveFee(),
+			TakerFee:     swap.GetTakerFee(),
+			NetAmountOut: swap.GetNetAmountOut(),
+		}
+	}
+
+	if execution := brokerSwap.GetExecution(); execution != nil {
+		route.Execution = &models.BrokerExecutionData{
+			Memo:              execution.Memo,
+			SmartContractData: convertFromProtoWasmData(execution.GetSmartContractData()),
+			IBCReceiver:       execution.IbcReceiver,
+			MinOutputAmount:   execution.GetMinOutputAmount(),
+			MinReceivedAmount: execution.GetMinReceivedAmount(),
+			MaxInputAmount:    execution.GetMaxInputAmount(),
+			UsesWasm:          execution.GetUsesWasm(),
+			Description:       execution.GetDescription(),
+			Fee:               convertFromProtoFeeEstimate(execution.GetFee()),
+		}
+		if execution.GetRecoverAddress() != "" {
+			recoverAddress := execution.GetRecoverAddress()
+			route.Execution.RecoverAddress = &recoverAddress
+		}
+	}
+
+	return route
+}
+
+func convertFromProtoIBCLegs(legs []*v1.IBCLeg) []*models.IBCLeg {
+	if legs == nil {
+		return nil
+	}
+	modelLegs := make([]*models.IBCLeg, len(legs))
+	for i, leg := range legs {
+		modelLegs[i] = convertFromProtoIBCLeg(leg)
+	}
+	return modelLegs
+}
+
+func convertFromProtoIBCLeg(leg *v1.IBCLeg) *models.IBCLeg {
+	if leg == nil {
+		return nil
+	}
+	return &models.IBCLeg{
+		FromChain: leg.GetFromChain(),
+		ToChain:   leg.GetToChain(),
+		Channel:   leg.GetChannel(),
+		Port:      leg.GetPort(),
+		Token:     convertFromProtoTokenMapping(leg.GetToken()),
+		Amount:    leg.GetAmount(),
+		Fee:       convertFromProtoFeeEstimate(leg.GetFee()),
+	}
+}
+
+func convertFromProtoFeeEstimate(fee *v1.FeeEstimate) *models.FeeEstimate {
+	if fee == nil {
+		return nil
+	}
+	return &models.FeeEstimate{
+		ChainID:  fee.GetChainId(),
+		Gas:      fee.GetGas(),
+		Denom:    fee.GetDenom(),
+		Amount:   fee.GetAmount(),
+		GasPrice: fee.GetGasPrice(),
+	}
+}
+
+func convertFromProtoTokenMapping(token *v1.TokenMapping) *models.TokenMapping {
+	if token == nil {
+		return nil
+	}
+	return &models.TokenMapping{
+		ChainDenom:  token.GetChainDenom(),
+		BaseDenom:   token.GetBaseDenom(),
+		OriginChain: token.GetOriginChain(),
+		IsNative:    token.GetIsNative(),
+	}
+}
+
+func convertFromProtoWasmData(wasmData *v1.WasmData) *ibcmemo.WasmMemo {
+	if wasmData == nil {
+		return nil
+	}
+	return ibcmemo.NewWasmMemo(wasmData.GetContract(), &ibcmemo.WasmMsg{
+		SwapAndAction: convertFromProtoSwapAndAction(wasmData.GetMsg().GetSwapAndAction()),
+	})
+}
+
+func convertFromProtoSwapAndAction(swapAndAction *v1.SwapAndAction) *ibcmemo.SwapAndAction {
+	if swapAndAction == nil {
+		return nil
+	}
+
+	// Affiliates are always encoded as an array
+	affiliates := make([]interface{}, len(swapAndAction.GetAffiliates()))
+	for i, affiliate := range swapAndAction.GetAffiliates() {
+		affiliates[i] = affiliate
+	}
+
+	result := &ibcmemo.SwapAndAction{
+		UserSwap:         convertFromProtoUserSwap(swapAndAction.GetUserSwap()),
+		TimeoutTimestamp: swapAndAction.GetTimeoutTimestamp(),
+		PostSwapAction:   convertFromProtoPostSwapAction(swapAndAction.GetPostSwapAction()),
+		Affiliates:       affiliates,
+	}
+	if native := swapAndAction.GetMinAsset().GetNative(); native != nil {
+		result.MinAsset = &ibcmemo.MinAsset{
+			Native: &ibcmemo.Asset{Amount: native.GetAmount(), Denom: native.GetDenom()},
+		}
+	}
+	return result
+}
+
+func convertFromProtoUserSwap(userSwap *v1.UserSwap) *ibcmemo.UserSwap {
+	if userSwap == nil {
+		return nil
+	}
+
+	result := &ibcmemo.UserSwap{}
+	if swap := userSwap.GetSwapExactAssetIn(); swap != nil {
+		result.SwapExactAssetIn = &ibcmemo.SwapExactAssetIn{
+			SwapVenueName: swap.GetSwapVenueName(),
+			Operations:    convertFromProtoSwapOperations(swap.GetOperations()),
+		}
+	}
+	if swap := userSwap.GetSwapExactAssetOut(); swap != nil {
+		result.SwapExactAssetOut = &ibcmemo.SwapExactAssetOut{
+			SwapVenueName: swap.GetSwapVenueName(),
+			Operations:    convertFromProtoSwapOperations(swap.GetOperations()),
+			RefundAddress: swap.GetRefundAddress(),
+		}
+	}
+	if swap := userSwap.GetSmartSwapExactAssetIn(); swap != nil {
+		routes := make([]ibcmemo.SwapRoute, len(swap.GetRoutes()))
+		for i, route := range swap.GetRoutes() {
+			routes[i] = ibcmemo.SwapRoute{
+				Operations: convertFromProtoSwapOperations(route.GetOperations()),
+			}
+			if native := route.GetOfferAsset().GetNative(); native != nil {
+				routes[i].OfferAsset = &ibcmemo.OfferAsset{
+					Native: &ibcmemo.Asset{Amount: native.GetAmount(), Denom: native.GetDenom()},
+				}
+			}
+		}
+		result.SmartSwapExactAssetIn = &ibcmemo.SmartSwapExactAssetIn{
+			SwapVenueName: swap.GetSwapVenueName(),
+			Routes:        routes,
+		}
+	}
+	return result
+}
+
+func convertFromProtoSwapOperations(operations []*v1.SwapOperation) []ibcmemo.SwapOperation {
+	if operations == nil {
+		return nil
+	}
+	result := make([]ibcmemo.SwapOperation, len(operations))
+	for i, operation := range operations {
+		result[i] = ibcmemo.SwapOperation{
+			Pool:      operation.GetPool(),
+			DenomIn:   operation.GetDenomIn(),
+			DenomOut:  operation.GetDenomOut(),
+			Interface: operation.Interface,
+		}
+	}
+	return result
+}
+
+func convertFromProtoPostSwapAction(postSwapAction *v1.PostSwapAction) *ibcmemo.PostSwapAction {
+	switch action := postSwapAction.GetAction().(type) {
+	case *v1.PostSwapAction_IbcTransfer:
+		info := action.IbcTransfer.GetIbcInfo()
+		return &ibcmemo.PostSwapAction{
+			IBCTransfer: &ibcmemo.IBCTransfer{
+				IBCInfo: &ibcmemo.IBCInfo{
+					Memo:           info.GetMemo(),
+					Receiver:       info.GetReceiver(),
+					RecoverAddress: info.GetRecoverAddress(),
+					SourceChannel:  info.GetSourceChannel(),
+				},
+			},
+		}
+	case *v1.PostSwapAction_Transfer:
+		return &ibcmemo.PostSwapAction{
+			Transfer: &ibcmemo.Transfer{ToAddress: action.Transfer.GetToAddress()},
+		}
+	}
+	return nil
+}
+
+/*
+Converts the internal unsigned transactions to v1.UnsignedTransaction
+
+Parameters:
+- transactions: []models.UnsignedTransaction
+
+Returns:
+- []*v1.UnsignedTransaction
+
+Errors:
+- None
+*/
+func convertToProtoUnsignedTransactions(transactions []models.UnsignedTransaction) []*v1.UnsignedTransaction {
+	protoTransactions := make([]*v1.UnsignedTransaction, len(transactions))
+	for i, transaction := range transactions {
+		messages := make([]*v1.UnsignedMessage, len(transaction.Messages))
+		for j, msg := range transaction.Messages {
+			messages[j] = &v1.UnsignedMessage{
+				TypeUrl:   msg.TypeURL,
+				Value:     msg.Value,
+				AminoJson: msg.AminoJSON,
+			}
+		}
+		protoTransactions[i] = &v1.UnsignedTransaction{
+			ChainId:   transaction.ChainID,
+			Signer:    transaction.Signer,
+			Messages:  messages,
+			Memo:      transaction.Memo,
+			BodyBytes: transaction.BodyBytes,
+		}
+	}
+	return protoTransactions
+}
@@ -2,9 +2,11 @@ package rpc
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
 	"os"
+	"sync"
 	"time"
 
 	"buf.build/go/protovalidate"
@@ -42,6 +44,7 @@ type ServerConfig struct {
 	EnableMetrics         bool
 	RatePerMinute         *int
 	MaxConcurrentRequests *int
+	MaxConcurrentStreams  *int
 	OTelConfig            *OTelConfig // OpenTelemetry configuration
 }
 
@@ -49,6 +52,7 @@ type ServerConfig struct {
 func DefaultServerConfig() *ServerConfig {
 	rateLimit := 0
 	maxConcurrentRequests := 200
+	maxConcurrentStreams := 100
 	return &ServerConfig{
 		Address:               "localhost:8080",
 		AllowedOrigins:        []string{"http://localhost:3000", "http://localhost:8080"},
@@ -56,6 +60,7 @@ func DefaultServerConfig() *ServerConfig {
 		EnableMetrics:         true,
 		RatePerMinute:         &rateLimit,
 		MaxConcurrentRequests: &maxConcurrentRequests,
+		MaxConcurrentStreams:  &maxConcurrentStreams,
 		OTelConfig:            DefaultOTelConfig(),
 	}
 }
@@ -66,6 +71,62 @@ type Server struct {
 	httpServer   *http.Server
 	mux          *chi.Mux
 	otelShutdown func(context.Context) error
+	configStatus *configStatus
+}
+
+// configStatus tracks the chain config reloads for the health endpoint
+type configStatus struct {
+	mu          sync.RWMutex
+	loadedAt    time.Time
+	reloadError string
+	failedAt    time.Time
+}
+
+// healthResponse is the body of the health endpoint
+type healthResponse struct {
+	Status  string            `json:"status"`
+	Service string            `json:"service"`
+	Config  chainConfigHealth `json:"chain_config"`
+}
+
+type chainConfigHealth struct {
+	LoadedAt    time.Time  `json:"loaded_at"`
+	ReloadError string     `json:"reload_error,omitempty"`
+	FailedAt    *time.Time `json:"failed_at,omitempty"`
+}
+
+// ReportConfigReload records the outcome of a chain config reload.
+// A failed reload keeps the previous config in use and marks the server as degraded until a reload succeeds.
+func (s *Server) ReportConfigReload(err error) {
+	s.configStatus.mu.Lock()
+	defer s.configStatus.mu.Unlock()
+
+	if err != nil {
+		s.configStatus.reloadError = err.Error()
+		s.configStatus.failedAt = time.Now()
+		return
+	}
+	s.configStatus.loadedAt = time.Now()
+	s.configStatus.reloadError = ""
+	s.configStatus.failedAt = time.Time{}
+}
+
+func (c *configStatus) health() healthResponse {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+
+	response := healthResponse{
+		Status:  "healthy",
+		Service: "pathfinder-rpc",
+		Config:  chainConfigHealth{LoadedAt: c.loadedAt},
+	}
+	if c.reloadError != "" {
+		failedAt := c.failedAt
+		response.Status = "degraded"
+		response.Config.ReloadError = c.reloadError
+		response.Config.FailedAt = &failedAt
+	}
+	return response
 }
 
 // NewServer creates a new RPC server with the given configuration
@@ -73,7 +134,6 @@ func NewServer(
 	ctx context.Context,
 	config *ServerConfig,
 	pathfinder *router.Pathfinder,
-	denomResolver *router.DenomResolver,
 ) (*Server, error) {
 	if config == nil {
 		config = DefaultServerConfig()
@@ -104,7 +164,7 @@ func NewServer(
 	mux.Use(middleware.RequestID)
 	mux.Use(middleware.RealIP)
 	mux.Use(middleware.Compress(5))
-	mux.Use(middleware.Timeout(60 * time.Second))
+	mux.Use(requestTimeoutMiddleware(60 * time.Second))
 	mux.Use(realIPMiddleware)
 
 	// Add OpenTelemetry HTTP instrumentation if tracing is enabled
@@ -116,8 +176,15 @@ func NewServer(
 	if config.RatePerMinute != nil && *config.RatePerMinute > 0 {
 		mux.Use(httprate.LimitByIP(*config.RatePerMinute, 1*time.Minute))
 	}
-	if config.MaxConcurrentRequests != nil && *config.MaxConcurrentRequests > 0 {
-		mux.Use(middleware.Throttle(*config.MaxConcurrentRequests))
+	maxConcurrentRequests, maxConcurrentStreams := 0, 0
+	if config.MaxConcurrentRequests != nil {
+		maxConcurrentRequests = *config.MaxConcurrentRequests
+	}
+	if config.MaxConcurrentStreams != nil {
+		maxConcurrentStreams = *config.MaxConcurrentStreams
+	}
+	if maxConcurrentRequests > 0 || maxConcurrentStreams > 0 {
+		mux.Use(throttleMiddleware(maxConcurrentRequests, maxConcurrentStreams))
 	}
 
 	// Prometheus metrics endpoint - enabled by separate flag or OTel config
@@ -127,11 +194,12 @@ func NewServer(
 		Logger.Info().Msg("Metrics endpoint enabled: /server/metrics")
 	}
 
-	// Health check endpoint
+	// Health check endpoint, a failed chain config reload reports "degraded" while the old config keeps serving
+	status := &configStatus{loadedAt: time.Now()}
 	mux.HandleFunc("/server/health", func(w http.ResponseWriter, r *http.Request) {
 		w.Header().Set("Content-Type", "application/json")
 		w.WriteHeader(http.StatusOK)
-		_, _ = w.Write([]byte(`{"status":"healthy","service":"pathfinder-rpc"}`))
+		_ = json.NewEncoder(w).Encode(status.health())
 	})
 
 	// Readiness probe
@@ -142,7 +210,7 @@ func NewServer(
 	})
 
 	// Create the PathfinderServer implementation
-	pathfinderServer := NewPathfinderServer(pathfinder, denomResolver)
+	pathfinderServer := NewPathfinderServer(pathfinder)
 
 	// Initialize protovalidate validator
 	validator, err := protovalidate.New()
@@ -219,6 +287,7 @@ func NewServer(
 		httpServer:   httpServer,
 		mux:          mux,
 		otelShutdown: otelShutdown,
+		configStatus: status,
 	}, nil
 }
 
@@ -42,6 +42,7 @@ type FindPathRequest struct {
 	// or IBC denom (e.g., "ibc/...")
 	TokenFromDenom string `protobuf:"bytes,2,opt,name=token_from_denom,json=tokenFromDenom,proto3" json:"token_from_denom,omitempty"`
 	// Amount to transfer/swap (in base units)
+	// Exactly one of amount_in and amount_out must be set
 	AmountIn string `protobuf:"bytes,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
 	// Destination chain ID
 	ChainTo string `protobuf:"bytes,4,opt,name=chain_to,json=chainTo,proto3" json:"chain_to,omitempty"`
@@ -58,6 +59,21 @@ type FindPathRequest struct {
 	// Slippage in basis points (e.g., 100 = 1%, 1000 = 10%)
 	// Must be less than 10000
 	SlippageBps uint32 `protobuf:"varint,9,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+	// Exact amount the receiver should get (in base units)
+	// If set the route is quoted in reverse and the required input is returned
+	AmountOut string `protobuf:"bytes,10,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	// Route guards, tighten the server-level guards when set, the stricter limit applies
+	// Largest accepted price impact of the swap as a fraction (e.g., 0.05 for 5%)
+	MaxPriceImpact *float64 `protobuf:"fixed64,11,opt,name=max_price_impact,json=maxPriceImpact,proto3,oneof" json:"max_price_impact,omitempty"`
+	// Smallest accepted USD liquidity of a pool the swap trades through
+	MinLiquidityUsd *float64 `protobuf:"fixed64,12,opt,name=min_liquidity_usd,json=minLiquidityUsd,proto3,oneof" json:"min_liquidity_usd,omitempty"`
+	// Largest accepted number of IBC transfers in the route
+	MaxHops *uint32 `protobuf:"varint,13,opt,name=max_hops,json=maxHops,proto3,oneof" json:"max_hops,omitempty"`
+	// IBC settings, override the server-level and per-chain settings when set
+	// Time every IBC transfer of the route has to be received, in seconds
+	IbcTimeoutSeconds *uint32 `protobuf:"varint,14,opt,name=ibc_timeout_seconds,json=ibcTimeoutSeconds,proto3,oneof" json:"ibc_timeout_seconds,omitempty"`
+	// Times PFM retries a forwarded transfer that failed
+	PfmRetries *uint32 `protobuf:"varint,15,opt,name=pfm_retries,json=pfmRetries,proto3,oneof" json:"pfm_retries,omitempty"`
 }
 
 func (x *FindPathRequest) Reset() {
@@ -155,23 +171,59 @@ func (x *FindPathRequest) GetSlippageBps() uint32 {
 	return 0
 }
 
-type FindPathResponse struct {
+func (x *FindPathRequest) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+func (x *FindPathRequest) GetMaxPriceImpact() float64 {
+	if x != nil && x.MaxPriceImpact != nil {
+		return *x.MaxPriceImpact
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetMinLiquidityUsd() float64 {
+	if x != nil && x.MinLiquidityUsd != nil {
+		return *x.MinLiquidityUsd
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetMaxHops() uint32 {
+	if x != nil && x.MaxHops != nil {
+		return *x.MaxHops
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetIbcTimeoutSeconds() uint32 {
+	if x != nil && x.IbcTimeoutSeconds != nil {
+		return *x.IbcTimeoutSeconds
+	}
+	return 0
+}
+
+func (x *FindPathRequest) GetPfmRetries() uint32 {
+	if x != nil && x.PfmRetries != nil {
+		return *x.PfmRetries
+	}
+	return 0
+}
+
+// BatchFindPathRequest - Find the routes of several requests at once
+type BatchFindPathRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
-	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,proto3" json:"error_message,omitempty"`
-	// Types that are assignable to Route:
-	//
-	//	*FindPathResponse_Direct
-	//	*FindPathResponse_Indirect
-	//	*FindPathResponse_BrokerSwap
-	Route isFindPathResponse_Route `protobuf_oneof:"route"`
+	Requests []*FindPathRequest `protobuf:"bytes,1,rep,name=requests,proto3" json:"requests,omitempty"`
 }
 
-func (x *FindPathResponse) Reset() {
-	*x = FindPathResponse{}
+func (x *BatchFindPathRequest) Reset() {
+	*x = BatchFindPathRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -179,13 +231,13 @@ func (x *FindPathResponse) Reset() {
 	}
 }
 
-func (x *FindPathResponse) String() string {
+func (x *BatchFindPathRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*FindPathResponse) ProtoMessage() {}
+func (*BatchFindPathRequest) ProtoMessage() {}
 
-func (x *FindPathResponse) ProtoReflect() protoreflect.Message {
+func (x *BatchFindPathRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -197,85 +249,29 @@ func (x *FindPathResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use FindPathResponse.ProtoReflect.Descriptor instead.
-func (*FindPathResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchFindPathRequest.ProtoReflect.Descriptor instead.
+func (*BatchFindPathRequest) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{1}
 }
 
-func (x *FindPathResponse) GetSuccess() bool {
-	if x != nil {
-		return x.Success
-	}
-	return false
-}
-
-func (x *FindPathResponse) GetErrorMessage() string {
+func (x *BatchFindPathRequest) GetRequests() []*FindPathRequest {
 	if x != nil {
-		return x.ErrorMessage
-	}
-	return ""
-}
-
-func (m *FindPathResponse) GetRoute() isFindPathResponse_Route {
-	if m != nil {
-		return m.Route
-	}
-	return nil
-}
-
-func (x *FindPathResponse) GetDirect() *DirectRoute {
-	if x, ok := x.GetRoute().(*FindPathResponse_Direct); ok {
-		return x.Direct
-	}
-	return nil
-}
-
-func (x *FindPathResponse) GetIndirect() *IndirectRoute {
-	if x, ok := x.GetRoute().(*FindPathResponse_Indirect); ok {
-		return x.Indirect
+		return x.Requests
 	}
 	return nil
 }
 
-func (x *FindPathResponse) GetBrokerSwap() *BrokerSwapRoute {
-	if x, ok := x.GetRoute().(*FindPathResponse_BrokerSwap); ok {
-		return x.BrokerSwap
-	}
-	return nil
-}
-
-type isFindPathResponse_Route interface {
-	isFindPathResponse_Route()
-}
-
-type FindPathResponse_Direct struct {
-	Direct *DirectRoute `protobuf:"bytes,3,opt,name=direct,proto3,oneof"`
-}
-
-type FindPathResponse_Indirect struct {
-	Indirect *IndirectRoute `protobuf:"bytes,4,opt,name=indirect,proto3,oneof"`
-}
-
-type FindPathResponse_BrokerSwap struct {
-	BrokerSwap *BrokerSwapRoute `protobuf:"bytes,5,opt,name=broker_swap,proto3,oneof"`
-}
-
-func (*FindPathResponse_Direct) isFindPathResponse_Route() {}
-
-func (*FindPathResponse_Indirect) isFindPathResponse_Route() {}
-
-func (*FindPathResponse_BrokerSwap) isFindPathResponse_Route() {}
-
-type DirectRoute struct {
+type BatchFindPathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Transfer *IBCLeg `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	// A result per request, in the order of the requests
+	Results []*BatchFindPathResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *DirectRoute) Reset() {
-	*x = DirectRoute{}
+func (x *BatchFindPathResponse) Reset() {
+	*x = BatchFindPathResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -283,13 +279,13 @@ func (x *DirectRoute) Reset() {
 	}
 }
 
-func (x *DirectRoute) String() string {
+func (x *BatchFindPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DirectRoute) ProtoMessage() {}
+func (*BatchFindPathResponse) ProtoMessage() {}
 
-func (x *DirectRoute) ProtoReflect() protoreflect.Message {
+func (x *BatchFindPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,32 +297,32 @@ func (x *DirectRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DirectRoute.ProtoReflect.Descriptor instead.
-func (*DirectRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchFindPathResponse.ProtoReflect.Descriptor instead.
+func (*BatchFindPathResponse) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{2}
 }
 
-func (x *DirectRoute) GetTransfer() *IBCLeg {
+func (x *BatchFindPathResponse) GetResults() []*BatchFindPathResult {
 	if x != nil {
-		return x.Transfer
+		return x.Results
 	}
 	return nil
 }
 
-type IndirectRoute struct {
+// BatchFindPathResult - the route of a single request of a batch, or why the request is invalid
+type BatchFindPathResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Path          []string  `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
-	Legs          []*IBCLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
-	SupportsPfm   bool      `protobuf:"varint,3,opt,name=supports_pfm,proto3" json:"supports_pfm,omitempty"`
-	PfmStartChain string    `protobuf:"bytes,4,opt,name=pfm_start_chain,proto3" json:"pfm_start_chain,omitempty"`
-	PfmMemo       string    `protobuf:"bytes,5,opt,name=pfm_memo,proto3" json:"pfm_memo,omitempty"`
+	// The route, same shape as a FindPath response, not set if the request is invalid
+	Route *FindPathResponse `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Why the request is invalid, e.g. an unknown chain or denom
+	Error string `protobuf:"bytes,2,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *IndirectRoute) Reset() {
-	*x = IndirectRoute{}
+func (x *BatchFindPathResult) Reset() {
+	*x = BatchFindPathResult{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -334,13 +330,13 @@ func (x *IndirectRoute) Reset() {
 	}
 }
 
-func (x *IndirectRoute) String() string {
+func (x *BatchFindPathResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IndirectRoute) ProtoMessage() {}
+func (*BatchFindPathResult) ProtoMessage() {}
 
-func (x *IndirectRoute) ProtoReflect() protoreflect.Message {
+func (x *BatchFindPathResult) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -352,74 +348,47 @@ func (x *IndirectRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IndirectRoute.ProtoReflect.Descriptor instead.
-func (*IndirectRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use BatchFindPathResult.ProtoReflect.Descriptor instead.
+func (*BatchFindPathResult) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{3}
 }
 
-func (x *IndirectRoute) GetPath() []string {
-	if x != nil {
-		return x.Path
-	}
-	return nil
-}
-
-func (x *IndirectRoute) GetLegs() []*IBCLeg {
+func (x *BatchFindPathResult) GetRoute() *FindPathResponse {
 	if x != nil {
-		return x.Legs
+		return x.Route
 	}
 	return nil
 }
 
-func (x *IndirectRoute) GetSupportsPfm() bool {
-	if x != nil {
-		return x.SupportsPfm
-	}
-	return false
-}
-
-func (x *IndirectRoute) GetPfmStartChain() string {
-	if x != nil {
-		return x.PfmStartChain
-	}
-	return ""
-}
-
-func (x *IndirectRoute) GetPfmMemo() string {
+func (x *BatchFindPathResult) GetError() string {
 	if x != nil {
-		return x.PfmMemo
+		return x.Error
 	}
 	return ""
 }
 
-// BrokerSwapRoute represents a route involving a swap on a broker chain.
-// Supports various scenarios:
-// - Same-chain swap: inbound_leg absent, outbound_legs=[] (e.g., osmosis ATOM -> osmosis OSMO)
-// - Swap from broker: inbound_leg absent (e.g., osmosis USDC -> juno JUNO)
-// - Standard 3-chain: inbound_leg set, len(outbound_legs)=1
-// - Multi-hop outbound: inbound_leg set, len(outbound_legs)>1 (e.g., cosmos ATOM -> swap -> noble -> juno)
-type BrokerSwapRoute struct {
+// PlanConsolidationRequest - Move balances on several chains into one token on one chain
+type PlanConsolidationRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// All chain IDs in order
-	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
-	// IBC transfer to reach broker (absent if starting from broker)
-	InboundLegs []*IBCLeg `protobuf:"bytes,2,rep,name=inbound_legs,proto3" json:"inbound_legs,omitempty"`
-	// The swap operation on the broker chain
-	Swap *SwapQuote `protobuf:"bytes,3,opt,name=swap,proto3" json:"swap,omitempty"`
-	// IBC transfers after swap (empty if destination is broker)
-	// Can be multiple for multi-hop forwarding via PFM
-	OutboundLegs []*IBCLeg `protobuf:"bytes,4,rep,name=outbound_legs,proto3" json:"outbound_legs,omitempty"`
-	// Can use PFM for outbound legs
-	OutboundSupportsPfm bool `protobuf:"varint,5,opt,name=outbound_supports_pfm,proto3" json:"outbound_supports_pfm,omitempty"`
-	// Execution data for building transactions
-	Execution *BrokerExecutionData `protobuf:"bytes,6,opt,name=execution,proto3" json:"execution,omitempty"`
-}
-
-func (x *BrokerSwapRoute) Reset() {
-	*x = BrokerSwapRoute{}
+	// Balances to move, every balance is routed on its own
+	Balances []*Balance `protobuf:"bytes,1,rep,name=balances,proto3" json:"balances,omitempty"`
+	// Chain the balances are moved to
+	ChainTo string `protobuf:"bytes,2,opt,name=chain_to,json=chainTo,proto3" json:"chain_to,omitempty"`
+	// Token the balances end up as, can be human-readable (e.g., "uusdc") or IBC denom
+	TokenToDenom string `protobuf:"bytes,3,opt,name=token_to_denom,json=tokenToDenom,proto3" json:"token_to_denom,omitempty"`
+	// Address of the owner on any supported chain, the senders on the other chains are derived from it
+	SenderAddress string `protobuf:"bytes,4,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// Receiver address on the destination chain
+	ReceiverAddress string `protobuf:"bytes,5,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	// Slippage of the swaps in basis points (e.g., 100 = 1%)
+	SlippageBps uint32 `protobuf:"varint,6,opt,name=slippage_bps,json=slippageBps,proto3" json:"slippage_bps,omitempty"`
+}
+
+func (x *PlanConsolidationRequest) Reset() {
+	*x = PlanConsolidationRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -427,13 +396,13 @@ func (x *BrokerSwapRoute) Reset() {
 	}
 }
 
-func (x *BrokerSwapRoute) String() string {
+func (x *PlanConsolidationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrokerSwapRoute) ProtoMessage() {}
+func (*PlanConsolidationRequest) ProtoMessage() {}
 
-func (x *BrokerSwapRoute) ProtoReflect() protoreflect.Message {
+func (x *PlanConsolidationRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -445,77 +414,68 @@ func (x *BrokerSwapRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrokerSwapRoute.ProtoReflect.Descriptor instead.
-func (*BrokerSwapRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanConsolidationRequest.ProtoReflect.Descriptor instead.
+func (*PlanConsolidationRequest) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *BrokerSwapRoute) GetPath() []string {
+func (x *PlanConsolidationRequest) GetBalances() []*Balance {
 	if x != nil {
-		return x.Path
+		return x.Balances
 	}
 	return nil
 }
 
-func (x *BrokerSwapRoute) GetInboundLegs() []*IBCLeg {
+func (x *PlanConsolidationRequest) GetChainTo() string {
 	if x != nil {
-		return x.InboundLegs
+		return x.ChainTo
 	}
-	return nil
+	return ""
 }
 
-func (x *BrokerSwapRoute) GetSwap() *SwapQuote {
+func (x *PlanConsolidationRequest) GetTokenToDenom() string {
 	if x != nil {
-		return x.Swap
+		return x.TokenToDenom
 	}
-	return nil
+	return ""
 }
 
-func (x *BrokerSwapRoute) GetOutboundLegs() []*IBCLeg {
+func (x *PlanConsolidationRequest) GetSenderAddress() string {
 	if x != nil {
-		return x.OutboundLegs
+		return x.SenderAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *BrokerSwapRoute) GetOutboundSupportsPfm() bool {
+func (x *PlanConsolidationRequest) GetReceiverAddress() string {
 	if x != nil {
-		return x.OutboundSupportsPfm
+		return x.ReceiverAddress
 	}
-	return false
+	return ""
 }
 
-func (x *BrokerSwapRoute) GetExecution() *BrokerExecutionData {
+func (x *PlanConsolidationRequest) GetSlippageBps() uint32 {
 	if x != nil {
-		return x.Execution
+		return x.SlippageBps
 	}
-	return nil
+	return 0
 }
 
-// BrokerExecutionData contains ready-to-use transaction data
-type BrokerExecutionData struct {
+// Balance - an amount of a token on a chain
+type Balance struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The IBC memo to use with MsgTransfer (contains wasm swap_and_action)
-	Memo *string `protobuf:"bytes,1,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
-	// The smart contract data to use with the swap and transfer
-	SmartContractData *WasmData `protobuf:"bytes,2,opt,name=smart_contract_data,proto3,oneof" json:"smart_contract_data,omitempty"`
-	// Receiver address for MsgTransfer (the ibc-hooks contract)
-	IbcReceiver *string `protobuf:"bytes,3,opt,name=ibc_receiver,proto3,oneof" json:"ibc_receiver,omitempty"`
-	// Recovery address on broker chain (where funds go if swap/forward fails)
-	RecoverAddress string `protobuf:"bytes,4,opt,name=recover_address,proto3" json:"recover_address,omitempty"`
-	// Minimum output amount after slippage (1% default)
-	MinOutputAmount string `protobuf:"bytes,5,opt,name=min_output_amount,proto3" json:"min_output_amount,omitempty"`
-	// True if using wasm ibc-hooks (vs simple PFM)
-	UsesWasm bool `protobuf:"varint,6,opt,name=uses_wasm,proto3" json:"uses_wasm,omitempty"`
-	// Human-readable description
-	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	// Denom on the chain, can be human-readable or an IBC denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Amount in base units
+	Amount string `protobuf:"bytes,3,opt,name=amount,proto3" json:"amount,omitempty"`
 }
 
-func (x *BrokerExecutionData) Reset() {
-	*x = BrokerExecutionData{}
+func (x *Balance) Reset() {
+	*x = Balance{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -523,13 +483,13 @@ func (x *BrokerExecutionData) Reset() {
 	}
 }
 
-func (x *BrokerExecutionData) String() string {
+func (x *Balance) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BrokerExecutionData) ProtoMessage() {}
+func (*Balance) ProtoMessage() {}
 
-func (x *BrokerExecutionData) ProtoReflect() protoreflect.Message {
+func (x *Balance) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -541,75 +501,45 @@ func (x *BrokerExecutionData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BrokerExecutionData.ProtoReflect.Descriptor instead.
-func (*BrokerExecutionData) Descriptor() ([]byte, []int) {
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *BrokerExecutionData) GetMemo() string {
-	if x != nil && x.Memo != nil {
-		return *x.Memo
+func (x *Balance) GetChainId() string {
+	if x != nil {
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *BrokerExecutionData) GetSmartContractData() *WasmData {
+func (x *Balance) GetDenom() string {
 	if x != nil {
-		return x.SmartContractData
-	}
-	return nil
-}
-
-func (x *BrokerExecutionData) GetIbcReceiver() string {
-	if x != nil && x.IbcReceiver != nil {
-		return *x.IbcReceiver
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *BrokerExecutionData) GetRecoverAddress() string {
+func (x *Balance) GetAmount() string {
 	if x != nil {
-		return x.RecoverAddress
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *BrokerExecutionData) GetMinOutputAmount() string {
-	if x != nil {
-		return x.MinOutputAmount
-	}
-	return ""
+type PlanConsolidationResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// A route per balance, in the order of the balances
+	Routes []*ConsolidationRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	// Transactions in the order they have to be broadcast
+	Transactions []*PlannedTransaction `protobuf:"bytes,2,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *BrokerExecutionData) GetUsesWasm() bool {
-	if x != nil {
-		return x.UsesWasm
-	}
-	return false
-}
-
-func (x *BrokerExecutionData) GetDescription() string {
-	if x != nil {
-		return x.Description
-	}
-	return ""
-}
-
-type IBCLeg struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	FromChain string        `protobuf:"bytes,1,opt,name=from_chain,proto3" json:"from_chain,omitempty"`
-	ToChain   string        `protobuf:"bytes,2,opt,name=to_chain,proto3" json:"to_chain,omitempty"`
-	Channel   string        `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
-	Port      string        `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
-	Token     *TokenMapping `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
-	Amount    string        `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
-}
-
-func (x *IBCLeg) Reset() {
-	*x = IBCLeg{}
+func (x *PlanConsolidationResponse) Reset() {
+	*x = PlanConsolidationResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -617,13 +547,13 @@ func (x *IBCLeg) Reset() {
 	}
 }
 
-func (x *IBCLeg) String() string {
+func (x *PlanConsolidationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IBCLeg) ProtoMessage() {}
+func (*PlanConsolidationResponse) ProtoMessage() {}
 
-func (x *IBCLeg) ProtoReflect() protoreflect.Message {
+func (x *PlanConsolidationResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -635,70 +565,42 @@ func (x *IBCLeg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IBCLeg.ProtoReflect.Descriptor instead.
-func (*IBCLeg) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlanConsolidationResponse.ProtoReflect.Descriptor instead.
+func (*PlanConsolidationResponse) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *IBCLeg) GetFromChain() string {
-	if x != nil {
-		return x.FromChain
-	}
-	return ""
-}
-
-func (x *IBCLeg) GetToChain() string {
-	if x != nil {
-		return x.ToChain
-	}
-	return ""
-}
-
-func (x *IBCLeg) GetChannel() string {
-	if x != nil {
-		return x.Channel
-	}
-	return ""
-}
-
-func (x *IBCLeg) GetPort() string {
+func (x *PlanConsolidationResponse) GetRoutes() []*ConsolidationRoute {
 	if x != nil {
-		return x.Port
-	}
-	return ""
-}
-
-func (x *IBCLeg) GetToken() *TokenMapping {
-	if x != nil {
-		return x.Token
+		return x.Routes
 	}
 	return nil
 }
 
-func (x *IBCLeg) GetAmount() string {
+func (x *PlanConsolidationResponse) GetTransactions() []*PlannedTransaction {
 	if x != nil {
-		return x.Amount
+		return x.Transactions
 	}
-	return ""
+	return nil
 }
 
-type TokenMapping struct {
+// ConsolidationRoute - the route of one balance
+// Neither route nor error is set if the balance already is the target token
+type ConsolidationRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The denom as it appears on the current chain (native or IBC)
-	ChainDenom string `protobuf:"bytes,1,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
-	// The base/native denom on the token's origin chain
-	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
-	// The chain ID where this token is native
-	OriginChain string `protobuf:"bytes,3,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
-	// True if this is the token's native chain
-	IsNative bool `protobuf:"varint,4,opt,name=is_native,proto3" json:"is_native,omitempty"`
+	// The balance with its resolved denom
+	Balance *Balance `protobuf:"bytes,1,opt,name=balance,proto3" json:"balance,omitempty"`
+	// The route, same shape as a FindPath response, not set if the balance can't be routed
+	Route *FindPathResponse `protobuf:"bytes,2,opt,name=route,proto3" json:"route,omitempty"`
+	// Why the balance can't be routed, e.g. an unknown denom or no route
+	Error string `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
 }
 
-func (x *TokenMapping) Reset() {
-	*x = TokenMapping{}
+func (x *ConsolidationRoute) Reset() {
+	*x = ConsolidationRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[7]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -706,13 +608,13 @@ func (x *TokenMapping) Reset() {
 	}
 }
 
-func (x *TokenMapping) String() string {
+func (x *ConsolidationRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenMapping) ProtoMessage() {}
+func (*ConsolidationRoute) ProtoMessage() {}
 
-func (x *TokenMapping) ProtoReflect() protoreflect.Message {
+func (x *ConsolidationRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[7]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -724,62 +626,52 @@ func (x *TokenMapping) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenMapping.ProtoReflect.Descriptor instead.
-func (*TokenMapping) Descriptor() ([]byte, []int) {
+// Deprecated: Use ConsolidationRoute.ProtoReflect.Descriptor instead.
+func (*ConsolidationRoute) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{7}
 }
 
-func (x *TokenMapping) GetChainDenom() string {
+func (x *ConsolidationRoute) GetBalance() *Balance {
 	if x != nil {
-		return x.ChainDenom
+		return x.Balance
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenMapping) GetBaseDenom() string {
+func (x *ConsolidationRoute) GetRoute() *FindPathResponse {
 	if x != nil {
-		return x.BaseDenom
+		return x.Route
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenMapping) GetOriginChain() string {
+func (x *ConsolidationRoute) GetError() string {
 	if x != nil {
-		return x.OriginChain
+		return x.Error
 	}
 	return ""
 }
 
-func (x *TokenMapping) GetIsNative() bool {
-	if x != nil {
-		return x.IsNative
-	}
-	return false
-}
-
-// SwapQuote with broker-specific route data
-type SwapQuote struct {
+// PlannedTransaction - one transaction of the plan, it sends the transfers of several routes at once
+type PlannedTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Broker       string        `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
-	TokenIn      *TokenMapping `protobuf:"bytes,2,opt,name=token_in,proto3" json:"token_in,omitempty"`
-	TokenOut     *TokenMapping `protobuf:"bytes,3,opt,name=token_out,proto3" json:"token_out,omitempty"`
-	AmountIn     string        `protobuf:"bytes,4,opt,name=amount_in,proto3" json:"amount_in,omitempty"`
-	AmountOut    string        `protobuf:"bytes,5,opt,name=amount_out,proto3" json:"amount_out,omitempty"`
-	PriceImpact  string        `protobuf:"bytes,6,opt,name=price_impact,proto3" json:"price_impact,omitempty"`
-	EffectiveFee string        `protobuf:"bytes,7,opt,name=effective_fee,proto3" json:"effective_fee,omitempty"`
-	// Broker-specific route data using oneof for type safety
-	//
-	// Types that are assignable to RouteData:
-	//
-	//	*SwapQuote_OsmosisRouteData
-	RouteData isSwapQuote_RouteData `protobuf_oneof:"route_data"`
+	// Transactions of a step can be broadcast together, once the transfers of the step before were received
+	Step    uint32 `protobuf:"varint,1,opt,name=step,proto3" json:"step,omitempty"`
+	ChainId string `protobuf:"bytes,2,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	// Signer of the transaction on the chain
+	Signer string `protobuf:"bytes,3,opt,name=signer,proto3" json:"signer,omitempty"`
+	// Indexes of the routes whose transfer this transaction sends
+	Routes []uint32 `protobuf:"varint,4,rep,packed,name=routes,proto3" json:"routes,omitempty"`
+	// Indexes of the transactions whose transfers have to be received before this one is broadcast,
+	// they send the legs before the later legs of indirect routes without PFM
+	DependsOn []uint32 `protobuf:"varint,5,rep,packed,name=depends_on,proto3" json:"depends_on,omitempty"`
 }
 
-func (x *SwapQuote) Reset() {
-	*x = SwapQuote{}
+func (x *PlannedTransaction) Reset() {
+	*x = PlannedTransaction{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[8]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -787,13 +679,13 @@ func (x *SwapQuote) Reset() {
 	}
 }
 
-func (x *SwapQuote) String() string {
+func (x *PlannedTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapQuote) ProtoMessage() {}
+func (*PlannedTransaction) ProtoMessage() {}
 
-func (x *SwapQuote) ProtoReflect() protoreflect.Message {
+func (x *PlannedTransaction) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[8]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -805,97 +697,62 @@ func (x *SwapQuote) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapQuote.ProtoReflect.Descriptor instead.
-func (*SwapQuote) Descriptor() ([]byte, []int) {
+// Deprecated: Use PlannedTransaction.ProtoReflect.Descriptor instead.
+func (*PlannedTransaction) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{8}
 }
 
-func (x *SwapQuote) GetBroker() string {
-	if x != nil {
-		return x.Broker
-	}
-	return ""
-}
-
-func (x *SwapQuote) GetTokenIn() *TokenMapping {
-	if x != nil {
-		return x.TokenIn
-	}
-	return nil
-}
-
-func (x *SwapQuote) GetTokenOut() *TokenMapping {
-	if x != nil {
-		return x.TokenOut
-	}
-	return nil
-}
-
-func (x *SwapQuote) GetAmountIn() string {
+func (x *PlannedTransaction) GetStep() uint32 {
 	if x != nil {
-		return x.AmountIn
+		return x.Step
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapQuote) GetAmountOut() string {
+func (x *PlannedTransaction) GetChainId() string {
 	if x != nil {
-		return x.AmountOut
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *SwapQuote) GetPriceImpact() string {
+func (x *PlannedTransaction) GetSigner() string {
 	if x != nil {
-		return x.PriceImpact
+		return x.Signer
 	}
 	return ""
 }
 
-func (x *SwapQuote) GetEffectiveFee() string {
+func (x *PlannedTransaction) GetRoutes() []uint32 {
 	if x != nil {
-		return x.EffectiveFee
-	}
-	return ""
-}
-
-func (m *SwapQuote) GetRouteData() isSwapQuote_RouteData {
-	if m != nil {
-		return m.RouteData
+		return x.Routes
 	}
 	return nil
 }
 
-func (x *SwapQuote) GetOsmosisRouteData() *OsmosisRouteData {
-	if x, ok := x.GetRouteData().(*SwapQuote_OsmosisRouteData); ok {
-		return x.OsmosisRouteData
+func (x *PlannedTransaction) GetDependsOn() []uint32 {
+	if x != nil {
+		return x.DependsOn
 	}
 	return nil
 }
 
-type isSwapQuote_RouteData interface {
-	isSwapQuote_RouteData()
-}
-
-type SwapQuote_OsmosisRouteData struct {
-	OsmosisRouteData *OsmosisRouteData `protobuf:"bytes,8,opt,name=osmosis_route_data,proto3,oneof"` // Future brokers can be added here without breaking existing clients
-}
-
-func (*SwapQuote_OsmosisRouteData) isSwapQuote_RouteData() {}
-
-// Osmosis-specific route data (from SQS API)
-type OsmosisRouteData struct {
+// WatchQuoteRequest - Watch the quote of a route
+type WatchQuoteRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Routes               []*OsmosisRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
-	LiquidityCap         string          `protobuf:"bytes,2,opt,name=liquidity_cap,proto3" json:"liquidity_cap,omitempty"`
-	LiquidityCapOverflow bool            `protobuf:"varint,3,opt,name=liquidity_cap_overflow,proto3" json:"liquidity_cap_overflow,omitempty"`
+	// The route to watch, validated the same as a FindPath request
+	Route *FindPathRequest `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Time between two quotes in seconds, 10 when unset
+	IntervalSeconds *uint32 `protobuf:"varint,2,opt,name=interval_seconds,json=intervalSeconds,proto3,oneof" json:"interval_seconds,omitempty"`
+	// Smallest change of the quoted amount in basis points that is sent, every change is sent when 0
+	ChangeThresholdBps uint32 `protobuf:"varint,3,opt,name=change_threshold_bps,json=changeThresholdBps,proto3" json:"change_threshold_bps,omitempty"`
 }
 
-func (x *OsmosisRouteData) Reset() {
-	*x = OsmosisRouteData{}
+func (x *WatchQuoteRequest) Reset() {
+	*x = WatchQuoteRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[9]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -903,13 +760,13 @@ func (x *OsmosisRouteData) Reset() {
 	}
 }
 
-func (x *OsmosisRouteData) String() string {
+func (x *WatchQuoteRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OsmosisRouteData) ProtoMessage() {}
+func (*WatchQuoteRequest) ProtoMessage() {}
 
-func (x *OsmosisRouteData) ProtoReflect() protoreflect.Message {
+func (x *WatchQuoteRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[9]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -921,45 +778,58 @@ func (x *OsmosisRouteData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OsmosisRouteData.ProtoReflect.Descriptor instead.
-func (*OsmosisRouteData) Descriptor() ([]byte, []int) {
+// Deprecated: Use WatchQuoteRequest.ProtoReflect.Descriptor instead.
+func (*WatchQuoteRequest) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *OsmosisRouteData) GetRoutes() []*OsmosisRoute {
+func (x *WatchQuoteRequest) GetRoute() *FindPathRequest {
 	if x != nil {
-		return x.Routes
+		return x.Route
 	}
 	return nil
 }
 
-func (x *OsmosisRouteData) GetLiquidityCap() string {
-	if x != nil {
-		return x.LiquidityCap
+func (x *WatchQuoteRequest) GetIntervalSeconds() uint32 {
+	if x != nil && x.IntervalSeconds != nil {
+		return *x.IntervalSeconds
 	}
-	return ""
+	return 0
 }
 
-func (x *OsmosisRouteData) GetLiquidityCapOverflow() bool {
+func (x *WatchQuoteRequest) GetChangeThresholdBps() uint32 {
 	if x != nil {
-		return x.LiquidityCapOverflow
+		return x.ChangeThresholdBps
 	}
-	return false
+	return 0
 }
 
-type OsmosisRoute struct {
+type FindPathResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pools     []*OsmosisPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
-	HasCwPool bool           `protobuf:"varint,2,opt,name=has_cw_pool,proto3" json:"has_cw_pool,omitempty"`
-	OutAmount string         `protobuf:"bytes,3,opt,name=out_amount,proto3" json:"out_amount,omitempty"`
-	InAmount  string         `protobuf:"bytes,4,opt,name=in_amount,proto3" json:"in_amount,omitempty"`
+	Success      bool   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string `protobuf:"bytes,2,opt,name=error_message,proto3" json:"error_message,omitempty"`
+	// Types that are assignable to Route:
+	//
+	//	*FindPathResponse_Direct
+	//	*FindPathResponse_Indirect
+	//	*FindPathResponse_BrokerSwap
+	Route isFindPathResponse_Route `protobuf_oneof:"route"`
+	// Estimated cost of executing the route, set for successful routes
+	Fees *RouteFees `protobuf:"bytes,6,opt,name=fees,proto3" json:"fees,omitempty"`
+	// USD values of the route, set for successful routes if prices are configured
+	Valuation *RouteValuation `protobuf:"bytes,7,opt,name=valuation,proto3" json:"valuation,omitempty"`
+	// Set if a route guard rejected the route or could not be checked for it
+	// PRICE_IMPACT_TOO_HIGH, LIQUIDITY_TOO_LOW, TOO_MANY_HOPS, PRICE_IMPACT_UNKNOWN or LIQUIDITY_UNKNOWN
+	ErrorCode string `protobuf:"bytes,8,opt,name=error_code,proto3" json:"error_code,omitempty"`
+	// Route guards the route came close to
+	Warnings []*RouteWarning `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
 }
 
-func (x *OsmosisRoute) Reset() {
-	*x = OsmosisRoute{}
+func (x *FindPathResponse) Reset() {
+	*x = FindPathResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[10]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -967,13 +837,13 @@ func (x *OsmosisRoute) Reset() {
 	}
 }
 
-func (x *OsmosisRoute) String() string {
+func (x *FindPathResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OsmosisRoute) ProtoMessage() {}
+func (*FindPathResponse) ProtoMessage() {}
 
-func (x *OsmosisRoute) ProtoReflect() protoreflect.Message {
+func (x *FindPathResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[10]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -985,156 +855,132 @@ func (x *OsmosisRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OsmosisRoute.ProtoReflect.Descriptor instead.
-func (*OsmosisRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindPathResponse.ProtoReflect.Descriptor instead.
+func (*FindPathResponse) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{10}
 }
 
-func (x *OsmosisRoute) GetPools() []*OsmosisPool {
+func (x *FindPathResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Pools
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-func (x *OsmosisRoute) GetHasCwPool() bool {
+func (x *FindPathResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.HasCwPool
+		return x.ErrorMessage
 	}
-	return false
+	return ""
 }
 
-func (x *OsmosisRoute) GetOutAmount() string {
-	if x != nil {
-		return x.OutAmount
+func (m *FindPathResponse) GetRoute() isFindPathResponse_Route {
+	if m != nil {
+		return m.Route
 	}
-	return ""
+	return nil
 }
 
-func (x *OsmosisRoute) GetInAmount() string {
-	if x != nil {
-		return x.InAmount
+func (x *FindPathResponse) GetDirect() *DirectRoute {
+	if x, ok := x.GetRoute().(*FindPathResponse_Direct); ok {
+		return x.Direct
 	}
-	return ""
+	return nil
 }
 
-type OsmosisPool struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	Id            int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	Type          int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
-	SpreadFactor  string `protobuf:"bytes,3,opt,name=spread_factor,proto3" json:"spread_factor,omitempty"`
-	TokenOutDenom string `protobuf:"bytes,4,opt,name=token_out_denom,proto3" json:"token_out_denom,omitempty"`
-	TakerFee      string `protobuf:"bytes,5,opt,name=taker_fee,proto3" json:"taker_fee,omitempty"`
-	LiquidityCap  string `protobuf:"bytes,6,opt,name=liquidity_cap,proto3" json:"liquidity_cap,omitempty"`
-}
-
-func (x *OsmosisPool) Reset() {
-	*x = OsmosisPool{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[11]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *FindPathResponse) GetIndirect() *IndirectRoute {
+	if x, ok := x.GetRoute().(*FindPathResponse_Indirect); ok {
+		return x.Indirect
 	}
+	return nil
 }
 
-func (x *OsmosisPool) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*OsmosisPool) ProtoMessage() {}
-
-func (x *OsmosisPool) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[11]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *FindPathResponse) GetBrokerSwap() *BrokerSwapRoute {
+	if x, ok := x.GetRoute().(*FindPathResponse_BrokerSwap); ok {
+		return x.BrokerSwap
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use OsmosisPool.ProtoReflect.Descriptor instead.
-func (*OsmosisPool) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{11}
+	return nil
 }
 
-func (x *OsmosisPool) GetId() int32 {
+func (x *FindPathResponse) GetFees() *RouteFees {
 	if x != nil {
-		return x.Id
+		return x.Fees
 	}
-	return 0
+	return nil
 }
 
-func (x *OsmosisPool) GetType() int32 {
+func (x *FindPathResponse) GetValuation() *RouteValuation {
 	if x != nil {
-		return x.Type
+		return x.Valuation
 	}
-	return 0
+	return nil
 }
 
-func (x *OsmosisPool) GetSpreadFactor() string {
+func (x *FindPathResponse) GetErrorCode() string {
 	if x != nil {
-		return x.SpreadFactor
+		return x.ErrorCode
 	}
 	return ""
 }
 
-func (x *OsmosisPool) GetTokenOutDenom() string {
+func (x *FindPathResponse) GetWarnings() []*RouteWarning {
 	if x != nil {
-		return x.TokenOutDenom
+		return x.Warnings
 	}
-	return ""
+	return nil
 }
 
-func (x *OsmosisPool) GetTakerFee() string {
-	if x != nil {
-		return x.TakerFee
-	}
-	return ""
+type isFindPathResponse_Route interface {
+	isFindPathResponse_Route()
 }
 
-func (x *OsmosisPool) GetLiquidityCap() string {
-	if x != nil {
-		return x.LiquidityCap
-	}
-	return ""
+type FindPathResponse_Direct struct {
+	Direct *DirectRoute `protobuf:"bytes,3,opt,name=direct,proto3,oneof"`
 }
 
-// LookupDenomRequest - Resolve token information
-// Accepts either:
-// - Human-readable denom (e.g., "uatone", "uosmo")
-// - IBC denom (e.g., "ibc/ABC123...")
-type LookupDenomRequest struct {
+type FindPathResponse_Indirect struct {
+	Indirect *IndirectRoute `protobuf:"bytes,4,opt,name=indirect,proto3,oneof"`
+}
+
+type FindPathResponse_BrokerSwap struct {
+	BrokerSwap *BrokerSwapRoute `protobuf:"bytes,5,opt,name=broker_swap,proto3,oneof"`
+}
+
+func (*FindPathResponse_Direct) isFindPathResponse_Route() {}
+
+func (*FindPathResponse_Indirect) isFindPathResponse_Route() {}
+
+func (*FindPathResponse_BrokerSwap) isFindPathResponse_Route() {}
+
+// RouteWarning - a problem with a route that did not stop it from being returned
+type RouteWarning struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// HIGH_PRICE_IMPACT, LIQUIDITY_UNCHECKED or NON_CANONICAL_DENOM
+	Code string `protobuf:"bytes,1,opt,name=code,proto3" json:"code,omitempty"`
+	// Human-readable description
+	Message string `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
 }
 
-func (x *LookupDenomRequest) Reset() {
-	*x = LookupDenomRequest{}
+func (x *RouteWarning) Reset() {
+	*x = RouteWarning{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[12]
+		mi := &file_pathfinder_route_proto_msgTypes[11]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupDenomRequest) String() string {
+func (x *RouteWarning) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupDenomRequest) ProtoMessage() {}
+func (*RouteWarning) ProtoMessage() {}
 
-func (x *LookupDenomRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[12]
+func (x *RouteWarning) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[11]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1145,62 +991,60 @@ func (x *LookupDenomRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupDenomRequest.ProtoReflect.Descriptor instead.
-func (*LookupDenomRequest) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{12}
+// Deprecated: Use RouteWarning.ProtoReflect.Descriptor instead.
+func (*RouteWarning) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *LookupDenomRequest) GetChainId() string {
+func (x *RouteWarning) GetCode() string {
 	if x != nil {
-		return x.ChainId
+		return x.Code
 	}
 	return ""
 }
 
-func (x *LookupDenomRequest) GetDenom() string {
+func (x *RouteWarning) GetMessage() string {
 	if x != nil {
-		return x.Denom
+		return x.Message
 	}
 	return ""
 }
 
-type LookupDenomResponse struct {
+// RouteValuation - USD values of a route, amounts without a price are left empty
+type RouteValuation struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
-	// The denom as it appears on this chain
-	ChainDenom string `protobuf:"bytes,2,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
-	// The base/native denom
-	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
-	// Where this token is native
-	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
-	// True if this is the token's native chain
-	IsNative bool `protobuf:"varint,5,opt,name=is_native,proto3" json:"is_native,omitempty"`
-	// IBC path if this is an IBC token (e.g., "transfer/channel-75")
-	IbcPath string `protobuf:"bytes,6,opt,name=ibc_path,proto3" json:"ibc_path,omitempty"`
-	// Denoms on other chains where this token is available
-	AvailableOn []*ChainDenom `protobuf:"bytes,7,rep,name=available_on,proto3" json:"available_on,omitempty"`
+	// Value of the amount the sender spends
+	AmountInUsd string `protobuf:"bytes,1,opt,name=amount_in_usd,proto3" json:"amount_in_usd,omitempty"`
+	// Value of the amount the receiver gets
+	AmountOutUsd string `protobuf:"bytes,2,opt,name=amount_out_usd,proto3" json:"amount_out_usd,omitempty"`
+	// Value of the transaction fees in the fee total
+	FeesUsd string `protobuf:"bytes,3,opt,name=fees_usd,proto3" json:"fees_usd,omitempty"`
+	// Share of the input and fees that doesn't reach the receiver, in percent
+	ValueLossPercent string `protobuf:"bytes,4,opt,name=value_loss_percent,proto3" json:"value_loss_percent,omitempty"`
+	// Tokens that could not be priced
+	Notes []string `protobuf:"bytes,5,rep,name=notes,proto3" json:"notes,omitempty"`
 }
 
-func (x *LookupDenomResponse) Reset() {
-	*x = LookupDenomResponse{}
+func (x *RouteValuation) Reset() {
+	*x = RouteValuation{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[13]
+		mi := &file_pathfinder_route_proto_msgTypes[12]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupDenomResponse) String() string {
+func (x *RouteValuation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupDenomResponse) ProtoMessage() {}
+func (*RouteValuation) ProtoMessage() {}
 
-func (x *LookupDenomResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[13]
+func (x *RouteValuation) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[12]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1211,74 +1055,124 @@ func (x *LookupDenomResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupDenomResponse.ProtoReflect.Descriptor instead.
-func (*LookupDenomResponse) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{13}
+// Deprecated: Use RouteValuation.ProtoReflect.Descriptor instead.
+func (*RouteValuation) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{12}
 }
 
-func (x *LookupDenomResponse) GetFound() bool {
+func (x *RouteValuation) GetAmountInUsd() string {
 	if x != nil {
-		return x.Found
+		return x.AmountInUsd
 	}
-	return false
+	return ""
 }
 
-func (x *LookupDenomResponse) GetChainDenom() string {
+func (x *RouteValuation) GetAmountOutUsd() string {
 	if x != nil {
-		return x.ChainDenom
+		return x.AmountOutUsd
 	}
 	return ""
 }
 
-func (x *LookupDenomResponse) GetBaseDenom() string {
+func (x *RouteValuation) GetFeesUsd() string {
 	if x != nil {
-		return x.BaseDenom
+		return x.FeesUsd
 	}
 	return ""
 }
 
-func (x *LookupDenomResponse) GetOriginChain() string {
+func (x *RouteValuation) GetValueLossPercent() string {
 	if x != nil {
-		return x.OriginChain
+		return x.ValueLossPercent
 	}
 	return ""
 }
 
-func (x *LookupDenomResponse) GetIsNative() bool {
+func (x *RouteValuation) GetNotes() []string {
 	if x != nil {
-		return x.IsNative
+		return x.Notes
 	}
-	return false
+	return nil
 }
 
-func (x *LookupDenomResponse) GetIbcPath() string {
+// RouteFees - what executing a route costs the sender on top of the transferred amount
+type RouteFees struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Transaction fees summed up per chain and denom
+	Total []*FeeEstimate `protobuf:"bytes,1,rep,name=total,proto3" json:"total,omitempty"`
+	// Costs that are not part of the total, e.g. relayer and PFM fees
+	Notes []string `protobuf:"bytes,2,rep,name=notes,proto3" json:"notes,omitempty"`
+}
+
+func (x *RouteFees) Reset() {
+	*x = RouteFees{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteFees) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteFees) ProtoMessage() {}
+
+func (x *RouteFees) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteFees.ProtoReflect.Descriptor instead.
+func (*RouteFees) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *RouteFees) GetTotal() []*FeeEstimate {
 	if x != nil {
-		return x.IbcPath
+		return x.Total
 	}
-	return ""
+	return nil
 }
 
-func (x *LookupDenomResponse) GetAvailableOn() []*ChainDenom {
+func (x *RouteFees) GetNotes() []string {
 	if x != nil {
-		return x.AvailableOn
+		return x.Notes
 	}
 	return nil
 }
 
-// ChainDenom represents a token's denom on a specific chain
-type ChainDenom struct {
+// FeeEstimate - estimated gas and fee of a transaction the sender signs
+type FeeEstimate struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
-	ChainName string `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
-	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	IsNative  bool   `protobuf:"varint,4,opt,name=is_native,proto3" json:"is_native,omitempty"`
+	// Chain the transaction is signed on
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	// Estimated gas limit
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+	// Fee denom, empty if the chain has no fee currency configured
+	Denom string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Fee at the average gas price
+	Amount string `protobuf:"bytes,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Average gas price of the fee denom
+	GasPrice string `protobuf:"bytes,5,opt,name=gas_price,proto3" json:"gas_price,omitempty"`
 }
 
-func (x *ChainDenom) Reset() {
-	*x = ChainDenom{}
+func (x *FeeEstimate) Reset() {
+	*x = FeeEstimate{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[14]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1286,13 +1180,13 @@ func (x *ChainDenom) Reset() {
 	}
 }
 
-func (x *ChainDenom) String() string {
+func (x *FeeEstimate) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainDenom) ProtoMessage() {}
+func (*FeeEstimate) ProtoMessage() {}
 
-func (x *ChainDenom) ProtoReflect() protoreflect.Message {
+func (x *FeeEstimate) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[14]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1304,56 +1198,59 @@ func (x *ChainDenom) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainDenom.ProtoReflect.Descriptor instead.
-func (*ChainDenom) Descriptor() ([]byte, []int) {
+// Deprecated: Use FeeEstimate.ProtoReflect.Descriptor instead.
+func (*FeeEstimate) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *ChainDenom) GetChainId() string {
+func (x *FeeEstimate) GetChainId() string {
 	if x != nil {
 		return x.ChainId
 	}
 	return ""
 }
 
-func (x *ChainDenom) GetChainName() string {
+func (x *FeeEstimate) GetGas() uint64 {
 	if x != nil {
-		return x.ChainName
+		return x.Gas
 	}
-	return ""
+	return 0
 }
 
-func (x *ChainDenom) GetDenom() string {
+func (x *FeeEstimate) GetDenom() string {
 	if x != nil {
 		return x.Denom
 	}
 	return ""
 }
 
-func (x *ChainDenom) GetIsNative() bool {
+func (x *FeeEstimate) GetAmount() string {
 	if x != nil {
-		return x.IsNative
+		return x.Amount
 	}
-	return false
+	return ""
 }
 
-// GetTokenDenomsRequest - Get all denoms for a token across chains
-// Use this to discover what denom a token has on different chains
-type GetTokenDenomsRequest struct {
+func (x *FeeEstimate) GetGasPrice() string {
+	if x != nil {
+		return x.GasPrice
+	}
+	return ""
+}
+
+// FindPathsResponse - every viable route ordered by score
+type FindPathsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The base denom (e.g., "uatone", "uosmo", "ustars")
-	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
-	// The chain where this token is native (e.g., "atomone-1", "osmosis-1")
-	OriginChain string `protobuf:"bytes,2,opt,name=origin_chain,json=originChain,proto3" json:"origin_chain,omitempty"`
-	// Optional: Filter to a specific chain. If empty, returns denoms on all chains
-	OnChainId string `protobuf:"bytes,3,opt,name=on_chain_id,json=onChainId,proto3" json:"on_chain_id,omitempty"`
+	Success      bool           `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ErrorMessage string         `protobuf:"bytes,2,opt,name=error_message,proto3" json:"error_message,omitempty"`
+	Routes       []*RankedRoute `protobuf:"bytes,3,rep,name=routes,proto3" json:"routes,omitempty"`
 }
 
-func (x *GetTokenDenomsRequest) Reset() {
-	*x = GetTokenDenomsRequest{}
+func (x *FindPathsResponse) Reset() {
+	*x = FindPathsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[15]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1361,13 +1258,13 @@ func (x *GetTokenDenomsRequest) Reset() {
 	}
 }
 
-func (x *GetTokenDenomsRequest) String() string {
+func (x *FindPathsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenDenomsRequest) ProtoMessage() {}
+func (*FindPathsResponse) ProtoMessage() {}
 
-func (x *GetTokenDenomsRequest) ProtoReflect() protoreflect.Message {
+func (x *FindPathsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[15]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1379,46 +1276,58 @@ func (x *GetTokenDenomsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenDenomsRequest.ProtoReflect.Descriptor instead.
-func (*GetTokenDenomsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use FindPathsResponse.ProtoReflect.Descriptor instead.
+func (*FindPathsResponse) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetTokenDenomsRequest) GetBaseDenom() string {
+func (x *FindPathsResponse) GetSuccess() bool {
 	if x != nil {
-		return x.BaseDenom
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *GetTokenDenomsRequest) GetOriginChain() string {
+func (x *FindPathsResponse) GetErrorMessage() string {
 	if x != nil {
-		return x.OriginChain
+		return x.ErrorMessage
 	}
 	return ""
 }
 
-func (x *GetTokenDenomsRequest) GetOnChainId() string {
+func (x *FindPathsResponse) GetRoutes() []*RankedRoute {
 	if x != nil {
-		return x.OnChainId
+		return x.Routes
 	}
-	return ""
+	return nil
 }
 
-type GetTokenDenomsResponse struct {
+// RankedRoute - a route candidate together with the metrics used to rank it
+type RankedRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Found       bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
-	BaseDenom   string `protobuf:"bytes,2,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
-	OriginChain string `protobuf:"bytes,3,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
-	// All chains where this token is available and what denom it has
-	Denoms []*ChainDenom `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
-}
-
-func (x *GetTokenDenomsResponse) Reset() {
-	*x = GetTokenDenomsResponse{}
+	// The route itself, same shape as a FindPath response
+	Route *FindPathResponse `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Position in the ranking, 1 is the best route
+	Rank uint32 `protobuf:"varint,2,opt,name=rank,proto3" json:"rank,omitempty"`
+	// Higher is better
+	Score float64 `protobuf:"fixed64,3,opt,name=score,proto3" json:"score,omitempty"`
+	// Amount of the requested token delivered to the receiver
+	ExpectedOutput string `protobuf:"bytes,4,opt,name=expected_output,proto3" json:"expected_output,omitempty"`
+	// Number of IBC transfers in the route
+	HopCount uint32 `protobuf:"varint,5,opt,name=hop_count,proto3" json:"hop_count,omitempty"`
+	// Swap fee as a fraction (e.g., "0.003"), "0" if the route has no swap
+	EffectiveFee string `protobuf:"bytes,6,opt,name=effective_fee,proto3" json:"effective_fee,omitempty"`
+	// True if this route delivers the highest output
+	IsBestPrice bool `protobuf:"varint,7,opt,name=is_best_price,proto3" json:"is_best_price,omitempty"`
+	// True if this route has the fewest hops
+	IsFastest bool `protobuf:"varint,8,opt,name=is_fastest,proto3" json:"is_fastest,omitempty"`
+}
+
+func (x *RankedRoute) Reset() {
+	*x = RankedRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[16]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1426,13 +1335,13 @@ func (x *GetTokenDenomsResponse) Reset() {
 	}
 }
 
-func (x *GetTokenDenomsResponse) String() string {
+func (x *RankedRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetTokenDenomsResponse) ProtoMessage() {}
+func (*RankedRoute) ProtoMessage() {}
 
-func (x *GetTokenDenomsResponse) ProtoReflect() protoreflect.Message {
+func (x *RankedRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[16]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1444,50 +1353,77 @@ func (x *GetTokenDenomsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetTokenDenomsResponse.ProtoReflect.Descriptor instead.
-func (*GetTokenDenomsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RankedRoute.ProtoReflect.Descriptor instead.
+func (*RankedRoute) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *GetTokenDenomsResponse) GetFound() bool {
+func (x *RankedRoute) GetRoute() *FindPathResponse {
 	if x != nil {
-		return x.Found
+		return x.Route
 	}
-	return false
+	return nil
 }
 
-func (x *GetTokenDenomsResponse) GetBaseDenom() string {
+func (x *RankedRoute) GetRank() uint32 {
 	if x != nil {
-		return x.BaseDenom
+		return x.Rank
+	}
+	return 0
+}
+
+func (x *RankedRoute) GetScore() float64 {
+	if x != nil {
+		return x.Score
+	}
+	return 0
+}
+
+func (x *RankedRoute) GetExpectedOutput() string {
+	if x != nil {
+		return x.ExpectedOutput
 	}
 	return ""
 }
 
-func (x *GetTokenDenomsResponse) GetOriginChain() string {
+func (x *RankedRoute) GetHopCount() uint32 {
 	if x != nil {
-		return x.OriginChain
+		return x.HopCount
+	}
+	return 0
+}
+
+func (x *RankedRoute) GetEffectiveFee() string {
+	if x != nil {
+		return x.EffectiveFee
 	}
 	return ""
 }
 
-func (x *GetTokenDenomsResponse) GetDenoms() []*ChainDenom {
+func (x *RankedRoute) GetIsBestPrice() bool {
 	if x != nil {
-		return x.Denoms
+		return x.IsBestPrice
 	}
-	return nil
+	return false
 }
 
-// GetChainTokensRequest - Get all tokens on a chain
-type GetChainTokensRequest struct {
+func (x *RankedRoute) GetIsFastest() bool {
+	if x != nil {
+		return x.IsFastest
+	}
+	return false
+}
+
+type DirectRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Transfer *IBCLeg `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
 }
 
-func (x *GetChainTokensRequest) Reset() {
-	*x = GetChainTokensRequest{}
+func (x *DirectRoute) Reset() {
+	*x = DirectRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1495,13 +1431,13 @@ func (x *GetChainTokensRequest) Reset() {
 	}
 }
 
-func (x *GetChainTokensRequest) String() string {
+func (x *DirectRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChainTokensRequest) ProtoMessage() {}
+func (*DirectRoute) ProtoMessage() {}
 
-func (x *GetChainTokensRequest) ProtoReflect() protoreflect.Message {
+func (x *DirectRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1513,33 +1449,32 @@ func (x *GetChainTokensRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChainTokensRequest.ProtoReflect.Descriptor instead.
-func (*GetChainTokensRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use DirectRoute.ProtoReflect.Descriptor instead.
+func (*DirectRoute) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *GetChainTokensRequest) GetChainId() string {
+func (x *DirectRoute) GetTransfer() *IBCLeg {
 	if x != nil {
-		return x.ChainId
+		return x.Transfer
 	}
-	return ""
+	return nil
 }
 
-type GetChainTokensResponse struct {
+type IndirectRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
-	ChainName string `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
-	// Native tokens on this chain
-	NativeTokens []*TokenDetails `protobuf:"bytes,3,rep,name=native_tokens,proto3" json:"native_tokens,omitempty"`
-	// IBC tokens received on this chain
-	IbcTokens []*TokenDetails `protobuf:"bytes,4,rep,name=ibc_tokens,proto3" json:"ibc_tokens,omitempty"`
+	Path          []string  `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	Legs          []*IBCLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	SupportsPfm   bool      `protobuf:"varint,3,opt,name=supports_pfm,proto3" json:"supports_pfm,omitempty"`
+	PfmStartChain string    `protobuf:"bytes,4,opt,name=pfm_start_chain,proto3" json:"pfm_start_chain,omitempty"`
+	PfmMemo       string    `protobuf:"bytes,5,opt,name=pfm_memo,proto3" json:"pfm_memo,omitempty"`
 }
 
-func (x *GetChainTokensResponse) Reset() {
-	*x = GetChainTokensResponse{}
+func (x *IndirectRoute) Reset() {
+	*x = IndirectRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1547,13 +1482,13 @@ func (x *GetChainTokensResponse) Reset() {
 	}
 }
 
-func (x *GetChainTokensResponse) String() string {
+func (x *IndirectRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetChainTokensResponse) ProtoMessage() {}
+func (*IndirectRoute) ProtoMessage() {}
 
-func (x *GetChainTokensResponse) ProtoReflect() protoreflect.Message {
+func (x *IndirectRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1565,61 +1500,76 @@ func (x *GetChainTokensResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetChainTokensResponse.ProtoReflect.Descriptor instead.
-func (*GetChainTokensResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use IndirectRoute.ProtoReflect.Descriptor instead.
+func (*IndirectRoute) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{18}
 }
 
-func (x *GetChainTokensResponse) GetChainId() string {
+func (x *IndirectRoute) GetPath() []string {
 	if x != nil {
-		return x.ChainId
+		return x.Path
 	}
-	return ""
+	return nil
 }
 
-func (x *GetChainTokensResponse) GetChainName() string {
+func (x *IndirectRoute) GetLegs() []*IBCLeg {
 	if x != nil {
-		return x.ChainName
+		return x.Legs
 	}
-	return ""
+	return nil
 }
 
-func (x *GetChainTokensResponse) GetNativeTokens() []*TokenDetails {
+func (x *IndirectRoute) GetSupportsPfm() bool {
 	if x != nil {
-		return x.NativeTokens
+		return x.SupportsPfm
 	}
-	return nil
+	return false
 }
 
-func (x *GetChainTokensResponse) GetIbcTokens() []*TokenDetails {
+func (x *IndirectRoute) GetPfmStartChain() string {
 	if x != nil {
-		return x.IbcTokens
+		return x.PfmStartChain
 	}
-	return nil
+	return ""
 }
 
-// TokenDetails - Full token information
-type TokenDetails struct {
+func (x *IndirectRoute) GetPfmMemo() string {
+	if x != nil {
+		return x.PfmMemo
+	}
+	return ""
+}
+
+// BrokerSwapRoute represents a route involving a swap on a broker chain.
+// Supports various scenarios:
+// - Same-chain swap: inbound_leg absent, outbound_legs=[] (e.g., osmosis ATOM -> osmosis OSMO)
+// - Swap from broker: inbound_leg absent (e.g., osmosis USDC -> juno JUNO)
+// - Standard 3-chain: inbound_leg set, len(outbound_legs)=1
+// - Multi-hop outbound: inbound_leg set, len(outbound_legs)>1 (e.g., cosmos ATOM -> swap -> noble -> juno)
+type BrokerSwapRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Denom on this chain (native or IBC hash)
-	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
-	// Human-readable symbol (e.g., "ATONE", "OSMO")
-	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
-	// Base denom on origin chain
-	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
-	// Chain where token is native
-	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
-	// Number of decimals
-	Decimals int32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	// True if native to this chain
-	IsNative bool `protobuf:"varint,6,opt,name=is_native,proto3" json:"is_native,omitempty"`
+	// All chain IDs in order
+	Path []string `protobuf:"bytes,1,rep,name=path,proto3" json:"path,omitempty"`
+	// IBC transfer to reach broker (absent if starting from broker)
+	InboundLegs []*IBCLeg `protobuf:"bytes,2,rep,name=inbound_legs,proto3" json:"inbound_legs,omitempty"`
+	// The swap operation on the broker chain
+	Swap *SwapQuote `protobuf:"bytes,3,opt,name=swap,proto3" json:"swap,omitempty"`
+	// IBC transfers after swap (empty if destination is broker)
+	// Can be multiple for multi-hop forwarding via PFM
+	OutboundLegs []*IBCLeg `protobuf:"bytes,4,rep,name=outbound_legs,proto3" json:"outbound_legs,omitempty"`
+	// Can use PFM for outbound legs
+	OutboundSupportsPfm bool `protobuf:"varint,5,opt,name=outbound_supports_pfm,proto3" json:"outbound_supports_pfm,omitempty"`
+	// Execution data for building transactions
+	Execution *BrokerExecutionData `protobuf:"bytes,6,opt,name=execution,proto3" json:"execution,omitempty"`
+	// Quotes from the other brokers that can do this swap, best first
+	AlternativeQuotes []*SwapQuote `protobuf:"bytes,7,rep,name=alternative_quotes,proto3" json:"alternative_quotes,omitempty"`
 }
 
-func (x *TokenDetails) Reset() {
-	*x = TokenDetails{}
+func (x *BrokerSwapRoute) Reset() {
+	*x = BrokerSwapRoute{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1627,13 +1577,13 @@ func (x *TokenDetails) Reset() {
 	}
 }
 
-func (x *TokenDetails) String() string {
+func (x *BrokerSwapRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenDetails) ProtoMessage() {}
+func (*BrokerSwapRoute) ProtoMessage() {}
 
-func (x *TokenDetails) ProtoReflect() protoreflect.Message {
+func (x *BrokerSwapRoute) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1645,63 +1595,91 @@ func (x *TokenDetails) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenDetails.ProtoReflect.Descriptor instead.
-func (*TokenDetails) Descriptor() ([]byte, []int) {
+// Deprecated: Use BrokerSwapRoute.ProtoReflect.Descriptor instead.
+func (*BrokerSwapRoute) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *TokenDetails) GetDenom() string {
+func (x *BrokerSwapRoute) GetPath() []string {
 	if x != nil {
-		return x.Denom
+		return x.Path
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenDetails) GetSymbol() string {
+func (x *BrokerSwapRoute) GetInboundLegs() []*IBCLeg {
 	if x != nil {
-		return x.Symbol
+		return x.InboundLegs
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenDetails) GetBaseDenom() string {
+func (x *BrokerSwapRoute) GetSwap() *SwapQuote {
 	if x != nil {
-		return x.BaseDenom
+		return x.Swap
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenDetails) GetOriginChain() string {
+func (x *BrokerSwapRoute) GetOutboundLegs() []*IBCLeg {
 	if x != nil {
-		return x.OriginChain
+		return x.OutboundLegs
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenDetails) GetDecimals() int32 {
+func (x *BrokerSwapRoute) GetOutboundSupportsPfm() bool {
 	if x != nil {
-		return x.Decimals
+		return x.OutboundSupportsPfm
 	}
-	return 0
+	return false
 }
 
-func (x *TokenDetails) GetIsNative() bool {
+func (x *BrokerSwapRoute) GetExecution() *BrokerExecutionData {
 	if x != nil {
-		return x.IsNative
+		return x.Execution
 	}
-	return false
+	return nil
 }
 
-type PathfinderSupportedChainsResponse struct {
+func (x *BrokerSwapRoute) GetAlternativeQuotes() []*SwapQuote {
+	if x != nil {
+		return x.AlternativeQuotes
+	}
+	return nil
+}
+
+// BrokerExecutionData contains ready-to-use transaction data
+type BrokerExecutionData struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainIds []string `protobuf:"bytes,1,rep,name=chain_ids,proto3" json:"chain_ids,omitempty"`
+	// The IBC memo to use with MsgTransfer (contains wasm swap_and_action)
+	Memo *string `protobuf:"bytes,1,opt,name=memo,proto3,oneof" json:"memo,omitempty"`
+	// The smart contract data to use with the swap and transfer
+	SmartContractData *WasmData `protobuf:"bytes,2,opt,name=smart_contract_data,proto3,oneof" json:"smart_contract_data,omitempty"`
+	// Receiver address for MsgTransfer (the ibc-hooks contract)
+	IbcReceiver *string `protobuf:"bytes,3,opt,name=ibc_receiver,proto3,oneof" json:"ibc_receiver,omitempty"`
+	// Recovery address on broker chain (where funds go if swap/forward fails)
+	RecoverAddress string `protobuf:"bytes,4,opt,name=recover_address,proto3" json:"recover_address,omitempty"`
+	// Minimum output amount after slippage (1% default)
+	MinOutputAmount string `protobuf:"bytes,5,opt,name=min_output_amount,proto3" json:"min_output_amount,omitempty"`
+	// True if using wasm ibc-hooks (vs simple PFM)
+	UsesWasm bool `protobuf:"varint,6,opt,name=uses_wasm,proto3" json:"uses_wasm,omitempty"`
+	// Human-readable description
+	Description string `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	// Maximum input after slippage, only set for exact output routes
+	// The unused input is refunded to the recover address
+	MaxInputAmount string `protobuf:"bytes,8,opt,name=max_input_amount,proto3" json:"max_input_amount,omitempty"`
+	// Fee of the transaction that starts the execution
+	Fee *FeeEstimate `protobuf:"bytes,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	// Minimum amount delivered to the receiver, the min output after the PFM fees of the outbound hops
+	MinReceivedAmount string `protobuf:"bytes,10,opt,name=min_received_amount,proto3" json:"min_received_amount,omitempty"`
 }
 
-func (x *PathfinderSupportedChainsResponse) Reset() {
-	*x = PathfinderSupportedChainsResponse{}
+func (x *BrokerExecutionData) Reset() {
+	*x = BrokerExecutionData{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1709,13 +1687,13 @@ func (x *PathfinderSupportedChainsResponse) Reset() {
 	}
 }
 
-func (x *PathfinderSupportedChainsResponse) String() string {
+func (x *BrokerExecutionData) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PathfinderSupportedChainsResponse) ProtoMessage() {}
+func (*BrokerExecutionData) ProtoMessage() {}
 
-func (x *PathfinderSupportedChainsResponse) ProtoReflect() protoreflect.Message {
+func (x *BrokerExecutionData) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1727,31 +1705,98 @@ func (x *PathfinderSupportedChainsResponse) ProtoReflect() protoreflect.Message
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PathfinderSupportedChainsResponse.ProtoReflect.Descriptor instead.
-func (*PathfinderSupportedChainsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use BrokerExecutionData.ProtoReflect.Descriptor instead.
+func (*BrokerExecutionData) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *PathfinderSupportedChainsResponse) GetChainIds() []string {
-	if x != nil {
-		return x.ChainIds
+func (x *BrokerExecutionData) GetMemo() string {
+	if x != nil && x.Memo != nil {
+		return *x.Memo
 	}
-	return nil
+	return ""
 }
 
-type ChainInfoRequest struct {
-	state         protoimpl.MessageState
+func (x *BrokerExecutionData) GetSmartContractData() *WasmData {
+	if x != nil {
+		return x.SmartContractData
+	}
+	return nil
+}
+
+func (x *BrokerExecutionData) GetIbcReceiver() string {
+	if x != nil && x.IbcReceiver != nil {
+		return *x.IbcReceiver
+	}
+	return ""
+}
+
+func (x *BrokerExecutionData) GetRecoverAddress() string {
+	if x != nil {
+		return x.RecoverAddress
+	}
+	return ""
+}
+
+func (x *BrokerExecutionData) GetMinOutputAmount() string {
+	if x != nil {
+		return x.MinOutputAmount
+	}
+	return ""
+}
+
+func (x *BrokerExecutionData) GetUsesWasm() bool {
+	if x != nil {
+		return x.UsesWasm
+	}
+	return false
+}
+
+func (x *BrokerExecutionData) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *BrokerExecutionData) GetMaxInputAmount() string {
+	if x != nil {
+		return x.MaxInputAmount
+	}
+	return ""
+}
+
+func (x *BrokerExecutionData) GetFee() *FeeEstimate {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+func (x *BrokerExecutionData) GetMinReceivedAmount() string {
+	if x != nil {
+		return x.MinReceivedAmount
+	}
+	return ""
+}
+
+type IBCLeg struct {
+	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// The chain id of the chain to get information for
-	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
-	// Whether to show the symbols of the tokens on the chain, default is false
-	ShowSymbols bool `protobuf:"varint,2,opt,name=show_symbols,json=showSymbols,proto3" json:"show_symbols,omitempty"`
+	FromChain string        `protobuf:"bytes,1,opt,name=from_chain,proto3" json:"from_chain,omitempty"`
+	ToChain   string        `protobuf:"bytes,2,opt,name=to_chain,proto3" json:"to_chain,omitempty"`
+	Channel   string        `protobuf:"bytes,3,opt,name=channel,proto3" json:"channel,omitempty"`
+	Port      string        `protobuf:"bytes,4,opt,name=port,proto3" json:"port,omitempty"`
+	Token     *TokenMapping `protobuf:"bytes,5,opt,name=token,proto3" json:"token,omitempty"`
+	Amount    string        `protobuf:"bytes,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	// Fee of the transaction the sender signs for this leg, not set if the leg is forwarded
+	Fee *FeeEstimate `protobuf:"bytes,7,opt,name=fee,proto3" json:"fee,omitempty"`
 }
 
-func (x *ChainInfoRequest) Reset() {
-	*x = ChainInfoRequest{}
+func (x *IBCLeg) Reset() {
+	*x = IBCLeg{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1759,13 +1804,13 @@ func (x *ChainInfoRequest) Reset() {
 	}
 }
 
-func (x *ChainInfoRequest) String() string {
+func (x *IBCLeg) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainInfoRequest) ProtoMessage() {}
+func (*IBCLeg) ProtoMessage() {}
 
-func (x *ChainInfoRequest) ProtoReflect() protoreflect.Message {
+func (x *IBCLeg) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1777,35 +1822,77 @@ func (x *ChainInfoRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainInfoRequest.ProtoReflect.Descriptor instead.
-func (*ChainInfoRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use IBCLeg.ProtoReflect.Descriptor instead.
+func (*IBCLeg) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *ChainInfoRequest) GetChainId() string {
+func (x *IBCLeg) GetFromChain() string {
 	if x != nil {
-		return x.ChainId
+		return x.FromChain
 	}
 	return ""
 }
 
-func (x *ChainInfoRequest) GetShowSymbols() bool {
+func (x *IBCLeg) GetToChain() string {
 	if x != nil {
-		return x.ShowSymbols
+		return x.ToChain
 	}
-	return false
+	return ""
 }
 
-type ChainInfoResponse struct {
+func (x *IBCLeg) GetChannel() string {
+	if x != nil {
+		return x.Channel
+	}
+	return ""
+}
+
+func (x *IBCLeg) GetPort() string {
+	if x != nil {
+		return x.Port
+	}
+	return ""
+}
+
+func (x *IBCLeg) GetToken() *TokenMapping {
+	if x != nil {
+		return x.Token
+	}
+	return nil
+}
+
+func (x *IBCLeg) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+func (x *IBCLeg) GetFee() *FeeEstimate {
+	if x != nil {
+		return x.Fee
+	}
+	return nil
+}
+
+type TokenMapping struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainInfo *ChainInfo `protobuf:"bytes,1,opt,name=chain_info,proto3" json:"chain_info,omitempty"`
+	// The denom as it appears on the current chain (native or IBC)
+	ChainDenom string `protobuf:"bytes,1,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
+	// The base/native denom on the token's origin chain
+	BaseDenom string `protobuf:"bytes,2,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
+	// The chain ID where this token is native
+	OriginChain string `protobuf:"bytes,3,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
+	// True if this is the token's native chain
+	IsNative bool `protobuf:"varint,4,opt,name=is_native,proto3" json:"is_native,omitempty"`
 }
 
-func (x *ChainInfoResponse) Reset() {
-	*x = ChainInfoResponse{}
+func (x *TokenMapping) Reset() {
+	*x = TokenMapping{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_pathfinder_route_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1813,13 +1900,13 @@ func (x *ChainInfoResponse) Reset() {
 	}
 }
 
-func (x *ChainInfoResponse) String() string {
+func (x *TokenMapping) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainInfoResponse) ProtoMessage() {}
+func (*TokenMapping) ProtoMessage() {}
 
-func (x *ChainInfoResponse) ProtoReflect() protoreflect.Message {
+func (x *TokenMapping) ProtoReflect() protoreflect.Message {
 	mi := &file_pathfinder_route_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1831,47 +1918,2161 @@ func (x *ChainInfoResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainInfoResponse.ProtoReflect.Descriptor instead.
-func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use TokenMapping.ProtoReflect.Descriptor instead.
+func (*TokenMapping) Descriptor() ([]byte, []int) {
 	return file_pathfinder_route_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *ChainInfoResponse) GetChainInfo() *ChainInfo {
+func (x *TokenMapping) GetChainDenom() string {
 	if x != nil {
-		return x.ChainInfo
+		return x.ChainDenom
 	}
-	return nil
+	return ""
 }
 
-type ChainInfo struct {
+func (x *TokenMapping) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *TokenMapping) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *TokenMapping) GetIsNative() bool {
+	if x != nil {
+		return x.IsNative
+	}
+	return false
+}
+
+// SwapQuote with broker-specific route data
+type SwapQuote struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainId   string        `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
-	ChainName string        `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
-	HasPfm    bool          `protobuf:"varint,3,opt,name=has_pfm,proto3" json:"has_pfm,omitempty"`
-	IsBroker  bool          `protobuf:"varint,4,opt,name=is_broker,proto3" json:"is_broker,omitempty"`
-	Routes    []*BasicRoute `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+	Broker       string        `protobuf:"bytes,1,opt,name=broker,proto3" json:"broker,omitempty"`
+	TokenIn      *TokenMapping `protobuf:"bytes,2,opt,name=token_in,proto3" json:"token_in,omitempty"`
+	TokenOut     *TokenMapping `protobuf:"bytes,3,opt,name=token_out,proto3" json:"token_out,omitempty"`
+	AmountIn     string        `protobuf:"bytes,4,opt,name=amount_in,proto3" json:"amount_in,omitempty"`
+	AmountOut    string        `protobuf:"bytes,5,opt,name=amount_out,proto3" json:"amount_out,omitempty"`
+	PriceImpact  string        `protobuf:"bytes,6,opt,name=price_impact,proto3" json:"price_impact,omitempty"`
+	EffectiveFee string        `protobuf:"bytes,7,opt,name=effective_fee,proto3" json:"effective_fee,omitempty"`
+	// Broker-specific route data using oneof for type safety
+	//
+	// Types that are assignable to RouteData:
+	//
+	//	*SwapQuote_OsmosisRouteData
+	//	*SwapQuote_AstroportRouteData
+	RouteData isSwapQuote_RouteData `protobuf_oneof:"route_data"`
+	// Taker fees charged on top of the quote as a fraction, "0" if none
+	TakerFee string `protobuf:"bytes,10,opt,name=taker_fee,proto3" json:"taker_fee,omitempty"`
+	// Amount delivered to the receiver after taker and PFM fees
+	NetAmountOut string `protobuf:"bytes,11,opt,name=net_amount_out,proto3" json:"net_amount_out,omitempty"`
 }
 
-func (x *ChainInfo) Reset() {
-	*x = ChainInfo{}
+func (x *SwapQuote) Reset() {
+	*x = SwapQuote{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapQuote) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapQuote) ProtoMessage() {}
+
+func (x *SwapQuote) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapQuote.ProtoReflect.Descriptor instead.
+func (*SwapQuote) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *SwapQuote) GetBroker() string {
+	if x != nil {
+		return x.Broker
+	}
+	return ""
+}
+
+func (x *SwapQuote) GetTokenIn() *TokenMapping {
+	if x != nil {
+		return x.TokenIn
+	}
+	return nil
+}
+
+func (x *SwapQuote) GetTokenOut() *TokenMapping {
+	if x != nil {
+		return x.TokenOut
+	}
+	return nil
+}
+
+func (x *SwapQuote) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *SwapQuote) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+func (x *SwapQuote) GetPriceImpact() string {
+	if x != nil {
+		return x.PriceImpact
+	}
+	return ""
+}
+
+func (x *SwapQuote) GetEffectiveFee() string {
+	if x != nil {
+		return x.EffectiveFee
+	}
+	return ""
+}
+
+func (m *SwapQuote) GetRouteData() isSwapQuote_RouteData {
+	if m != nil {
+		return m.RouteData
+	}
+	return nil
+}
+
+func (x *SwapQuote) GetOsmosisRouteData() *OsmosisRouteData {
+	if x, ok := x.GetRouteData().(*SwapQuote_OsmosisRouteData); ok {
+		return x.OsmosisRouteData
+	}
+	return nil
+}
+
+func (x *SwapQuote) GetAstroportRouteData() *AstroportRouteData {
+	if x, ok := x.GetRouteData().(*SwapQuote_AstroportRouteData); ok {
+		return x.AstroportRouteData
+	}
+	return nil
+}
+
+func (x *SwapQuote) GetTakerFee() string {
+	if x != nil {
+		return x.TakerFee
+	}
+	return ""
+}
+
+func (x *SwapQuote) GetNetAmountOut() string {
+	if x != nil {
+		return x.NetAmountOut
+	}
+	return ""
+}
+
+type isSwapQuote_RouteData interface {
+	isSwapQuote_RouteData()
+}
+
+type SwapQuote_OsmosisRouteData struct {
+	OsmosisRouteData *OsmosisRouteData `protobuf:"bytes,8,opt,name=osmosis_route_data,proto3,oneof"`
+}
+
+type SwapQuote_AstroportRouteData struct {
+	AstroportRouteData *AstroportRouteData `protobuf:"bytes,9,opt,name=astroport_route_data,proto3,oneof"` // Future brokers can be added here without breaking existing clients
+}
+
+func (*SwapQuote_OsmosisRouteData) isSwapQuote_RouteData() {}
+
+func (*SwapQuote_AstroportRouteData) isSwapQuote_RouteData() {}
+
+// Osmosis-specific route data (from SQS API)
+type OsmosisRouteData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Routes               []*OsmosisRoute `protobuf:"bytes,1,rep,name=routes,proto3" json:"routes,omitempty"`
+	LiquidityCap         string          `protobuf:"bytes,2,opt,name=liquidity_cap,proto3" json:"liquidity_cap,omitempty"`
+	LiquidityCapOverflow bool            `protobuf:"varint,3,opt,name=liquidity_cap_overflow,proto3" json:"liquidity_cap_overflow,omitempty"`
+}
+
+func (x *OsmosisRouteData) Reset() {
+	*x = OsmosisRouteData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsmosisRouteData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsmosisRouteData) ProtoMessage() {}
+
+func (x *OsmosisRouteData) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OsmosisRouteData.ProtoReflect.Descriptor instead.
+func (*OsmosisRouteData) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OsmosisRouteData) GetRoutes() []*OsmosisRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+func (x *OsmosisRouteData) GetLiquidityCap() string {
+	if x != nil {
+		return x.LiquidityCap
+	}
+	return ""
+}
+
+func (x *OsmosisRouteData) GetLiquidityCapOverflow() bool {
+	if x != nil {
+		return x.LiquidityCapOverflow
+	}
+	return false
+}
+
+type OsmosisRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pools     []*OsmosisPool `protobuf:"bytes,1,rep,name=pools,proto3" json:"pools,omitempty"`
+	HasCwPool bool           `protobuf:"varint,2,opt,name=has_cw_pool,proto3" json:"has_cw_pool,omitempty"`
+	OutAmount string         `protobuf:"bytes,3,opt,name=out_amount,proto3" json:"out_amount,omitempty"`
+	InAmount  string         `protobuf:"bytes,4,opt,name=in_amount,proto3" json:"in_amount,omitempty"`
+}
+
+func (x *OsmosisRoute) Reset() {
+	*x = OsmosisRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsmosisRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsmosisRoute) ProtoMessage() {}
+
+func (x *OsmosisRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OsmosisRoute.ProtoReflect.Descriptor instead.
+func (*OsmosisRoute) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OsmosisRoute) GetPools() []*OsmosisPool {
+	if x != nil {
+		return x.Pools
+	}
+	return nil
+}
+
+func (x *OsmosisRoute) GetHasCwPool() bool {
+	if x != nil {
+		return x.HasCwPool
+	}
+	return false
+}
+
+func (x *OsmosisRoute) GetOutAmount() string {
+	if x != nil {
+		return x.OutAmount
+	}
+	return ""
+}
+
+func (x *OsmosisRoute) GetInAmount() string {
+	if x != nil {
+		return x.InAmount
+	}
+	return ""
+}
+
+type OsmosisPool struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id            int32  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Type          int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	SpreadFactor  string `protobuf:"bytes,3,opt,name=spread_factor,proto3" json:"spread_factor,omitempty"`
+	TokenOutDenom string `protobuf:"bytes,4,opt,name=token_out_denom,proto3" json:"token_out_denom,omitempty"`
+	TakerFee      string `protobuf:"bytes,5,opt,name=taker_fee,proto3" json:"taker_fee,omitempty"`
+	LiquidityCap  string `protobuf:"bytes,6,opt,name=liquidity_cap,proto3" json:"liquidity_cap,omitempty"`
+}
+
+func (x *OsmosisPool) Reset() {
+	*x = OsmosisPool{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OsmosisPool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OsmosisPool) ProtoMessage() {}
+
+func (x *OsmosisPool) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OsmosisPool.ProtoReflect.Descriptor instead.
+func (*OsmosisPool) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *OsmosisPool) GetId() int32 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OsmosisPool) GetType() int32 {
+	if x != nil {
+		return x.Type
+	}
+	return 0
+}
+
+func (x *OsmosisPool) GetSpreadFactor() string {
+	if x != nil {
+		return x.SpreadFactor
+	}
+	return ""
+}
+
+func (x *OsmosisPool) GetTokenOutDenom() string {
+	if x != nil {
+		return x.TokenOutDenom
+	}
+	return ""
+}
+
+func (x *OsmosisPool) GetTakerFee() string {
+	if x != nil {
+		return x.TakerFee
+	}
+	return ""
+}
+
+func (x *OsmosisPool) GetLiquidityCap() string {
+	if x != nil {
+		return x.LiquidityCap
+	}
+	return ""
+}
+
+// Astroport-specific route data (from pair simulations)
+type AstroportRouteData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Hops      []*AstroportHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+	AmountIn  string          `protobuf:"bytes,2,opt,name=amount_in,proto3" json:"amount_in,omitempty"`
+	AmountOut string          `protobuf:"bytes,3,opt,name=amount_out,proto3" json:"amount_out,omitempty"`
+}
+
+func (x *AstroportRouteData) Reset() {
+	*x = AstroportRouteData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[27]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AstroportRouteData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AstroportRouteData) ProtoMessage() {}
+
+func (x *AstroportRouteData) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[27]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AstroportRouteData.ProtoReflect.Descriptor instead.
+func (*AstroportRouteData) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *AstroportRouteData) GetHops() []*AstroportHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *AstroportRouteData) GetAmountIn() string {
+	if x != nil {
+		return x.AmountIn
+	}
+	return ""
+}
+
+func (x *AstroportRouteData) GetAmountOut() string {
+	if x != nil {
+		return x.AmountOut
+	}
+	return ""
+}
+
+type AstroportHop struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	PairAddress string `protobuf:"bytes,1,opt,name=pair_address,proto3" json:"pair_address,omitempty"`
+	PairType    string `protobuf:"bytes,2,opt,name=pair_type,proto3" json:"pair_type,omitempty"`
+	DenomIn     string `protobuf:"bytes,3,opt,name=denom_in,proto3" json:"denom_in,omitempty"`
+	DenomOut    string `protobuf:"bytes,4,opt,name=denom_out,proto3" json:"denom_out,omitempty"`
+	// Fee of the pair as a fraction, empty if unknown
+	FeeRate string `protobuf:"bytes,5,opt,name=fee_rate,proto3" json:"fee_rate,omitempty"`
+}
+
+func (x *AstroportHop) Reset() {
+	*x = AstroportHop{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[28]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AstroportHop) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AstroportHop) ProtoMessage() {}
+
+func (x *AstroportHop) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[28]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AstroportHop.ProtoReflect.Descriptor instead.
+func (*AstroportHop) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *AstroportHop) GetPairAddress() string {
+	if x != nil {
+		return x.PairAddress
+	}
+	return ""
+}
+
+func (x *AstroportHop) GetPairType() string {
+	if x != nil {
+		return x.PairType
+	}
+	return ""
+}
+
+func (x *AstroportHop) GetDenomIn() string {
+	if x != nil {
+		return x.DenomIn
+	}
+	return ""
+}
+
+func (x *AstroportHop) GetDenomOut() string {
+	if x != nil {
+		return x.DenomOut
+	}
+	return ""
+}
+
+func (x *AstroportHop) GetFeeRate() string {
+	if x != nil {
+		return x.FeeRate
+	}
+	return ""
+}
+
+// LookupDenomRequest - Resolve token information
+// Accepts either:
+// - Human-readable denom (e.g., "uatone", "uosmo")
+// - IBC denom (e.g., "ibc/ABC123...")
+type LookupDenomRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *LookupDenomRequest) Reset() {
+	*x = LookupDenomRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[29]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDenomRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDenomRequest) ProtoMessage() {}
+
+func (x *LookupDenomRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[29]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDenomRequest.ProtoReflect.Descriptor instead.
+func (*LookupDenomRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *LookupDenomRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *LookupDenomRequest) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+type LookupDenomResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Found bool `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	// The denom as it appears on this chain
+	ChainDenom string `protobuf:"bytes,2,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
+	// The base/native denom
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
+	// Where this token is native
+	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
+	// True if this is the token's native chain
+	IsNative bool `protobuf:"varint,5,opt,name=is_native,proto3" json:"is_native,omitempty"`
+	// IBC path if this is an IBC token (e.g., "transfer/channel-75")
+	IbcPath string `protobuf:"bytes,6,opt,name=ibc_path,proto3" json:"ibc_path,omitempty"`
+	// Denoms on other chains where this token is available
+	AvailableOn []*ChainDenom `protobuf:"bytes,7,rep,name=available_on,proto3" json:"available_on,omitempty"`
+}
+
+func (x *LookupDenomResponse) Reset() {
+	*x = LookupDenomResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[30]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupDenomResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupDenomResponse) ProtoMessage() {}
+
+func (x *LookupDenomResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[30]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupDenomResponse.ProtoReflect.Descriptor instead.
+func (*LookupDenomResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *LookupDenomResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *LookupDenomResponse) GetChainDenom() string {
+	if x != nil {
+		return x.ChainDenom
+	}
+	return ""
+}
+
+func (x *LookupDenomResponse) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *LookupDenomResponse) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *LookupDenomResponse) GetIsNative() bool {
+	if x != nil {
+		return x.IsNative
+	}
+	return false
+}
+
+func (x *LookupDenomResponse) GetIbcPath() string {
+	if x != nil {
+		return x.IbcPath
+	}
+	return ""
+}
+
+func (x *LookupDenomResponse) GetAvailableOn() []*ChainDenom {
+	if x != nil {
+		return x.AvailableOn
+	}
+	return nil
+}
+
+// ChainDenom represents a token's denom on a specific chain
+type ChainDenom struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	ChainName string `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
+	Denom     string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	IsNative  bool   `protobuf:"varint,4,opt,name=is_native,proto3" json:"is_native,omitempty"`
+}
+
+func (x *ChainDenom) Reset() {
+	*x = ChainDenom{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[31]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainDenom) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainDenom) ProtoMessage() {}
+
+func (x *ChainDenom) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[31]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainDenom.ProtoReflect.Descriptor instead.
+func (*ChainDenom) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *ChainDenom) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainDenom) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *ChainDenom) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *ChainDenom) GetIsNative() bool {
+	if x != nil {
+		return x.IsNative
+	}
+	return false
+}
+
+// GetTokenDenomsRequest - Get all denoms for a token across chains
+// Use this to discover what denom a token has on different chains
+type GetTokenDenomsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The base denom (e.g., "uatone", "uosmo", "ustars")
+	BaseDenom string `protobuf:"bytes,1,opt,name=base_denom,json=baseDenom,proto3" json:"base_denom,omitempty"`
+	// The chain where this token is native (e.g., "atomone-1", "osmosis-1")
+	OriginChain string `protobuf:"bytes,2,opt,name=origin_chain,json=originChain,proto3" json:"origin_chain,omitempty"`
+	// Optional: Filter to a specific chain. If empty, returns denoms on all chains
+	OnChainId string `protobuf:"bytes,3,opt,name=on_chain_id,json=onChainId,proto3" json:"on_chain_id,omitempty"`
+}
+
+func (x *GetTokenDenomsRequest) Reset() {
+	*x = GetTokenDenomsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[32]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenDenomsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenDenomsRequest) ProtoMessage() {}
+
+func (x *GetTokenDenomsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[32]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenDenomsRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenDenomsRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetTokenDenomsRequest) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *GetTokenDenomsRequest) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *GetTokenDenomsRequest) GetOnChainId() string {
+	if x != nil {
+		return x.OnChainId
+	}
+	return ""
+}
+
+type GetTokenDenomsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Found       bool   `protobuf:"varint,1,opt,name=found,proto3" json:"found,omitempty"`
+	BaseDenom   string `protobuf:"bytes,2,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
+	OriginChain string `protobuf:"bytes,3,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
+	// All chains where this token is available and what denom it has
+	Denoms []*ChainDenom `protobuf:"bytes,4,rep,name=denoms,proto3" json:"denoms,omitempty"`
+}
+
+func (x *GetTokenDenomsResponse) Reset() {
+	*x = GetTokenDenomsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[33]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetTokenDenomsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTokenDenomsResponse) ProtoMessage() {}
+
+func (x *GetTokenDenomsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[33]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTokenDenomsResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenDenomsResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *GetTokenDenomsResponse) GetFound() bool {
+	if x != nil {
+		return x.Found
+	}
+	return false
+}
+
+func (x *GetTokenDenomsResponse) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *GetTokenDenomsResponse) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *GetTokenDenomsResponse) GetDenoms() []*ChainDenom {
+	if x != nil {
+		return x.Denoms
+	}
+	return nil
+}
+
+// GetChainTokensRequest - Get all tokens on a chain
+type GetChainTokensRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+}
+
+func (x *GetChainTokensRequest) Reset() {
+	*x = GetChainTokensRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[34]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainTokensRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainTokensRequest) ProtoMessage() {}
+
+func (x *GetChainTokensRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[34]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainTokensRequest.ProtoReflect.Descriptor instead.
+func (*GetChainTokensRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetChainTokensRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+type GetChainTokensResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	ChainName string `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
+	// Native tokens on this chain
+	NativeTokens []*TokenDetails `protobuf:"bytes,3,rep,name=native_tokens,proto3" json:"native_tokens,omitempty"`
+	// IBC tokens received on this chain
+	IbcTokens []*TokenDetails `protobuf:"bytes,4,rep,name=ibc_tokens,proto3" json:"ibc_tokens,omitempty"`
+}
+
+func (x *GetChainTokensResponse) Reset() {
+	*x = GetChainTokensResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[35]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetChainTokensResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetChainTokensResponse) ProtoMessage() {}
+
+func (x *GetChainTokensResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[35]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetChainTokensResponse.ProtoReflect.Descriptor instead.
+func (*GetChainTokensResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *GetChainTokensResponse) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *GetChainTokensResponse) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *GetChainTokensResponse) GetNativeTokens() []*TokenDetails {
+	if x != nil {
+		return x.NativeTokens
+	}
+	return nil
+}
+
+func (x *GetChainTokensResponse) GetIbcTokens() []*TokenDetails {
+	if x != nil {
+		return x.IbcTokens
+	}
+	return nil
+}
+
+// TokenDetails - Full token information
+type TokenDetails struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Denom on this chain (native or IBC hash)
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Human-readable symbol (e.g., "ATONE", "OSMO")
+	Symbol string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Base denom on origin chain
+	BaseDenom string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
+	// Chain where token is native
+	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
+	// Number of decimals
+	Decimals int32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// True if native to this chain
+	IsNative bool `protobuf:"varint,6,opt,name=is_native,proto3" json:"is_native,omitempty"`
+}
+
+func (x *TokenDetails) Reset() {
+	*x = TokenDetails{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[36]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenDetails) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenDetails) ProtoMessage() {}
+
+func (x *TokenDetails) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[36]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenDetails.ProtoReflect.Descriptor instead.
+func (*TokenDetails) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *TokenDetails) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+func (x *TokenDetails) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *TokenDetails) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *TokenDetails) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *TokenDetails) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenDetails) GetIsNative() bool {
+	if x != nil {
+		return x.IsNative
+	}
+	return false
+}
+
+type PathfinderSupportedChainsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainIds []string `protobuf:"bytes,1,rep,name=chain_ids,proto3" json:"chain_ids,omitempty"`
+}
+
+func (x *PathfinderSupportedChainsResponse) Reset() {
+	*x = PathfinderSupportedChainsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[37]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PathfinderSupportedChainsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PathfinderSupportedChainsResponse) ProtoMessage() {}
+
+func (x *PathfinderSupportedChainsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[37]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PathfinderSupportedChainsResponse.ProtoReflect.Descriptor instead.
+func (*PathfinderSupportedChainsResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PathfinderSupportedChainsResponse) GetChainIds() []string {
+	if x != nil {
+		return x.ChainIds
+	}
+	return nil
+}
+
+type ChainInfoRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The chain id of the chain to get information for
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	// Whether to show the symbols of the tokens on the chain, default is false
+	ShowSymbols bool `protobuf:"varint,2,opt,name=show_symbols,json=showSymbols,proto3" json:"show_symbols,omitempty"`
+}
+
+func (x *ChainInfoRequest) Reset() {
+	*x = ChainInfoRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[38]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainInfoRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainInfoRequest) ProtoMessage() {}
+
+func (x *ChainInfoRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[38]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainInfoRequest.ProtoReflect.Descriptor instead.
+func (*ChainInfoRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *ChainInfoRequest) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainInfoRequest) GetShowSymbols() bool {
+	if x != nil {
+		return x.ShowSymbols
+	}
+	return false
+}
+
+type ChainInfoResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainInfo *ChainInfo `protobuf:"bytes,1,opt,name=chain_info,proto3" json:"chain_info,omitempty"`
+}
+
+func (x *ChainInfoResponse) Reset() {
+	*x = ChainInfoResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[39]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainInfoResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainInfoResponse) ProtoMessage() {}
+
+func (x *ChainInfoResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[39]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainInfoResponse.ProtoReflect.Descriptor instead.
+func (*ChainInfoResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ChainInfoResponse) GetChainInfo() *ChainInfo {
+	if x != nil {
+		return x.ChainInfo
+	}
+	return nil
+}
+
+type ChainInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainId   string        `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	ChainName string        `protobuf:"bytes,2,opt,name=chain_name,proto3" json:"chain_name,omitempty"`
+	HasPfm    bool          `protobuf:"varint,3,opt,name=has_pfm,proto3" json:"has_pfm,omitempty"`
+	IsBroker  bool          `protobuf:"varint,4,opt,name=is_broker,proto3" json:"is_broker,omitempty"`
+	Routes    []*BasicRoute `protobuf:"bytes,5,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *ChainInfo) Reset() {
+	*x = ChainInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[40]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ChainInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChainInfo) ProtoMessage() {}
+
+func (x *ChainInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[40]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChainInfo.ProtoReflect.Descriptor instead.
+func (*ChainInfo) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ChainInfo) GetChainId() string {
+	if x != nil {
+		return x.ChainId
+	}
+	return ""
+}
+
+func (x *ChainInfo) GetChainName() string {
+	if x != nil {
+		return x.ChainName
+	}
+	return ""
+}
+
+func (x *ChainInfo) GetHasPfm() bool {
+	if x != nil {
+		return x.HasPfm
+	}
+	return false
+}
+
+func (x *ChainInfo) GetIsBroker() bool {
+	if x != nil {
+		return x.IsBroker
+	}
+	return false
+}
+
+func (x *ChainInfo) GetRoutes() []*BasicRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+type TokenInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ChainDenom  string `protobuf:"bytes,1,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
+	IbcDenom    string `protobuf:"bytes,2,opt,name=ibc_denom,proto3" json:"ibc_denom,omitempty"`
+	BaseDenom   string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
+	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
+	Decimals    int32  `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	Symbol      string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+}
+
+func (x *TokenInfo) Reset() {
+	*x = TokenInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[41]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *TokenInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenInfo) ProtoMessage() {}
+
+func (x *TokenInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[41]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
+func (*TokenInfo) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *TokenInfo) GetChainDenom() string {
+	if x != nil {
+		return x.ChainDenom
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetIbcDenom() string {
+	if x != nil {
+		return x.IbcDenom
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetBaseDenom() string {
+	if x != nil {
+		return x.BaseDenom
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetOriginChain() string {
+	if x != nil {
+		return x.OriginChain
+	}
+	return ""
+}
+
+func (x *TokenInfo) GetDecimals() int32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *TokenInfo) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+type BasicRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToChain       string                `protobuf:"bytes,1,opt,name=to_chain,proto3" json:"to_chain,omitempty"`
+	ToChainId     string                `protobuf:"bytes,2,opt,name=to_chain_id,proto3" json:"to_chain_id,omitempty"`
+	ConnectionId  string                `protobuf:"bytes,3,opt,name=connection_id,proto3" json:"connection_id,omitempty"`
+	ChannelId     string                `protobuf:"bytes,4,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
+	PortId        string                `protobuf:"bytes,5,opt,name=port_id,proto3" json:"port_id,omitempty"`
+	AllowedTokens map[string]*TokenInfo `protobuf:"bytes,6,rep,name=allowed_tokens,proto3" json:"allowed_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+}
+
+func (x *BasicRoute) Reset() {
+	*x = BasicRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[42]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BasicRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BasicRoute) ProtoMessage() {}
+
+func (x *BasicRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[42]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BasicRoute.ProtoReflect.Descriptor instead.
+func (*BasicRoute) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *BasicRoute) GetToChain() string {
+	if x != nil {
+		return x.ToChain
+	}
+	return ""
+}
+
+func (x *BasicRoute) GetToChainId() string {
+	if x != nil {
+		return x.ToChainId
+	}
+	return ""
+}
+
+func (x *BasicRoute) GetConnectionId() string {
+	if x != nil {
+		return x.ConnectionId
+	}
+	return ""
+}
+
+func (x *BasicRoute) GetChannelId() string {
+	if x != nil {
+		return x.ChannelId
+	}
+	return ""
+}
+
+func (x *BasicRoute) GetPortId() string {
+	if x != nil {
+		return x.PortId
+	}
+	return ""
+}
+
+func (x *BasicRoute) GetAllowedTokens() map[string]*TokenInfo {
+	if x != nil {
+		return x.AllowedTokens
+	}
+	return nil
+}
+
+type WasmData struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Contract string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	Msg      *WasmMsg `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (x *WasmData) Reset() {
+	*x = WasmData{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[43]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WasmData) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WasmData) ProtoMessage() {}
+
+func (x *WasmData) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[43]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WasmData.ProtoReflect.Descriptor instead.
+func (*WasmData) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *WasmData) GetContract() string {
+	if x != nil {
+		return x.Contract
+	}
+	return ""
+}
+
+func (x *WasmData) GetMsg() *WasmMsg {
+	if x != nil {
+		return x.Msg
+	}
+	return nil
+}
+
+type WasmMsg struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapAndAction *SwapAndAction `protobuf:"bytes,1,opt,name=swap_and_action,proto3" json:"swap_and_action,omitempty"`
+}
+
+func (x *WasmMsg) Reset() {
+	*x = WasmMsg{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[44]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *WasmMsg) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WasmMsg) ProtoMessage() {}
+
+func (x *WasmMsg) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[44]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WasmMsg.ProtoReflect.Descriptor instead.
+func (*WasmMsg) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *WasmMsg) GetSwapAndAction() *SwapAndAction {
+	if x != nil {
+		return x.SwapAndAction
+	}
+	return nil
+}
+
+type SwapAndAction struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// SwapAndAction is the entry point contract message structure
+	UserSwap         *UserSwap       `protobuf:"bytes,1,opt,name=user_swap,proto3" json:"user_swap,omitempty"`
+	MinAsset         *MinAsset       `protobuf:"bytes,2,opt,name=min_asset,proto3" json:"min_asset,omitempty"`
+	TimeoutTimestamp int64           `protobuf:"varint,3,opt,name=timeout_timestamp,proto3" json:"timeout_timestamp,omitempty"`
+	PostSwapAction   *PostSwapAction `protobuf:"bytes,4,opt,name=post_swap_action,proto3" json:"post_swap_action,omitempty"`
+	Affiliates       []string        `protobuf:"bytes,5,rep,name=affiliates,proto3" json:"affiliates,omitempty"`
+}
+
+func (x *SwapAndAction) Reset() {
+	*x = SwapAndAction{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[45]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapAndAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapAndAction) ProtoMessage() {}
+
+func (x *SwapAndAction) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[45]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapAndAction.ProtoReflect.Descriptor instead.
+func (*SwapAndAction) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *SwapAndAction) GetUserSwap() *UserSwap {
+	if x != nil {
+		return x.UserSwap
+	}
+	return nil
+}
+
+func (x *SwapAndAction) GetMinAsset() *MinAsset {
+	if x != nil {
+		return x.MinAsset
+	}
+	return nil
+}
+
+func (x *SwapAndAction) GetTimeoutTimestamp() int64 {
+	if x != nil {
+		return x.TimeoutTimestamp
+	}
+	return 0
+}
+
+func (x *SwapAndAction) GetPostSwapAction() *PostSwapAction {
+	if x != nil {
+		return x.PostSwapAction
+	}
+	return nil
+}
+
+func (x *SwapAndAction) GetAffiliates() []string {
+	if x != nil {
+		return x.Affiliates
+	}
+	return nil
+}
+
+type SwapExactAssetIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapVenueName string           `protobuf:"bytes,1,opt,name=swap_venue_name,proto3" json:"swap_venue_name,omitempty"`
+	Operations    []*SwapOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *SwapExactAssetIn) Reset() {
+	*x = SwapExactAssetIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[46]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapExactAssetIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapExactAssetIn) ProtoMessage() {}
+
+func (x *SwapExactAssetIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[46]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapExactAssetIn.ProtoReflect.Descriptor instead.
+func (*SwapExactAssetIn) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *SwapExactAssetIn) GetSwapVenueName() string {
+	if x != nil {
+		return x.SwapVenueName
+	}
+	return ""
+}
+
+func (x *SwapExactAssetIn) GetOperations() []*SwapOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+// SwapExactAssetOut swaps for an exact output, the unused input goes to the refund address
+type SwapExactAssetOut struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapVenueName string           `protobuf:"bytes,1,opt,name=swap_venue_name,proto3" json:"swap_venue_name,omitempty"`
+	Operations    []*SwapOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	RefundAddress string           `protobuf:"bytes,3,opt,name=refund_address,proto3" json:"refund_address,omitempty"`
+}
+
+func (x *SwapExactAssetOut) Reset() {
+	*x = SwapExactAssetOut{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[47]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapExactAssetOut) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapExactAssetOut) ProtoMessage() {}
+
+func (x *SwapExactAssetOut) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[47]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapExactAssetOut.ProtoReflect.Descriptor instead.
+func (*SwapExactAssetOut) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *SwapExactAssetOut) GetSwapVenueName() string {
+	if x != nil {
+		return x.SwapVenueName
+	}
+	return ""
+}
+
+func (x *SwapExactAssetOut) GetOperations() []*SwapOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+func (x *SwapExactAssetOut) GetRefundAddress() string {
+	if x != nil {
+		return x.RefundAddress
+	}
+	return ""
+}
+
+// SmartSwapExactAssetIn splits the input over several routes
+// The outputs of all routes are summed and checked against the min_asset
+type SmartSwapExactAssetIn struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	SwapVenueName string       `protobuf:"bytes,1,opt,name=swap_venue_name,proto3" json:"swap_venue_name,omitempty"`
+	Routes        []*SwapRoute `protobuf:"bytes,2,rep,name=routes,proto3" json:"routes,omitempty"`
+}
+
+func (x *SmartSwapExactAssetIn) Reset() {
+	*x = SmartSwapExactAssetIn{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[48]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SmartSwapExactAssetIn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SmartSwapExactAssetIn) ProtoMessage() {}
+
+func (x *SmartSwapExactAssetIn) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[48]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SmartSwapExactAssetIn.ProtoReflect.Descriptor instead.
+func (*SmartSwapExactAssetIn) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *SmartSwapExactAssetIn) GetSwapVenueName() string {
+	if x != nil {
+		return x.SwapVenueName
+	}
+	return ""
+}
+
+func (x *SmartSwapExactAssetIn) GetRoutes() []*SwapRoute {
+	if x != nil {
+		return x.Routes
+	}
+	return nil
+}
+
+// SwapRoute is a single route of a split swap
+type SwapRoute struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// The part of the input swapped through this route
+	OfferAsset *OfferAsset      `protobuf:"bytes,1,opt,name=offer_asset,proto3" json:"offer_asset,omitempty"`
+	Operations []*SwapOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+}
+
+func (x *SwapRoute) Reset() {
+	*x = SwapRoute{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[49]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapRoute) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapRoute) ProtoMessage() {}
+
+func (x *SwapRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[49]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapRoute.ProtoReflect.Descriptor instead.
+func (*SwapRoute) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *SwapRoute) GetOfferAsset() *OfferAsset {
+	if x != nil {
+		return x.OfferAsset
+	}
+	return nil
+}
+
+func (x *SwapRoute) GetOperations() []*SwapOperation {
+	if x != nil {
+		return x.Operations
+	}
+	return nil
+}
+
+type OfferAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Native *Asset `protobuf:"bytes,1,opt,name=native,proto3" json:"native,omitempty"`
+}
+
+func (x *OfferAsset) Reset() {
+	*x = OfferAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[50]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *OfferAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OfferAsset) ProtoMessage() {}
+
+func (x *OfferAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[50]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OfferAsset.ProtoReflect.Descriptor instead.
+func (*OfferAsset) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *OfferAsset) GetNative() *Asset {
+	if x != nil {
+		return x.Native
+	}
+	return nil
+}
+
+type SwapOperation struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Pool     string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
+	DenomIn  string `protobuf:"bytes,2,opt,name=denom_in,proto3" json:"denom_in,omitempty"`
+	DenomOut string `protobuf:"bytes,3,opt,name=denom_out,proto3" json:"denom_out,omitempty"`
+	// Interface is used by some DEXs (like Injective), optional
+	Interface *string `protobuf:"bytes,4,opt,name=interface,proto3,oneof" json:"interface,omitempty"`
+}
+
+func (x *SwapOperation) Reset() {
+	*x = SwapOperation{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SwapOperation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapOperation) ProtoMessage() {}
+
+func (x *SwapOperation) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapOperation.ProtoReflect.Descriptor instead.
+func (*SwapOperation) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *SwapOperation) GetPool() string {
+	if x != nil {
+		return x.Pool
+	}
+	return ""
+}
+
+func (x *SwapOperation) GetDenomIn() string {
+	if x != nil {
+		return x.DenomIn
+	}
+	return ""
+}
+
+func (x *SwapOperation) GetDenomOut() string {
+	if x != nil {
+		return x.DenomOut
+	}
+	return ""
+}
+
+func (x *SwapOperation) GetInterface() string {
+	if x != nil && x.Interface != nil {
+		return *x.Interface
+	}
+	return ""
+}
+
+type MinAsset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Native *Asset `protobuf:"bytes,1,opt,name=native,proto3" json:"native,omitempty"`
+}
+
+func (x *MinAsset) Reset() {
+	*x = MinAsset{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MinAsset) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MinAsset) ProtoMessage() {}
+
+func (x *MinAsset) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MinAsset.ProtoReflect.Descriptor instead.
+func (*MinAsset) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *MinAsset) GetNative() *Asset {
+	if x != nil {
+		return x.Native
+	}
+	return nil
+}
+
+type Asset struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (x *Asset) Reset() {
+	*x = Asset{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[23]
+		mi := &file_pathfinder_route_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *ChainInfo) String() string {
+func (x *Asset) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ChainInfo) ProtoMessage() {}
+func (*Asset) ProtoMessage() {}
 
-func (x *ChainInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[23]
+func (x *Asset) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1882,76 +4083,54 @@ func (x *ChainInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ChainInfo.ProtoReflect.Descriptor instead.
-func (*ChainInfo) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{23}
+// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
+func (*Asset) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *ChainInfo) GetChainId() string {
+func (x *Asset) GetAmount() string {
 	if x != nil {
-		return x.ChainId
+		return x.Amount
 	}
 	return ""
 }
 
-func (x *ChainInfo) GetChainName() string {
+func (x *Asset) GetDenom() string {
 	if x != nil {
-		return x.ChainName
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *ChainInfo) GetHasPfm() bool {
-	if x != nil {
-		return x.HasPfm
-	}
-	return false
-}
-
-func (x *ChainInfo) GetIsBroker() bool {
-	if x != nil {
-		return x.IsBroker
-	}
-	return false
-}
-
-func (x *ChainInfo) GetRoutes() []*BasicRoute {
-	if x != nil {
-		return x.Routes
-	}
-	return nil
-}
-
-type TokenInfo struct {
+type PostSwapAction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ChainDenom  string `protobuf:"bytes,1,opt,name=chain_denom,proto3" json:"chain_denom,omitempty"`
-	IbcDenom    string `protobuf:"bytes,2,opt,name=ibc_denom,proto3" json:"ibc_denom,omitempty"`
-	BaseDenom   string `protobuf:"bytes,3,opt,name=base_denom,proto3" json:"base_denom,omitempty"`
-	OriginChain string `protobuf:"bytes,4,opt,name=origin_chain,proto3" json:"origin_chain,omitempty"`
-	Decimals    int32  `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
-	Symbol      string `protobuf:"bytes,6,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Types that are assignable to Action:
+	//
+	//	*PostSwapAction_IbcTransfer
+	//	*PostSwapAction_Transfer
+	Action isPostSwapAction_Action `protobuf_oneof:"action"`
 }
 
-func (x *TokenInfo) Reset() {
-	*x = TokenInfo{}
+func (x *PostSwapAction) Reset() {
+	*x = PostSwapAction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[24]
+		mi := &file_pathfinder_route_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *TokenInfo) String() string {
+func (x *PostSwapAction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*TokenInfo) ProtoMessage() {}
+func (*PostSwapAction) ProtoMessage() {}
 
-func (x *TokenInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[24]
+func (x *PostSwapAction) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1962,83 +4141,73 @@ func (x *TokenInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use TokenInfo.ProtoReflect.Descriptor instead.
-func (*TokenInfo) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{24}
+// Deprecated: Use PostSwapAction.ProtoReflect.Descriptor instead.
+func (*PostSwapAction) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *TokenInfo) GetChainDenom() string {
-	if x != nil {
-		return x.ChainDenom
+func (m *PostSwapAction) GetAction() isPostSwapAction_Action {
+	if m != nil {
+		return m.Action
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenInfo) GetIbcDenom() string {
-	if x != nil {
-		return x.IbcDenom
+func (x *PostSwapAction) GetIbcTransfer() *IBCTransfer {
+	if x, ok := x.GetAction().(*PostSwapAction_IbcTransfer); ok {
+		return x.IbcTransfer
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenInfo) GetBaseDenom() string {
-	if x != nil {
-		return x.BaseDenom
+func (x *PostSwapAction) GetTransfer() *Transfer {
+	if x, ok := x.GetAction().(*PostSwapAction_Transfer); ok {
+		return x.Transfer
 	}
-	return ""
+	return nil
 }
 
-func (x *TokenInfo) GetOriginChain() string {
-	if x != nil {
-		return x.OriginChain
-	}
-	return ""
+type isPostSwapAction_Action interface {
+	isPostSwapAction_Action()
 }
 
-func (x *TokenInfo) GetDecimals() int32 {
-	if x != nil {
-		return x.Decimals
-	}
-	return 0
+type PostSwapAction_IbcTransfer struct {
+	IbcTransfer *IBCTransfer `protobuf:"bytes,1,opt,name=ibc_transfer,proto3,oneof"`
 }
 
-func (x *TokenInfo) GetSymbol() string {
-	if x != nil {
-		return x.Symbol
-	}
-	return ""
+type PostSwapAction_Transfer struct {
+	Transfer *Transfer `protobuf:"bytes,2,opt,name=transfer,proto3,oneof"`
 }
 
-type BasicRoute struct {
+func (*PostSwapAction_IbcTransfer) isPostSwapAction_Action() {}
+
+func (*PostSwapAction_Transfer) isPostSwapAction_Action() {}
+
+type IBCTransfer struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ToChain       string                `protobuf:"bytes,1,opt,name=to_chain,proto3" json:"to_chain,omitempty"`
-	ToChainId     string                `protobuf:"bytes,2,opt,name=to_chain_id,proto3" json:"to_chain_id,omitempty"`
-	ConnectionId  string                `protobuf:"bytes,3,opt,name=connection_id,proto3" json:"connection_id,omitempty"`
-	ChannelId     string                `protobuf:"bytes,4,opt,name=channel_id,proto3" json:"channel_id,omitempty"`
-	PortId        string                `protobuf:"bytes,5,opt,name=port_id,proto3" json:"port_id,omitempty"`
-	AllowedTokens map[string]*TokenInfo `protobuf:"bytes,6,rep,name=allowed_tokens,proto3" json:"allowed_tokens,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	IbcInfo *IBCInfo `protobuf:"bytes,1,opt,name=ibc_info,proto3" json:"ibc_info,omitempty"`
 }
 
-func (x *BasicRoute) Reset() {
-	*x = BasicRoute{}
+func (x *IBCTransfer) Reset() {
+	*x = IBCTransfer{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[25]
+		mi := &file_pathfinder_route_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *BasicRoute) String() string {
+func (x *IBCTransfer) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*BasicRoute) ProtoMessage() {}
+func (*IBCTransfer) ProtoMessage() {}
 
-func (x *BasicRoute) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[25]
+func (x *IBCTransfer) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2049,79 +4218,93 @@ func (x *BasicRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use BasicRoute.ProtoReflect.Descriptor instead.
-func (*BasicRoute) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{25}
+// Deprecated: Use IBCTransfer.ProtoReflect.Descriptor instead.
+func (*IBCTransfer) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *BasicRoute) GetToChain() string {
+func (x *IBCTransfer) GetIbcInfo() *IBCInfo {
 	if x != nil {
-		return x.ToChain
+		return x.IbcInfo
 	}
-	return ""
+	return nil
 }
 
-func (x *BasicRoute) GetToChainId() string {
-	if x != nil {
-		return x.ToChainId
-	}
-	return ""
+type Transfer struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	ToAddress string `protobuf:"bytes,1,opt,name=to_address,proto3" json:"to_address,omitempty"`
 }
 
-func (x *BasicRoute) GetConnectionId() string {
-	if x != nil {
-		return x.ConnectionId
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *BasicRoute) GetChannelId() string {
-	if x != nil {
-		return x.ChannelId
-	}
-	return ""
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *BasicRoute) GetPortId() string {
-	if x != nil {
-		return x.PortId
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *BasicRoute) GetAllowedTokens() map[string]*TokenInfo {
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *Transfer) GetToAddress() string {
 	if x != nil {
-		return x.AllowedTokens
+		return x.ToAddress
 	}
-	return nil
+	return ""
 }
 
-type WasmData struct {
+type IBCInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Contract string   `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
-	Msg      *WasmMsg `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	Memo           string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	Receiver       string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	RecoverAddress string `protobuf:"bytes,3,opt,name=recover_address,proto3" json:"recover_address,omitempty"`
+	SourceChannel  string `protobuf:"bytes,4,opt,name=source_channel,proto3" json:"source_channel,omitempty"`
 }
 
-func (x *WasmData) Reset() {
-	*x = WasmData{}
+func (x *IBCInfo) Reset() {
+	*x = IBCInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[26]
+		mi := &file_pathfinder_route_proto_msgTypes[57]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WasmData) String() string {
+func (x *IBCInfo) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WasmData) ProtoMessage() {}
+func (*IBCInfo) ProtoMessage() {}
 
-func (x *WasmData) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[26]
+func (x *IBCInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[57]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2132,50 +4315,67 @@ func (x *WasmData) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WasmData.ProtoReflect.Descriptor instead.
-func (*WasmData) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{26}
+// Deprecated: Use IBCInfo.ProtoReflect.Descriptor instead.
+func (*IBCInfo) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{57}
 }
 
-func (x *WasmData) GetContract() string {
+func (x *IBCInfo) GetMemo() string {
 	if x != nil {
-		return x.Contract
+		return x.Memo
 	}
 	return ""
 }
 
-func (x *WasmData) GetMsg() *WasmMsg {
+func (x *IBCInfo) GetReceiver() string {
 	if x != nil {
-		return x.Msg
+		return x.Receiver
 	}
-	return nil
+	return ""
+}
+
+func (x *IBCInfo) GetRecoverAddress() string {
+	if x != nil {
+		return x.RecoverAddress
+	}
+	return ""
+}
+
+func (x *IBCInfo) GetSourceChannel() string {
+	if x != nil {
+		return x.SourceChannel
+	}
+	return ""
 }
 
-type WasmMsg struct {
+type UserSwap struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SwapAndAction *SwapAndAction `protobuf:"bytes,1,opt,name=swap_and_action,proto3" json:"swap_and_action,omitempty"`
+	// Only one of the swaps is set
+	SwapExactAssetIn      *SwapExactAssetIn      `protobuf:"bytes,1,opt,name=swap_exact_asset_in,proto3" json:"swap_exact_asset_in,omitempty"`
+	SwapExactAssetOut     *SwapExactAssetOut     `protobuf:"bytes,2,opt,name=swap_exact_asset_out,proto3" json:"swap_exact_asset_out,omitempty"`
+	SmartSwapExactAssetIn *SmartSwapExactAssetIn `protobuf:"bytes,3,opt,name=smart_swap_exact_asset_in,proto3" json:"smart_swap_exact_asset_in,omitempty"`
 }
 
-func (x *WasmMsg) Reset() {
-	*x = WasmMsg{}
+func (x *UserSwap) Reset() {
+	*x = UserSwap{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[27]
+		mi := &file_pathfinder_route_proto_msgTypes[58]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *WasmMsg) String() string {
+func (x *UserSwap) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*WasmMsg) ProtoMessage() {}
+func (*UserSwap) ProtoMessage() {}
 
-func (x *WasmMsg) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[27]
+func (x *UserSwap) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[58]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2186,48 +4386,71 @@ func (x *WasmMsg) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use WasmMsg.ProtoReflect.Descriptor instead.
-func (*WasmMsg) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{27}
+// Deprecated: Use UserSwap.ProtoReflect.Descriptor instead.
+func (*UserSwap) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{58}
 }
 
-func (x *WasmMsg) GetSwapAndAction() *SwapAndAction {
+func (x *UserSwap) GetSwapExactAssetIn() *SwapExactAssetIn {
 	if x != nil {
-		return x.SwapAndAction
+		return x.SwapExactAssetIn
 	}
 	return nil
 }
 
-type SwapAndAction struct {
+func (x *UserSwap) GetSwapExactAssetOut() *SwapExactAssetOut {
+	if x != nil {
+		return x.SwapExactAssetOut
+	}
+	return nil
+}
+
+func (x *UserSwap) GetSmartSwapExactAssetIn() *SmartSwapExactAssetIn {
+	if x != nil {
+		return x.SmartSwapExactAssetIn
+	}
+	return nil
+}
+
+// BuildTransactionRequest - Build the unsigned transactions of a route
+//
+// Broker swaps are executed with their execution data, find them with smart_route set.
+type BuildTransactionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// SwapAndAction is the entry point contract message structure
-	UserSwap         *UserSwap       `protobuf:"bytes,1,opt,name=user_swap,proto3" json:"user_swap,omitempty"`
-	MinAsset         *MinAsset       `protobuf:"bytes,2,opt,name=min_asset,proto3" json:"min_asset,omitempty"`
-	TimeoutTimestamp int64           `protobuf:"varint,3,opt,name=timeout_timestamp,proto3" json:"timeout_timestamp,omitempty"`
-	PostSwapAction   *PostSwapAction `protobuf:"bytes,4,opt,name=post_swap_action,proto3" json:"post_swap_action,omitempty"`
-	Affiliates       []string        `protobuf:"bytes,5,rep,name=affiliates,proto3" json:"affiliates,omitempty"`
-}
-
-func (x *SwapAndAction) Reset() {
-	*x = SwapAndAction{}
+	// The route to execute as returned by FindPath or FindPaths
+	Route *FindPathResponse `protobuf:"bytes,1,opt,name=route,proto3" json:"route,omitempty"`
+	// Sender address on the source chain of the route
+	// Signers and intermediate receivers on other chains are derived from it
+	SenderAddress string `protobuf:"bytes,2,opt,name=sender_address,json=senderAddress,proto3" json:"sender_address,omitempty"`
+	// Receiver address on the destination chain
+	// Required for direct and indirect routes, broker swaps carry the receiver in their execution data
+	ReceiverAddress string `protobuf:"bytes,3,opt,name=receiver_address,json=receiverAddress,proto3" json:"receiver_address,omitempty"`
+	// IBC timeout of the transfers, 15 minutes from now if not set
+	Timeout *TransactionTimeout `protobuf:"bytes,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// Memo of the transactions, not to be confused with the IBC memo
+	Memo string `protobuf:"bytes,5,opt,name=memo,proto3" json:"memo,omitempty"`
+}
+
+func (x *BuildTransactionRequest) Reset() {
+	*x = BuildTransactionRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[28]
+		mi := &file_pathfinder_route_proto_msgTypes[59]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapAndAction) String() string {
+func (x *BuildTransactionRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapAndAction) ProtoMessage() {}
+func (*BuildTransactionRequest) ProtoMessage() {}
 
-func (x *SwapAndAction) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[28]
+func (x *BuildTransactionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[59]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2238,72 +4461,77 @@ func (x *SwapAndAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapAndAction.ProtoReflect.Descriptor instead.
-func (*SwapAndAction) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{28}
+// Deprecated: Use BuildTransactionRequest.ProtoReflect.Descriptor instead.
+func (*BuildTransactionRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{59}
 }
 
-func (x *SwapAndAction) GetUserSwap() *UserSwap {
+func (x *BuildTransactionRequest) GetRoute() *FindPathResponse {
 	if x != nil {
-		return x.UserSwap
+		return x.Route
 	}
 	return nil
 }
 
-func (x *SwapAndAction) GetMinAsset() *MinAsset {
+func (x *BuildTransactionRequest) GetSenderAddress() string {
 	if x != nil {
-		return x.MinAsset
+		return x.SenderAddress
 	}
-	return nil
+	return ""
 }
 
-func (x *SwapAndAction) GetTimeoutTimestamp() int64 {
+func (x *BuildTransactionRequest) GetReceiverAddress() string {
 	if x != nil {
-		return x.TimeoutTimestamp
+		return x.ReceiverAddress
 	}
-	return 0
+	return ""
 }
 
-func (x *SwapAndAction) GetPostSwapAction() *PostSwapAction {
+func (x *BuildTransactionRequest) GetTimeout() *TransactionTimeout {
 	if x != nil {
-		return x.PostSwapAction
+		return x.Timeout
 	}
 	return nil
 }
 
-func (x *SwapAndAction) GetAffiliates() []string {
+func (x *BuildTransactionRequest) GetMemo() string {
 	if x != nil {
-		return x.Affiliates
+		return x.Memo
 	}
-	return nil
+	return ""
 }
 
-type SwapExactAssetIn struct {
+// TransactionTimeout - IBC timeout preferences, the same timeout is used for every transfer
+type TransactionTimeout struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	SwapVenueName string           `protobuf:"bytes,1,opt,name=swap_venue_name,proto3" json:"swap_venue_name,omitempty"`
-	Operations    []*SwapOperation `protobuf:"bytes,2,rep,name=operations,proto3" json:"operations,omitempty"`
+	// Absolute timeout in unix nanoseconds
+	Timestamp uint64 `protobuf:"varint,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// Timeout relative to now in seconds, ignored if timestamp is set
+	Seconds uint64 `protobuf:"varint,2,opt,name=seconds,proto3" json:"seconds,omitempty"`
+	// Timeout height on the receiving chain
+	Height *IBCHeight `protobuf:"bytes,3,opt,name=height,proto3" json:"height,omitempty"`
 }
 
-func (x *SwapExactAssetIn) Reset() {
-	*x = SwapExactAssetIn{}
+func (x *TransactionTimeout) Reset() {
+	*x = TransactionTimeout{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[29]
+		mi := &file_pathfinder_route_proto_msgTypes[60]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapExactAssetIn) String() string {
+func (x *TransactionTimeout) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapExactAssetIn) ProtoMessage() {}
+func (*TransactionTimeout) ProtoMessage() {}
 
-func (x *SwapExactAssetIn) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[29]
+func (x *TransactionTimeout) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[60]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2314,54 +4542,58 @@ func (x *SwapExactAssetIn) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapExactAssetIn.ProtoReflect.Descriptor instead.
-func (*SwapExactAssetIn) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{29}
+// Deprecated: Use TransactionTimeout.ProtoReflect.Descriptor instead.
+func (*TransactionTimeout) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{60}
 }
 
-func (x *SwapExactAssetIn) GetSwapVenueName() string {
+func (x *TransactionTimeout) GetTimestamp() uint64 {
 	if x != nil {
-		return x.SwapVenueName
+		return x.Timestamp
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapExactAssetIn) GetOperations() []*SwapOperation {
+func (x *TransactionTimeout) GetSeconds() uint64 {
 	if x != nil {
-		return x.Operations
+		return x.Seconds
+	}
+	return 0
+}
+
+func (x *TransactionTimeout) GetHeight() *IBCHeight {
+	if x != nil {
+		return x.Height
 	}
 	return nil
 }
 
-type SwapOperation struct {
+type IBCHeight struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Pool     string `protobuf:"bytes,1,opt,name=pool,proto3" json:"pool,omitempty"`
-	DenomIn  string `protobuf:"bytes,2,opt,name=denom_in,proto3" json:"denom_in,omitempty"`
-	DenomOut string `protobuf:"bytes,3,opt,name=denom_out,proto3" json:"denom_out,omitempty"`
-	// Interface is used by some DEXs (like Injective), optional
-	Interface *string `protobuf:"bytes,4,opt,name=interface,proto3,oneof" json:"interface,omitempty"`
+	RevisionNumber uint64 `protobuf:"varint,1,opt,name=revision_number,json=revisionNumber,proto3" json:"revision_number,omitempty"`
+	RevisionHeight uint64 `protobuf:"varint,2,opt,name=revision_height,json=revisionHeight,proto3" json:"revision_height,omitempty"`
 }
 
-func (x *SwapOperation) Reset() {
-	*x = SwapOperation{}
+func (x *IBCHeight) Reset() {
+	*x = IBCHeight{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[30]
+		mi := &file_pathfinder_route_proto_msgTypes[61]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *SwapOperation) String() string {
+func (x *IBCHeight) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SwapOperation) ProtoMessage() {}
+func (*IBCHeight) ProtoMessage() {}
 
-func (x *SwapOperation) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[30]
+func (x *IBCHeight) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[61]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2372,64 +4604,52 @@ func (x *SwapOperation) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SwapOperation.ProtoReflect.Descriptor instead.
-func (*SwapOperation) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{30}
-}
-
-func (x *SwapOperation) GetPool() string {
-	if x != nil {
-		return x.Pool
-	}
-	return ""
+// Deprecated: Use IBCHeight.ProtoReflect.Descriptor instead.
+func (*IBCHeight) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{61}
 }
 
-func (x *SwapOperation) GetDenomIn() string {
+func (x *IBCHeight) GetRevisionNumber() uint64 {
 	if x != nil {
-		return x.DenomIn
+		return x.RevisionNumber
 	}
-	return ""
+	return 0
 }
 
-func (x *SwapOperation) GetDenomOut() string {
+func (x *IBCHeight) GetRevisionHeight() uint64 {
 	if x != nil {
-		return x.DenomOut
-	}
-	return ""
-}
-
-func (x *SwapOperation) GetInterface() string {
-	if x != nil && x.Interface != nil {
-		return *x.Interface
+		return x.RevisionHeight
 	}
-	return ""
+	return 0
 }
 
-type MinAsset struct {
+type BuildTransactionResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Native *Asset `protobuf:"bytes,1,opt,name=native,proto3" json:"native,omitempty"`
+	// Transactions in the order they have to be broadcast
+	// A transaction can only be sent once the transfer before it has been received
+	Transactions []*UnsignedTransaction `protobuf:"bytes,1,rep,name=transactions,proto3" json:"transactions,omitempty"`
 }
 
-func (x *MinAsset) Reset() {
-	*x = MinAsset{}
+func (x *BuildTransactionResponse) Reset() {
+	*x = BuildTransactionResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[31]
+		mi := &file_pathfinder_route_proto_msgTypes[62]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *MinAsset) String() string {
+func (x *BuildTransactionResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MinAsset) ProtoMessage() {}
+func (*BuildTransactionResponse) ProtoMessage() {}
 
-func (x *MinAsset) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[31]
+func (x *BuildTransactionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[62]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2440,44 +4660,49 @@ func (x *MinAsset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MinAsset.ProtoReflect.Descriptor instead.
-func (*MinAsset) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{31}
+// Deprecated: Use BuildTransactionResponse.ProtoReflect.Descriptor instead.
+func (*BuildTransactionResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{62}
 }
 
-func (x *MinAsset) GetNative() *Asset {
+func (x *BuildTransactionResponse) GetTransactions() []*UnsignedTransaction {
 	if x != nil {
-		return x.Native
+		return x.Transactions
 	}
 	return nil
 }
 
-type Asset struct {
+// UnsignedTransaction - the messages one signer broadcasts on one chain
+type UnsignedTransaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Amount string `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount,omitempty"`
-	Denom  string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	ChainId  string             `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	Signer   string             `protobuf:"bytes,2,opt,name=signer,proto3" json:"signer,omitempty"`
+	Messages []*UnsignedMessage `protobuf:"bytes,3,rep,name=messages,proto3" json:"messages,omitempty"`
+	Memo     string             `protobuf:"bytes,4,opt,name=memo,proto3" json:"memo,omitempty"`
+	// Encoded cosmos.tx.v1beta1.TxBody, the body_bytes of a SIGN_MODE_DIRECT sign doc
+	BodyBytes []byte `protobuf:"bytes,5,opt,name=body_bytes,proto3" json:"body_bytes,omitempty"`
 }
 
-func (x *Asset) Reset() {
-	*x = Asset{}
+func (x *UnsignedTransaction) Reset() {
+	*x = UnsignedTransaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[32]
+		mi := &file_pathfinder_route_proto_msgTypes[63]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Asset) String() string {
+func (x *UnsignedTransaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Asset) ProtoMessage() {}
+func (*UnsignedTransaction) ProtoMessage() {}
 
-func (x *Asset) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[32]
+func (x *UnsignedTransaction) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[63]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2488,54 +4713,77 @@ func (x *Asset) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Asset.ProtoReflect.Descriptor instead.
-func (*Asset) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{32}
+// Deprecated: Use UnsignedTransaction.ProtoReflect.Descriptor instead.
+func (*UnsignedTransaction) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{63}
 }
 
-func (x *Asset) GetAmount() string {
+func (x *UnsignedTransaction) GetChainId() string {
 	if x != nil {
-		return x.Amount
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *Asset) GetDenom() string {
+func (x *UnsignedTransaction) GetSigner() string {
 	if x != nil {
-		return x.Denom
+		return x.Signer
 	}
 	return ""
 }
 
-type PostSwapAction struct {
+func (x *UnsignedTransaction) GetMessages() []*UnsignedMessage {
+	if x != nil {
+		return x.Messages
+	}
+	return nil
+}
+
+func (x *UnsignedTransaction) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *UnsignedTransaction) GetBodyBytes() []byte {
+	if x != nil {
+		return x.BodyBytes
+	}
+	return nil
+}
+
+// UnsignedMessage - a Cosmos SDK message ready to be signed
+type UnsignedMessage struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	// Types that are assignable to Action:
-	//
-	//	*PostSwapAction_IbcTransfer
-	//	*PostSwapAction_Transfer
-	Action isPostSwapAction_Action `protobuf_oneof:"action"`
+	// e.g. "/ibc.applications.transfer.v1.MsgTransfer" or "/cosmwasm.wasm.v1.MsgExecuteContract"
+	TypeUrl string `protobuf:"bytes,1,opt,name=type_url,proto3" json:"type_url,omitempty"`
+	// Protobuf encoded message, the value of its google.protobuf.Any
+	Value []byte `protobuf:"bytes,2,opt,name=value,proto3" json:"value,omitempty"`
+	// The message as it appears in a SIGN_MODE_LEGACY_AMINO_JSON sign doc
+	AminoJson string `protobuf:"bytes,3,opt,name=amino_json,proto3" json:"amino_json,omitempty"`
 }
 
-func (x *PostSwapAction) Reset() {
-	*x = PostSwapAction{}
+func (x *UnsignedMessage) Reset() {
+	*x = UnsignedMessage{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[33]
+		mi := &file_pathfinder_route_proto_msgTypes[64]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *PostSwapAction) String() string {
+func (x *UnsignedMessage) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostSwapAction) ProtoMessage() {}
+func (*UnsignedMessage) ProtoMessage() {}
 
-func (x *PostSwapAction) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[33]
+func (x *UnsignedMessage) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[64]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2546,73 +4794,107 @@ func (x *PostSwapAction) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PostSwapAction.ProtoReflect.Descriptor instead.
-func (*PostSwapAction) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{33}
+// Deprecated: Use UnsignedMessage.ProtoReflect.Descriptor instead.
+func (*UnsignedMessage) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{64}
 }
 
-func (m *PostSwapAction) GetAction() isPostSwapAction_Action {
-	if m != nil {
-		return m.Action
+func (x *UnsignedMessage) GetTypeUrl() string {
+	if x != nil {
+		return x.TypeUrl
 	}
-	return nil
+	return ""
 }
 
-func (x *PostSwapAction) GetIbcTransfer() *IBCTransfer {
-	if x, ok := x.GetAction().(*PostSwapAction_IbcTransfer); ok {
-		return x.IbcTransfer
+func (x *UnsignedMessage) GetValue() []byte {
+	if x != nil {
+		return x.Value
 	}
 	return nil
 }
 
-func (x *PostSwapAction) GetTransfer() *Transfer {
-	if x, ok := x.GetAction().(*PostSwapAction_Transfer); ok {
-		return x.Transfer
+func (x *UnsignedMessage) GetAminoJson() string {
+	if x != nil {
+		return x.AminoJson
 	}
-	return nil
+	return ""
 }
 
-type isPostSwapAction_Action interface {
-	isPostSwapAction_Action()
+// GetTokenPricesRequest - Get the USD prices of tokens
+type GetTokenPricesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Tokens []*TokenPriceQuery `protobuf:"bytes,1,rep,name=tokens,proto3" json:"tokens,omitempty"`
 }
 
-type PostSwapAction_IbcTransfer struct {
-	IbcTransfer *IBCTransfer `protobuf:"bytes,1,opt,name=ibc_transfer,proto3,oneof"`
+func (x *GetTokenPricesRequest) Reset() {
+	*x = GetTokenPricesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_pathfinder_route_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
 
-type PostSwapAction_Transfer struct {
-	Transfer *Transfer `protobuf:"bytes,2,opt,name=transfer,proto3,oneof"`
+func (x *GetTokenPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PostSwapAction_IbcTransfer) isPostSwapAction_Action() {}
+func (*GetTokenPricesRequest) ProtoMessage() {}
 
-func (*PostSwapAction_Transfer) isPostSwapAction_Action() {}
+func (x *GetTokenPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
 
-type IBCTransfer struct {
+// Deprecated: Use GetTokenPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetTokenPricesRequest) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetTokenPricesRequest) GetTokens() []*TokenPriceQuery {
+	if x != nil {
+		return x.Tokens
+	}
+	return nil
+}
+
+// TokenPriceQuery - a token on a chain, the denom can be human-readable or an IBC denom
+type TokenPriceQuery struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	IbcInfo *IBCInfo `protobuf:"bytes,1,opt,name=ibc_info,proto3" json:"ibc_info,omitempty"`
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,json=chainId,proto3" json:"chain_id,omitempty"`
+	Denom   string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
 }
 
-func (x *IBCTransfer) Reset() {
-	*x = IBCTransfer{}
+func (x *TokenPriceQuery) Reset() {
+	*x = TokenPriceQuery{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[34]
+		mi := &file_pathfinder_route_proto_msgTypes[66]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IBCTransfer) String() string {
+func (x *TokenPriceQuery) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IBCTransfer) ProtoMessage() {}
+func (*TokenPriceQuery) ProtoMessage() {}
 
-func (x *IBCTransfer) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[34]
+func (x *TokenPriceQuery) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[66]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2623,43 +4905,51 @@ func (x *IBCTransfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IBCTransfer.ProtoReflect.Descriptor instead.
-func (*IBCTransfer) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{34}
+// Deprecated: Use TokenPriceQuery.ProtoReflect.Descriptor instead.
+func (*TokenPriceQuery) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{66}
 }
 
-func (x *IBCTransfer) GetIbcInfo() *IBCInfo {
+func (x *TokenPriceQuery) GetChainId() string {
 	if x != nil {
-		return x.IbcInfo
+		return x.ChainId
 	}
-	return nil
+	return ""
 }
 
-type Transfer struct {
+func (x *TokenPriceQuery) GetDenom() string {
+	if x != nil {
+		return x.Denom
+	}
+	return ""
+}
+
+type GetTokenPricesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	ToAddress string `protobuf:"bytes,1,opt,name=to_address,proto3" json:"to_address,omitempty"`
+	// Prices in the order of the requested tokens
+	Prices []*TokenPrice `protobuf:"bytes,1,rep,name=prices,proto3" json:"prices,omitempty"`
 }
 
-func (x *Transfer) Reset() {
-	*x = Transfer{}
+func (x *GetTokenPricesResponse) Reset() {
+	*x = GetTokenPricesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[35]
+		mi := &file_pathfinder_route_proto_msgTypes[67]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Transfer) String() string {
+func (x *GetTokenPricesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Transfer) ProtoMessage() {}
+func (*GetTokenPricesResponse) ProtoMessage() {}
 
-func (x *Transfer) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[35]
+func (x *GetTokenPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[67]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2670,46 +4960,56 @@ func (x *Transfer) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
-func (*Transfer) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{35}
+// Deprecated: Use GetTokenPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetTokenPricesResponse) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{67}
 }
 
-func (x *Transfer) GetToAddress() string {
+func (x *GetTokenPricesResponse) GetPrices() []*TokenPrice {
 	if x != nil {
-		return x.ToAddress
+		return x.Prices
 	}
-	return ""
+	return nil
 }
 
-type IBCInfo struct {
+// TokenPrice - USD price of one whole token
+type TokenPrice struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Memo           string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	Receiver       string `protobuf:"bytes,2,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	RecoverAddress string `protobuf:"bytes,3,opt,name=recover_address,proto3" json:"recover_address,omitempty"`
-	SourceChannel  string `protobuf:"bytes,4,opt,name=source_channel,proto3" json:"source_channel,omitempty"`
-}
-
-func (x *IBCInfo) Reset() {
-	*x = IBCInfo{}
+	ChainId string `protobuf:"bytes,1,opt,name=chain_id,proto3" json:"chain_id,omitempty"`
+	// Resolved denom on the chain
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// Human-readable symbol (e.g., "ATONE", "OSMO")
+	Symbol string `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// Number of decimals of the base unit
+	Decimals int32 `protobuf:"varint,4,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	// Empty if no provider has a price
+	PriceUsd string `protobuf:"bytes,5,opt,name=price_usd,proto3" json:"price_usd,omitempty"`
+	// Price provider the price came from
+	Source string `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	// Why the token has no price
+	Error string `protobuf:"bytes,7,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (x *TokenPrice) Reset() {
+	*x = TokenPrice{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[36]
+		mi := &file_pathfinder_route_proto_msgTypes[68]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *IBCInfo) String() string {
+func (x *TokenPrice) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*IBCInfo) ProtoMessage() {}
+func (*TokenPrice) ProtoMessage() {}
 
-func (x *IBCInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[36]
+func (x *TokenPrice) ProtoReflect() protoreflect.Message {
+	mi := &file_pathfinder_route_proto_msgTypes[68]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2720,84 +5020,58 @@ func (x *IBCInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use IBCInfo.ProtoReflect.Descriptor instead.
-func (*IBCInfo) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use TokenPrice.ProtoReflect.Descriptor instead.
+func (*TokenPrice) Descriptor() ([]byte, []int) {
+	return file_pathfinder_route_proto_rawDescGZIP(), []int{68}
 }
 
-func (x *IBCInfo) GetMemo() string {
+func (x *TokenPrice) GetChainId() string {
 	if x != nil {
-		return x.Memo
+		return x.ChainId
 	}
 	return ""
 }
 
-func (x *IBCInfo) GetReceiver() string {
+func (x *TokenPrice) GetDenom() string {
 	if x != nil {
-		return x.Receiver
+		return x.Denom
 	}
 	return ""
 }
 
-func (x *IBCInfo) GetRecoverAddress() string {
+func (x *TokenPrice) GetSymbol() string {
 	if x != nil {
-		return x.RecoverAddress
+		return x.Symbol
 	}
 	return ""
 }
 
-func (x *IBCInfo) GetSourceChannel() string {
+func (x *TokenPrice) GetDecimals() int32 {
 	if x != nil {
-		return x.SourceChannel
+		return x.Decimals
 	}
-	return ""
-}
-
-type UserSwap struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	SwapExactAssetIn *SwapExactAssetIn `protobuf:"bytes,1,opt,name=swap_exact_asset_in,proto3" json:"swap_exact_asset_in,omitempty"`
+	return 0
 }
 
-func (x *UserSwap) Reset() {
-	*x = UserSwap{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_pathfinder_route_proto_msgTypes[37]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *TokenPrice) GetPriceUsd() string {
+	if x != nil {
+		return x.PriceUsd
 	}
+	return ""
 }
 
-func (x *UserSwap) String() string {
-	return protoimpl.X.MessageStringOf(x)
-}
-
-func (*UserSwap) ProtoMessage() {}
-
-func (x *UserSwap) ProtoReflect() protoreflect.Message {
-	mi := &file_pathfinder_route_proto_msgTypes[37]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *TokenPrice) GetSource() string {
+	if x != nil {
+		return x.Source
 	}
-	return mi.MessageOf(x)
-}
-
-// Deprecated: Use UserSwap.ProtoReflect.Descriptor instead.
-func (*UserSwap) Descriptor() ([]byte, []int) {
-	return file_pathfinder_route_proto_rawDescGZIP(), []int{37}
+	return ""
 }
 
-func (x *UserSwap) GetSwapExactAssetIn() *SwapExactAssetIn {
+func (x *TokenPrice) GetError() string {
 	if x != nil {
-		return x.SwapExactAssetIn
+		return x.Error
 	}
-	return nil
+	return ""
 }
 
 var File_pathfinder_route_proto protoreflect.FileDescriptor
@@ -2809,110 +5083,307 @@ var file_pathfinder_route_proto_rawDesc = []byte{
 	0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2e, 0x70,
 	0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f,
 	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x22, 0xaa, 0x03, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x6f, 0x22, 0xc4, 0x06, 0x0a, 0x0f, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
 	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x66,
 	0x72, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
 	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x46, 0x72, 0x6f, 0x6d, 0x12, 0x37, 0x0a, 0x10,
 	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
 	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05,
 	0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x46, 0x72, 0x6f, 0x6d,
-	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x23, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
-	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
-	0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x49, 0x6e, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x12, 0x2e, 0x0a,
-	0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52,
-	0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x33, 0x0a,
-	0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10,
-	0x26, 0x18, 0x44, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x37, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48,
-	0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x26, 0x18, 0x44, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73,
-	0x6d, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x0a, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0c,
-	0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01,
-	0x28, 0x0d, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x00, 0x2a, 0x05, 0x18, 0x90, 0x4e, 0x28,
-	0x00, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x22, 0x91,
-	0x02, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f,
+	0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x49, 0x6e, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x54, 0x6f, 0x12, 0x2e, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74,
+	0x6f, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xba,
+	0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x54, 0x6f,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f,
+	0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba,
+	0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x26, 0x18, 0x44, 0x52, 0x0d, 0x73, 0x65, 0x6e,
+	0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a, 0x10, 0x72, 0x65,
+	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x26,
+	0x18, 0x44, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0a, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65,
+	0x5f, 0x62, 0x70, 0x73, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8,
+	0x01, 0x00, 0x2a, 0x05, 0x18, 0x90, 0x4e, 0x28, 0x00, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x70,
+	0x61, 0x67, 0x65, 0x42, 0x70, 0x73, 0x12, 0x1d, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x46, 0x0a, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x01, 0x42,
+	0x17, 0xba, 0x48, 0x14, 0x12, 0x12, 0x19, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0xf0, 0x3f, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x00, 0x52, 0x0e, 0x6d, 0x61, 0x78, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x49, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x88, 0x01, 0x01, 0x12, 0x3f, 0x0a,
+	0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x75,
+	0x73, 0x64, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x01, 0x42, 0x0e, 0xba, 0x48, 0x0b, 0x12, 0x09, 0x29,
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x48, 0x01, 0x52, 0x0f, 0x6d, 0x69, 0x6e, 0x4c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x55, 0x73, 0x64, 0x88, 0x01, 0x01, 0x12, 0x27,
+	0x0a, 0x08, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x0d,
+	0x42, 0x07, 0xba, 0x48, 0x04, 0x2a, 0x02, 0x28, 0x01, 0x48, 0x02, 0x52, 0x07, 0x6d, 0x61, 0x78,
+	0x48, 0x6f, 0x70, 0x73, 0x88, 0x01, 0x01, 0x12, 0x40, 0x0a, 0x13, 0x69, 0x62, 0x63, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x0e,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x0b, 0xba, 0x48, 0x08, 0x2a, 0x06, 0x18, 0x80, 0xa3, 0x05, 0x28,
+	0x3c, 0x48, 0x03, 0x52, 0x11, 0x69, 0x62, 0x63, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x53,
+	0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x88, 0x01, 0x01, 0x12, 0x2d, 0x0a, 0x0b, 0x70, 0x66, 0x6d,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x2a, 0x02, 0x18, 0x0a, 0x48, 0x04, 0x52, 0x0a, 0x70, 0x66, 0x6d, 0x52, 0x65,
+	0x74, 0x72, 0x69, 0x65, 0x73, 0x88, 0x01, 0x01, 0x42, 0x13, 0x0a, 0x11, 0x5f, 0x6d, 0x61, 0x78,
+	0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x42, 0x14, 0x0a,
+	0x12, 0x5f, 0x6d, 0x69, 0x6e, 0x5f, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f,
+	0x75, 0x73, 0x64, 0x42, 0x0b, 0x0a, 0x09, 0x5f, 0x6d, 0x61, 0x78, 0x5f, 0x68, 0x6f, 0x70, 0x73,
+	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74,
+	0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x42, 0x0e, 0x0a, 0x0c, 0x5f, 0x70, 0x66, 0x6d,
+	0x5f, 0x72, 0x65, 0x74, 0x72, 0x69, 0x65, 0x73, 0x22, 0x5e, 0x0a, 0x14, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x46, 0x0a, 0x08, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x19, 0x52, 0x08,
+	0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x73, 0x22, 0x55, 0x0a, 0x15, 0x42, 0x61, 0x74, 0x63,
+	0x68, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x3c, 0x0a, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x62, 0x0a, 0x13, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a,
+	0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72,
+	0x72, 0x6f, 0x72, 0x22, 0xcd, 0x02, 0x0a, 0x18, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3e, 0x0a, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x92,
+	0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x08, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x73,
+	0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x74, 0x6f, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x54, 0x6f, 0x12, 0x33, 0x0a, 0x0e, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x74, 0x6f, 0x5f,
+	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a,
+	0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x0c, 0x74, 0x6f, 0x6b, 0x65,
+	0x6e, 0x54, 0x6f, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x33, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64,
+	0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72, 0x04, 0x10, 0x26, 0x18, 0x44, 0x52, 0x0d,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x37, 0x0a,
+	0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72,
+	0x04, 0x10, 0x26, 0x18, 0x44, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2b, 0x0a, 0x0c, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x08, 0xba, 0x48,
+	0x05, 0x2a, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x0b, 0x73, 0x6c, 0x69, 0x70, 0x70, 0x61, 0x67, 0x65,
+	0x42, 0x70, 0x73, 0x22, 0x72, 0x0a, 0x07, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x22,
+	0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x9d, 0x01, 0x0a, 0x19, 0x50, 0x6c, 0x61, 0x6e,
+	0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x39, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x12, 0x45, 0x0a, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x93, 0x01, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x73,
+	0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x30,
+	0x0a, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x16, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x07, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x12, 0x35, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x22, 0x94, 0x01,
+	0x0a, 0x12, 0x50, 0x6c, 0x61, 0x6e, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x73, 0x74, 0x65, 0x70, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0d, 0x52, 0x04, 0x73, 0x74, 0x65, 0x70, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x06, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64, 0x73, 0x5f,
+	0x6f, 0x6e, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0d, 0x52, 0x0a, 0x64, 0x65, 0x70, 0x65, 0x6e, 0x64,
+	0x73, 0x5f, 0x6f, 0x6e, 0x22, 0xde, 0x01, 0x0a, 0x11, 0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75,
+	0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3c, 0x0a, 0x05, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x74, 0x68,
+	0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
+	0x01, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x3a, 0x0a, 0x10, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x0a, 0xba, 0x48, 0x07, 0x2a, 0x05, 0x18, 0xac, 0x02, 0x28, 0x02, 0x48, 0x00,
+	0x52, 0x0f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x53, 0x65, 0x63, 0x6f, 0x6e, 0x64,
+	0x73, 0x88, 0x01, 0x01, 0x12, 0x3a, 0x0a, 0x14, 0x63, 0x68, 0x61, 0x6e, 0x67, 0x65, 0x5f, 0x74,
+	0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x5f, 0x62, 0x70, 0x73, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0d, 0x42, 0x08, 0xba, 0x48, 0x05, 0x2a, 0x03, 0x18, 0x90, 0x4e, 0x52, 0x12, 0x63, 0x68,
+	0x61, 0x6e, 0x67, 0x65, 0x54, 0x68, 0x72, 0x65, 0x73, 0x68, 0x6f, 0x6c, 0x64, 0x42, 0x70, 0x73,
+	0x42, 0x13, 0x0a, 0x11, 0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x76, 0x61, 0x6c, 0x5f, 0x73, 0x65,
+	0x63, 0x6f, 0x6e, 0x64, 0x73, 0x22, 0xd5, 0x03, 0x0a, 0x10, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61,
+	0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75,
+	0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63,
+	0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x72, 0x72,
+	0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x69,
+	0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74,
+	0x12, 0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x42, 0x0a, 0x0b,
+	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70,
+	0x12, 0x2c, 0x0a, 0x04, 0x66, 0x65, 0x65, 0x73, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x52, 0x04, 0x66, 0x65, 0x65, 0x73, 0x12, 0x3b,
+	0x0a, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e,
+	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x65,
+	0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x08, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x63, 0x6f, 0x64, 0x65, 0x12, 0x37, 0x0a, 0x08, 0x77,
+	0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x73, 0x18, 0x09, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x52, 0x6f,
+	0x75, 0x74, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x52, 0x08, 0x77, 0x61, 0x72, 0x6e,
+	0x69, 0x6e, 0x67, 0x73, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x22, 0x3c, 0x0a,
+	0x0c, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x57, 0x61, 0x72, 0x6e, 0x69, 0x6e, 0x67, 0x12, 0x12, 0x0a,
+	0x04, 0x63, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x63, 0x6f, 0x64,
+	0x65, 0x12, 0x18, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xc0, 0x01, 0x0a, 0x0e,
+	0x52, 0x6f, 0x75, 0x74, 0x65, 0x56, 0x61, 0x6c, 0x75, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x24,
+	0x0a, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x5f, 0x75, 0x73, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e,
+	0x5f, 0x75, 0x73, 0x64, 0x12, 0x26, 0x0a, 0x0e, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x75, 0x73, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x75, 0x73, 0x64, 0x12, 0x2e, 0x0a, 0x12, 0x76, 0x61, 0x6c, 0x75,
+	0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x73, 0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x12, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6c, 0x6f, 0x73, 0x73,
+	0x5f, 0x70, 0x65, 0x72, 0x63, 0x65, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x6e, 0x6f, 0x74, 0x65,
+	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x22, 0x53,
+	0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x46, 0x65, 0x65, 0x73, 0x12, 0x30, 0x0a, 0x05, 0x74,
+	0x6f, 0x74, 0x61, 0x6c, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73,
+	0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x52, 0x05, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x6e, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52, 0x05, 0x6e, 0x6f,
+	0x74, 0x65, 0x73, 0x22, 0x87, 0x01, 0x0a, 0x0b, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d,
+	0x61, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12,
+	0x10, 0x0a, 0x03, 0x67, 0x61, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x03, 0x67, 0x61,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x1c, 0x0a, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x09, 0x67, 0x61, 0x73, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x87, 0x01,
+	0x0a, 0x11, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
 	0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x18, 0x01,
 	0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x12, 0x24, 0x0a,
 	0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02,
 	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x5f, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x12, 0x34, 0x0a, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48,
-	0x00, 0x52, 0x06, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x3a, 0x0a, 0x08, 0x69, 0x6e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61,
-	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x6e, 0x64, 0x69,
-	0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x08, 0x69, 0x6e, 0x64,
-	0x69, 0x72, 0x65, 0x63, 0x74, 0x12, 0x42, 0x0a, 0x0b, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x5f,
-	0x73, 0x77, 0x61, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70, 0x61, 0x74,
-	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65,
-	0x72, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x00, 0x52, 0x0b, 0x62, 0x72,
-	0x6f, 0x6b, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x42, 0x07, 0x0a, 0x05, 0x72, 0x6f, 0x75,
-	0x74, 0x65, 0x22, 0x40, 0x0a, 0x0b, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74,
-	0x65, 0x12, 0x31, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e,
-	0x73, 0x66, 0x65, 0x72, 0x22, 0xb8, 0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63,
-	0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01,
-	0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x65,
-	0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66,
-	0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52,
-	0x04, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74,
-	0x73, 0x5f, 0x70, 0x66, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x75, 0x70,
-	0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x66, 0x6d,
-	0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0f, 0x70, 0x66, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x66, 0x6d, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
-	0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x66, 0x6d, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x22,
-	0xc3, 0x02, 0x0a, 0x0f, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x04, 0x70, 0x61, 0x74, 0x68, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75,
-	0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e,
+	0x61, 0x67, 0x65, 0x12, 0x32, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x03, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x52, 0x61, 0x6e, 0x6b, 0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52,
+	0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xa2, 0x02, 0x0a, 0x0b, 0x52, 0x61, 0x6e, 0x6b,
+	0x65, 0x64, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x35, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12,
+	0x0a, 0x04, 0x72, 0x61, 0x6e, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x04, 0x72, 0x61,
+	0x6e, 0x6b, 0x12, 0x14, 0x0a, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x01, 0x52, 0x05, 0x73, 0x63, 0x6f, 0x72, 0x65, 0x12, 0x28, 0x0a, 0x0f, 0x65, 0x78, 0x70, 0x65,
+	0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x65, 0x78, 0x70, 0x65, 0x63, 0x74, 0x65, 0x64, 0x5f, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65,
+	0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x69, 0x73, 0x5f, 0x62, 0x65, 0x73,
+	0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x69,
+	0x73, 0x5f, 0x62, 0x65, 0x73, 0x74, 0x5f, 0x70, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x0a, 0x0a,
+	0x69, 0x73, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x0a, 0x69, 0x73, 0x5f, 0x66, 0x61, 0x73, 0x74, 0x65, 0x73, 0x74, 0x22, 0x40, 0x0a, 0x0b,
+	0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x31, 0x0a, 0x08, 0x74,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e,
 	0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42,
-	0x43, 0x4c, 0x65, 0x67, 0x52, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65,
-	0x67, 0x73, 0x12, 0x2c, 0x0a, 0x04, 0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70,
-	0x12, 0x3b, 0x0a, 0x0d, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67,
-	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
-	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52, 0x0d,
-	0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x34, 0x0a,
-	0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72,
-	0x74, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6f, 0x75,
-	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
-	0x70, 0x66, 0x6d, 0x12, 0x40, 0x0a, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x22, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x45, 0x78, 0x65,
-	0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63,
-	0x75, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0xf1, 0x02, 0x0a, 0x13, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72,
-	0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74, 0x61, 0x12, 0x17, 0x0a,
-	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x04, 0x6d,
-	0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f,
-	0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
-	0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x48, 0x01, 0x52, 0x13,
-	0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65,
-	0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x48, 0x02, 0x52, 0x0c,
-	0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x88, 0x01, 0x01, 0x12,
-	0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65,
-	0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a, 0x11, 0x6d, 0x69, 0x6e,
-	0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
-	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x73, 0x5f,
-	0x77, 0x61, 0x73, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x75, 0x73, 0x65, 0x73,
-	0x5f, 0x77, 0x61, 0x73, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70,
-	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x65, 0x73, 0x63,
-	0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
+	0x43, 0x4c, 0x65, 0x67, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x22, 0xb8,
+	0x01, 0x0a, 0x0d, 0x49, 0x6e, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x12, 0x12, 0x0a, 0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04,
+	0x70, 0x61, 0x74, 0x68, 0x12, 0x29, 0x0a, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x02, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52, 0x04, 0x6c, 0x65, 0x67, 0x73, 0x12,
+	0x22, 0x0a, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0c, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f,
+	0x70, 0x66, 0x6d, 0x12, 0x28, 0x0a, 0x0f, 0x70, 0x66, 0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x70, 0x66,
+	0x6d, 0x5f, 0x73, 0x74, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a,
+	0x08, 0x70, 0x66, 0x6d, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x08, 0x70, 0x66, 0x6d, 0x5f, 0x6d, 0x65, 0x6d, 0x6f, 0x22, 0x8d, 0x03, 0x0a, 0x0f, 0x42, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x12, 0x0a,
+	0x04, 0x70, 0x61, 0x74, 0x68, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x52, 0x04, 0x70, 0x61, 0x74,
+	0x68, 0x12, 0x39, 0x0a, 0x0c, 0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52, 0x0c,
+	0x69, 0x6e, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x2c, 0x0a, 0x04,
+	0x73, 0x77, 0x61, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x51,
+	0x75, 0x6f, 0x74, 0x65, 0x52, 0x04, 0x73, 0x77, 0x61, 0x70, 0x12, 0x3b, 0x0a, 0x0d, 0x6f, 0x75,
+	0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x15, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x49, 0x42, 0x43, 0x4c, 0x65, 0x67, 0x52, 0x0d, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75,
+	0x6e, 0x64, 0x5f, 0x6c, 0x65, 0x67, 0x73, 0x12, 0x34, 0x0a, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f,
+	0x75, 0x6e, 0x64, 0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x66, 0x6d,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x15, 0x6f, 0x75, 0x74, 0x62, 0x6f, 0x75, 0x6e, 0x64,
+	0x5f, 0x73, 0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x12, 0x40, 0x0a,
+	0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x42, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e,
+	0x44, 0x61, 0x74, 0x61, 0x52, 0x09, 0x65, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x12,
+	0x48, 0x0a, 0x12, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x71,
+	0x75, 0x6f, 0x74, 0x65, 0x73, 0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x12, 0x61, 0x6c, 0x74, 0x65, 0x72, 0x6e, 0x61, 0x74, 0x69,
+	0x76, 0x65, 0x5f, 0x71, 0x75, 0x6f, 0x74, 0x65, 0x73, 0x22, 0xfd, 0x03, 0x0a, 0x13, 0x42, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x45, 0x78, 0x65, 0x63, 0x75, 0x74, 0x69, 0x6f, 0x6e, 0x44, 0x61, 0x74,
+	0x61, 0x12, 0x17, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x48,
+	0x00, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x88, 0x01, 0x01, 0x12, 0x4e, 0x0a, 0x13, 0x73, 0x6d,
+	0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x44, 0x61, 0x74, 0x61,
+	0x48, 0x01, 0x52, 0x13, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72, 0x61,
+	0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x88, 0x01, 0x01, 0x12, 0x27, 0x0a, 0x0c, 0x69, 0x62,
+	0x63, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x48, 0x02, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x88, 0x01, 0x01, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65,
+	0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2c, 0x0a,
+	0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x11, 0x6d, 0x69, 0x6e, 0x5f, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x75,
+	0x73, 0x65, 0x73, 0x5f, 0x77, 0x61, 0x73, 0x6d, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x73, 0x5f, 0x77, 0x61, 0x73, 0x6d, 0x12, 0x20, 0x0a, 0x0b, 0x64, 0x65, 0x73,
+	0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b,
+	0x64, 0x65, 0x73, 0x63, 0x72, 0x69, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x0a, 0x10, 0x6d,
+	0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18,
+	0x08, 0x20, 0x01, 0x28, 0x09, 0x52, 0x10, 0x6d, 0x61, 0x78, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x66, 0x65, 0x65, 0x18, 0x09,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65,
+	0x52, 0x03, 0x66, 0x65, 0x65, 0x12, 0x30, 0x0a, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x13, 0x6d, 0x69, 0x6e, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x07, 0x0a, 0x05, 0x5f, 0x6d, 0x65, 0x6d, 0x6f,
 	0x42, 0x16, 0x0a, 0x14, 0x5f, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x74, 0x72,
 	0x61, 0x63, 0x74, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x42, 0x0f, 0x0a, 0x0d, 0x5f, 0x69, 0x62, 0x63,
-	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0xbd, 0x01, 0x0a, 0x06, 0x49, 0x42,
+	0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x22, 0xeb, 0x01, 0x0a, 0x06, 0x49, 0x42,
 	0x43, 0x4c, 0x65, 0x67, 0x12, 0x1e, 0x0a, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63, 0x68, 0x61,
 	0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x63,
 	0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
@@ -2924,302 +5395,466 @@ var file_pathfinder_route_proto_rawDesc = []byte{
 	0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f,
 	0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x05, 0x74, 0x6f, 0x6b, 0x65,
 	0x6e, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a,
-	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x80,
-	0x03, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06,
-	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72,
-	0x6f, 0x6b, 0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e,
-	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70,
-	0x69, 0x6e, 0x67, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x12, 0x39, 0x0a,
-	0x09, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f,
-	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
-	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75,
-	0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f,
-	0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72,
-	0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66,
-	0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65, 0x65,
-	0x12, 0x51, 0x0a, 0x12, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74,
-	0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70,
-	0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d,
-	0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52,
-	0x12, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64,
-	0x61, 0x74, 0x61, 0x42, 0x0c, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
-	0x61, 0x22, 0xa5, 0x01, 0x0a, 0x10, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
-	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f,
-	0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
-	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61,
-	0x70, 0x12, 0x36, 0x0a, 0x16, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63,
-	0x61, 0x70, 0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x16, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70,
-	0x5f, 0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x4f, 0x73,
-	0x6d, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x6f,
-	0x6f, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68,
-	0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69,
-	0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b,
-	0x68, 0x61, 0x73, 0x5f, 0x63, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x08, 0x52, 0x0b, 0x68, 0x61, 0x73, 0x5f, 0x63, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e,
-	0x0a, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c,
-	0x0a, 0x09, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x09, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc5, 0x01, 0x0a,
-	0x0b, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04,
-	0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65,
-	0x12, 0x24, 0x0a, 0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f,
-	0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f,
-	0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f,
-	0x6f, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x24,
-	0x0a, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79,
-	0x5f, 0x63, 0x61, 0x70, 0x22, 0x5c, 0x0a, 0x12, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x65,
-	0x6e, 0x6f, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a,
-	0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48,
-	0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x22, 0x8a, 0x02, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x6e,
-	0x6f, 0x6d, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f,
-	0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64,
-	0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
-	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74,
-	0x69, 0x76, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68,
-	0x12, 0x3d, 0x0a, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e,
-	0x18, 0x07, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f,
-	0x6d, 0x52, 0x0c, 0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x22,
-	0x7c, 0x0a, 0x0a, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61,
-	0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e,
-	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
-	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01,
-	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x89, 0x01,
-	0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03,
-	0xc8, 0x01, 0x01, 0x52, 0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29,
-	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x6f, 0x72,
-	0x69, 0x67, 0x69, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f,
-	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x6f, 0x6e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x47, 0x65,
-	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61,
+	0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x2c, 0x0a, 0x03, 0x66, 0x65, 0x65,
+	0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x65, 0x65, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x52, 0x03, 0x66, 0x65, 0x65, 0x22, 0x92, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61,
 	0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
 	0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72,
 	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x31,
-	0x0a, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19,
-	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43,
-	0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
-	0x73, 0x22, 0x3a, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48,
-	0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xd4, 0x01,
-	0x0a, 0x16, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
-	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61,
-	0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x12, 0x41, 0x0a, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61,
-	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x74,
-	0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61,
-	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65,
-	0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x22, 0xba, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65,
-	0x74, 0x61, 0x69, 0x6c, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d,
-	0x62, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
-	0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65,
-	0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68,
-	0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69,
-	0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d,
-	0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76,
-	0x65, 0x22, 0x49, 0x0a, 0x21, 0x50, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x53,
-	0x75, 0x70, 0x70, 0x6f, 0x72, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
-	0x69, 0x64, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01,
-	0x01, 0x52, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x10,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x53,
-	0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x18, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e,
-	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49,
-	0x6e, 0x66, 0x6f, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12,
-	0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12,
-	0x18, 0x0a, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x07, 0x68, 0x61, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f,
-	0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73,
-	0x5f, 0x62, 0x72, 0x6f, 0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
-	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x09, 0x54,
-	0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69,
-	0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63,
-	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x62,
-	0x63, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69,
-	0x62, 0x63, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65,
-	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61,
-	0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67,
-	0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
-	0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08,
-	0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62,
-	0x6f, 0x6c, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c,
-	0x22, 0xdc, 0x02, 0x0a, 0x0a, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12,
-	0x1a, 0x0a, 0x08, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74,
-	0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a,
-	0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69,
-	0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
-	0x5f, 0x69, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x54, 0x0a,
-	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18,
-	0x06, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65,
-	0x2e, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e,
-	0x74, 0x72, 0x79, 0x52, 0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b,
-	0x65, 0x6e, 0x73, 0x1a, 0x5a, 0x0a, 0x12, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f,
-	0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76,
-	0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x74,
-	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
-	0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22,
-	0x50, 0x0a, 0x08, 0x57, 0x61, 0x73, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63,
-	0x6f, 0x6e, 0x74, 0x72, 0x61, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x57, 0x61, 0x73, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x03, 0x6d, 0x73,
-	0x67, 0x22, 0x51, 0x0a, 0x07, 0x57, 0x61, 0x73, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x0f,
-	0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
-	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74,
-	0x69, 0x6f, 0x6e, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x63,
-	0x74, 0x69, 0x6f, 0x6e, 0x22, 0x96, 0x02, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6e, 0x64,
-	0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73,
-	0x77, 0x61, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68,
-	0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x77,
-	0x61, 0x70, 0x52, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x35, 0x0a,
-	0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
-	0x2e, 0x4d, 0x69, 0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x61,
-	0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f,
-	0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x12, 0x49, 0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f,
-	0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70,
-	0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73,
-	0x74, 0x53, 0x77, 0x61, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x6f, 0x73,
-	0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a,
-	0x0a, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28,
-	0x09, 0x52, 0x0a, 0x61, 0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x73, 0x22, 0x7a, 0x0a,
-	0x10, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49,
-	0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f,
-	0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70,
-	0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x6f,
+	0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1c,
+	0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x9f, 0x04, 0x0a,
+	0x09, 0x53, 0x77, 0x61, 0x70, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x12, 0x16, 0x0a, 0x06, 0x62, 0x72,
+	0x6f, 0x6b, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x62, 0x72, 0x6f, 0x6b,
+	0x65, 0x72, 0x12, 0x37, 0x0a, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e,
+	0x67, 0x52, 0x08, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x69, 0x6e, 0x12, 0x39, 0x0a, 0x09, 0x74,
+	0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x4d, 0x61, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x09, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74,
+	0x5f, 0x6f, 0x75, 0x74, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x69, 0x6d,
+	0x70, 0x61, 0x63, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x70, 0x72, 0x69, 0x63,
+	0x65, 0x5f, 0x69, 0x6d, 0x70, 0x61, 0x63, 0x74, 0x12, 0x24, 0x0a, 0x0d, 0x65, 0x66, 0x66, 0x65,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x65, 0x66, 0x66, 0x65, 0x63, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x51,
+	0x0a, 0x12, 0x6f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f,
+	0x64, 0x61, 0x74, 0x61, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d, 0x6f, 0x73,
+	0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x48, 0x00, 0x52, 0x12, 0x6f,
+	0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x5f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74,
+	0x61, 0x12, 0x57, 0x0a, 0x14, 0x61, 0x73, 0x74, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x21, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
+	0x41, 0x73, 0x74, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44, 0x61,
+	0x74, 0x61, 0x48, 0x00, 0x52, 0x14, 0x61, 0x73, 0x74, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x61,
+	0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x74,
+	0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x26, 0x0a, 0x0e, 0x6e, 0x65, 0x74, 0x5f,
+	0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0e, 0x6e, 0x65, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74,
+	0x42, 0x0c, 0x0a, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x64, 0x61, 0x74, 0x61, 0x22, 0xa5,
+	0x01, 0x0a, 0x10, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x44,
+	0x61, 0x74, 0x61, 0x12, 0x33, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x01, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x12, 0x24, 0x0a, 0x0d, 0x6c, 0x69, 0x71, 0x75,
+	0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x12, 0x36,
+	0x0a, 0x16, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x5f,
+	0x6f, 0x76, 0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x16,
+	0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x5f, 0x6f, 0x76,
+	0x65, 0x72, 0x66, 0x6c, 0x6f, 0x77, 0x22, 0xa0, 0x01, 0x0a, 0x0c, 0x4f, 0x73, 0x6d, 0x6f, 0x73,
+	0x69, 0x73, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x30, 0x0a, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x73, 0x6d, 0x6f, 0x73, 0x69, 0x73, 0x50, 0x6f,
+	0x6f, 0x6c, 0x52, 0x05, 0x70, 0x6f, 0x6f, 0x6c, 0x73, 0x12, 0x20, 0x0a, 0x0b, 0x68, 0x61, 0x73,
+	0x5f, 0x63, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b,
+	0x68, 0x61, 0x73, 0x5f, 0x63, 0x77, 0x5f, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1e, 0x0a, 0x0a, 0x6f,
+	0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x6f, 0x75, 0x74, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
+	0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0xc5, 0x01, 0x0a, 0x0b, 0x4f, 0x73,
+	0x6d, 0x6f, 0x73, 0x69, 0x73, 0x50, 0x6f, 0x6f, 0x6c, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x05, 0x52, 0x02, 0x69, 0x64, 0x12, 0x12, 0x0a, 0x04, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x05, 0x52, 0x04, 0x74, 0x79, 0x70, 0x65, 0x12, 0x24, 0x0a,
+	0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x61, 0x63, 0x74, 0x6f, 0x72, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x73, 0x70, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x66, 0x61, 0x63,
+	0x74, 0x6f, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x74, 0x6f,
+	0x6b, 0x65, 0x6e, 0x5f, 0x6f, 0x75, 0x74, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1c, 0x0a,
+	0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x74, 0x61, 0x6b, 0x65, 0x72, 0x5f, 0x66, 0x65, 0x65, 0x12, 0x24, 0x0a, 0x0d, 0x6c,
+	0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61, 0x70, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0d, 0x6c, 0x69, 0x71, 0x75, 0x69, 0x64, 0x69, 0x74, 0x79, 0x5f, 0x63, 0x61,
+	0x70, 0x22, 0x83, 0x01, 0x0a, 0x12, 0x41, 0x73, 0x74, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x44, 0x61, 0x74, 0x61, 0x12, 0x2f, 0x0a, 0x04, 0x68, 0x6f, 0x70, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x74, 0x72, 0x6f, 0x70, 0x6f, 0x72, 0x74,
+	0x48, 0x6f, 0x70, 0x52, 0x04, 0x68, 0x6f, 0x70, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x61, 0x6d,
+	0x6f, 0x75, 0x6e, 0x74, 0x5f, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x5f, 0x6f, 0x75, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0c, 0x41, 0x73, 0x74, 0x72,
+	0x6f, 0x70, 0x6f, 0x72, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x22, 0x0a, 0x0c, 0x70, 0x61, 0x69, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1c, 0x0a, 0x09,
+	0x70, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x09, 0x70, 0x61, 0x69, 0x72, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f,
+	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x75, 0x74, 0x12, 0x1a, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65,
+	0x22, 0x5c, 0x0a, 0x12, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x8a,
+	0x02, 0x0a, 0x13, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x20, 0x0a, 0x0b,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22,
+	0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x12, 0x1a, 0x0a, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x70, 0x61, 0x74, 0x68, 0x12, 0x3d, 0x0a, 0x0c,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x18, 0x07, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x0c, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x5f, 0x6f, 0x6e, 0x22, 0x7c, 0x0a, 0x0a, 0x43,
+	0x68, 0x61, 0x69, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61,
+	0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69,
+	0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x89, 0x01, 0x0a, 0x15, 0x47, 0x65,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x25, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52,
+	0x09, 0x62, 0x61, 0x73, 0x65, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x29, 0x0a, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x0b, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x43, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1e, 0x0a, 0x0b, 0x6f, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69,
+	0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6f, 0x6e, 0x43, 0x68,
+	0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xa5, 0x01, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x14, 0x0a, 0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x05, 0x66, 0x6f, 0x75, 0x6e, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72,
+	0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x31, 0x0a, 0x06, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e,
+	0x44, 0x65, 0x6e, 0x6f, 0x6d, 0x52, 0x06, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x73, 0x22, 0x3a, 0x0a,
+	0x15, 0x47, 0x65, 0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01,
+	0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x22, 0xd4, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64,
+	0x12, 0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x12, 0x41, 0x0a, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x0d, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x5f, 0x74, 0x6f, 0x6b,
+	0x65, 0x6e, 0x73, 0x12, 0x3b, 0x0a, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e,
+	0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74,
+	0x61, 0x69, 0x6c, 0x73, 0x52, 0x0a, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0xba, 0x01, 0x0a, 0x0c, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x44, 0x65, 0x74, 0x61, 0x69, 0x6c,
+	0x73, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x12,
+	0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12,
+	0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18,
+	0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12,
+	0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x06, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x49, 0x0a,
+	0x21, 0x50, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x75, 0x70, 0x70, 0x6f,
+	0x72, 0x74, 0x65, 0x64, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x12, 0x24, 0x0a, 0x09, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x09, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x73, 0x22, 0x58, 0x0a, 0x10, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x21, 0x0a, 0x08,
+	0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06,
+	0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x73, 0x68, 0x6f, 0x77, 0x5f, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0b, 0x73, 0x68, 0x6f, 0x77, 0x53, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x73, 0x22, 0x4d, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x66,
+	0x6f, 0x22, 0xb2, 0x01, 0x0a, 0x09, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12,
+	0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x0a, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x0a, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x68,
+	0x61, 0x73, 0x5f, 0x70, 0x66, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x68, 0x61,
+	0x73, 0x5f, 0x70, 0x66, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x6f, 0x6b,
+	0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x69, 0x73, 0x5f, 0x62, 0x72, 0x6f,
+	0x6b, 0x65, 0x72, 0x12, 0x31, 0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20,
+	0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x22, 0xc3, 0x01, 0x0a, 0x09, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x49, 0x6e, 0x66, 0x6f, 0x12, 0x20, 0x0a, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1c, 0x0a, 0x09, 0x69, 0x62, 0x63, 0x5f, 0x64, 0x65,
+	0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x69, 0x62, 0x63, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x1e, 0x0a, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64, 0x65, 0x6e,
+	0x6f, 0x6d, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x22, 0x0a, 0x0c, 0x6f, 0x72, 0x69, 0x67, 0x69, 0x6e, 0x5f, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0c, 0x6f, 0x72, 0x69, 0x67,
+	0x69, 0x6e, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69,
+	0x6d, 0x61, 0x6c, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f, 0x6c, 0x22, 0xdc, 0x02, 0x0a,
+	0x0a, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74,
+	0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x74,
+	0x6f, 0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x20, 0x0a, 0x0b, 0x74, 0x6f, 0x5f, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x74, 0x6f,
+	0x5f, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x24, 0x0a, 0x0d, 0x63, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0d, 0x63, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x12,
+	0x1e, 0x0a, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x07, 0x70, 0x6f, 0x72, 0x74, 0x5f, 0x69, 0x64, 0x12, 0x54, 0x0a, 0x0e, 0x61, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x06, 0x20, 0x03, 0x28,
+	0x0b, 0x32, 0x2c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x42, 0x61, 0x73, 0x69, 0x63, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x2e, 0x41, 0x6c, 0x6c,
+	0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x45, 0x6e, 0x74, 0x72, 0x79, 0x52,
+	0x0e, 0x61, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x5f, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x1a,
+	0x5a, 0x0a, 0x12, 0x41, 0x6c, 0x6c, 0x6f, 0x77, 0x65, 0x64, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x45, 0x6e, 0x74, 0x72, 0x79, 0x12, 0x10, 0x0a, 0x03, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x03, 0x6b, 0x65, 0x79, 0x12, 0x2e, 0x0a, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
+	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x49, 0x6e, 0x66, 0x6f,
+	0x52, 0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x3a, 0x02, 0x38, 0x01, 0x22, 0x50, 0x0a, 0x08, 0x57,
+	0x61, 0x73, 0x6d, 0x44, 0x61, 0x74, 0x61, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x72,
+	0x61, 0x63, 0x74, 0x12, 0x28, 0x0a, 0x03, 0x6d, 0x73, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x16, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x73, 0x6d, 0x4d, 0x73, 0x67, 0x52, 0x03, 0x6d, 0x73, 0x67, 0x22, 0x51, 0x0a,
+	0x07, 0x57, 0x61, 0x73, 0x6d, 0x4d, 0x73, 0x67, 0x12, 0x46, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76,
+	0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52,
+	0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x6e, 0x64, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x96, 0x02, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x41, 0x6e, 0x64, 0x41, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x35, 0x0a, 0x09, 0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x55, 0x73, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x52, 0x09,
+	0x75, 0x73, 0x65, 0x72, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x12, 0x35, 0x0a, 0x09, 0x6d, 0x69, 0x6e,
+	0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70,
+	0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4d, 0x69, 0x6e,
+	0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x09, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74,
+	0x12, 0x2c, 0x0a, 0x11, 0x74, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x11, 0x74, 0x69, 0x6d,
+	0x65, 0x6f, 0x75, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49,
+	0x0a, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1d, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6f, 0x73, 0x74, 0x53, 0x77, 0x61,
+	0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x10, 0x70, 0x6f, 0x73, 0x74, 0x5f, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x66, 0x66,
+	0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x03, 0x28, 0x09, 0x52, 0x0a, 0x61,
+	0x66, 0x66, 0x69, 0x6c, 0x69, 0x61, 0x74, 0x65, 0x73, 0x22, 0x7a, 0x0a, 0x10, 0x53, 0x77, 0x61,
+	0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x12, 0x28, 0x0a,
+	0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70,
+	0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0xa3, 0x01, 0x0a, 0x11, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78,
+	0x61, 0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x12, 0x28, 0x0a, 0x0f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65,
+	0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x3c, 0x0a, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1c, 0x2e, 0x70, 0x61, 0x74, 0x68,
+	0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x72, 0x65, 0x66, 0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x72, 0x65, 0x66,
+	0x75, 0x6e, 0x64, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x73, 0x0a, 0x15, 0x53,
+	0x6d, 0x61, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x49, 0x6e, 0x12, 0x28, 0x0a, 0x0f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e,
+	0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x73,
+	0x77, 0x61, 0x70, 0x5f, 0x76, 0x65, 0x6e, 0x75, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x30,
+	0x0a, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x18,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53,
+	0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x52, 0x06, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x73,
+	0x22, 0x86, 0x01, 0x0a, 0x09, 0x53, 0x77, 0x61, 0x70, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x3b,
+	0x0a, 0x0b, 0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x4f, 0x66, 0x66, 0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x0b,
+	0x6f, 0x66, 0x66, 0x65, 0x72, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x12, 0x3c, 0x0a, 0x0a, 0x6f,
 	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b, 0x32,
 	0x1c, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
 	0x53, 0x77, 0x61, 0x70, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0a, 0x6f,
-	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x53, 0x77,
-	0x61, 0x70, 0x4f, 0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70,
-	0x6f, 0x6f, 0x6c, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12,
-	0x1a, 0x0a, 0x08, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x08, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64,
-	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09,
-	0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x6e, 0x74,
-	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09,
-	0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a,
-	0x5f, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x08, 0x4d, 0x69,
-	0x6e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e,
-	0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x6e, 0x61,
-	0x74, 0x69, 0x76, 0x65, 0x22, 0x35, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a,
-	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61,
-	0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x93, 0x01, 0x0a, 0x0e,
-	0x50, 0x6f, 0x73, 0x74, 0x53, 0x77, 0x61, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40,
-	0x0a, 0x0c, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
-	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x48, 0x00, 0x52, 0x0c, 0x69, 0x62, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x12, 0x35, 0x0a, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x17, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74,
-	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f,
-	0x6e, 0x22, 0x41, 0x0a, 0x0b, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x12, 0x32, 0x0a, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x16, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
-	0x76, 0x31, 0x2e, 0x49, 0x42, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x69, 0x62, 0x63, 0x5f,
-	0x69, 0x6e, 0x66, 0x6f, 0x22, 0x2a, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72,
-	0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
-	0x22, 0x8b, 0x01, 0x0a, 0x07, 0x49, 0x42, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04,
-	0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
-	0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01,
-	0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f,
-	0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61,
-	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65,
-	0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e,
-	0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x5d,
-	0x0a, 0x08, 0x55, 0x73, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x13, 0x73, 0x77,
-	0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69,
-	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
-	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63,
-	0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x52, 0x13, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65,
-	0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x32, 0xc6, 0x04,
-	0x0a, 0x11, 0x50, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x12, 0x50, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12,
-	0x1e, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
-	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x70, 0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x3a, 0x0a, 0x0a, 0x4f, 0x66, 0x66,
+	0x65, 0x72, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76,
+	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69,
+	0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x6e,
+	0x61, 0x74, 0x69, 0x76, 0x65, 0x22, 0x8e, 0x01, 0x0a, 0x0d, 0x53, 0x77, 0x61, 0x70, 0x4f, 0x70,
+	0x65, 0x72, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x12, 0x0a, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x70, 0x6f, 0x6f, 0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x64,
+	0x65, 0x6e, 0x6f, 0x6d, 0x5f, 0x69, 0x6e, 0x12, 0x1c, 0x0a, 0x09, 0x64, 0x65, 0x6e, 0x6f, 0x6d,
+	0x5f, 0x6f, 0x75, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x64, 0x65, 0x6e, 0x6f,
+	0x6d, 0x5f, 0x6f, 0x75, 0x74, 0x12, 0x21, 0x0a, 0x09, 0x69, 0x6e, 0x74, 0x65, 0x72, 0x66, 0x61,
+	0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x48, 0x00, 0x52, 0x09, 0x69, 0x6e, 0x74, 0x65,
+	0x72, 0x66, 0x61, 0x63, 0x65, 0x88, 0x01, 0x01, 0x42, 0x0c, 0x0a, 0x0a, 0x5f, 0x69, 0x6e, 0x74,
+	0x65, 0x72, 0x66, 0x61, 0x63, 0x65, 0x22, 0x38, 0x0a, 0x08, 0x4d, 0x69, 0x6e, 0x41, 0x73, 0x73,
+	0x65, 0x74, 0x12, 0x2c, 0x0a, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x14, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x41, 0x73, 0x73, 0x65, 0x74, 0x52, 0x06, 0x6e, 0x61, 0x74, 0x69, 0x76, 0x65,
+	0x22, 0x35, 0x0a, 0x05, 0x41, 0x73, 0x73, 0x65, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f,
+	0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x93, 0x01, 0x0a, 0x0e, 0x50, 0x6f, 0x73, 0x74,
+	0x53, 0x77, 0x61, 0x70, 0x41, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x40, 0x0a, 0x0c, 0x69, 0x62,
+	0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x0c,
+	0x69, 0x62, 0x63, 0x5f, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x35, 0x0a, 0x08,
+	0x74, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x17,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x48, 0x00, 0x52, 0x08, 0x74, 0x72, 0x61, 0x6e, 0x73,
+	0x66, 0x65, 0x72, 0x42, 0x08, 0x0a, 0x06, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x41, 0x0a,
+	0x0b, 0x49, 0x42, 0x43, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08,
+	0x69, 0x62, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49,
+	0x42, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x69, 0x62, 0x63, 0x5f, 0x69, 0x6e, 0x66, 0x6f,
+	0x22, 0x2a, 0x0a, 0x08, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x66, 0x65, 0x72, 0x12, 0x1e, 0x0a, 0x0a,
+	0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x0a, 0x74, 0x6f, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x8b, 0x01, 0x0a,
+	0x07, 0x49, 0x42, 0x43, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1a, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x12, 0x28, 0x0a, 0x0f, 0x72, 0x65, 0x63, 0x6f,
+	0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x6f, 0x76, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x12, 0x26, 0x0a, 0x0e, 0x73, 0x6f, 0x75, 0x72, 0x63, 0x65, 0x5f, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x73, 0x6f, 0x75, 0x72,
+	0x63, 0x65, 0x5f, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x97, 0x02, 0x0a, 0x08, 0x55,
+	0x73, 0x65, 0x72, 0x53, 0x77, 0x61, 0x70, 0x12, 0x51, 0x0a, 0x13, 0x73, 0x77, 0x61, 0x70, 0x5f,
+	0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
+	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61, 0x63, 0x74, 0x41, 0x73,
+	0x73, 0x65, 0x74, 0x49, 0x6e, 0x52, 0x13, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63,
+	0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x12, 0x54, 0x0a, 0x14, 0x73, 0x77,
+	0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6f,
+	0x75, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x20, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61,
+	0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x4f, 0x75, 0x74, 0x52, 0x14, 0x73, 0x77, 0x61, 0x70,
+	0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x6f, 0x75, 0x74,
+	0x12, 0x62, 0x0a, 0x19, 0x73, 0x6d, 0x61, 0x72, 0x74, 0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65,
+	0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65, 0x74, 0x5f, 0x69, 0x6e, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x24, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x53, 0x6d, 0x61, 0x72, 0x74, 0x53, 0x77, 0x61, 0x70, 0x45, 0x78, 0x61,
+	0x63, 0x74, 0x41, 0x73, 0x73, 0x65, 0x74, 0x49, 0x6e, 0x52, 0x19, 0x73, 0x6d, 0x61, 0x72, 0x74,
+	0x5f, 0x73, 0x77, 0x61, 0x70, 0x5f, 0x65, 0x78, 0x61, 0x63, 0x74, 0x5f, 0x61, 0x73, 0x73, 0x65,
+	0x74, 0x5f, 0x69, 0x6e, 0x22, 0x9c, 0x02, 0x0a, 0x17, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x3d, 0x0a, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
 	0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e,
 	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x05, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x12,
+	0x33, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0c, 0xba, 0x48, 0x09, 0xc8, 0x01, 0x01, 0x72,
+	0x04, 0x10, 0x26, 0x18, 0x44, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x41, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x12, 0x32, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xba, 0x48, 0x04, 0x72, 0x02, 0x18, 0x44, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
+	0x72, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x3b, 0x0a, 0x07, 0x74, 0x69, 0x6d, 0x65,
+	0x6f, 0x75, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x21, 0x2e, 0x70, 0x61, 0x74, 0x68,
+	0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x52, 0x07, 0x74, 0x69,
+	0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x08, 0xba, 0x48, 0x05, 0x72, 0x03, 0x18, 0x80, 0x02, 0x52, 0x04, 0x6d,
+	0x65, 0x6d, 0x6f, 0x22, 0x89, 0x01, 0x0a, 0x12, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x54, 0x69, 0x6d, 0x65, 0x6f, 0x75, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x74, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23, 0x0a, 0x07, 0x73, 0x65, 0x63, 0x6f,
+	0x6e, 0x64, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x42, 0x09, 0xba, 0x48, 0x06, 0x32, 0x04,
+	0x18, 0x80, 0xf5, 0x24, 0x52, 0x07, 0x73, 0x65, 0x63, 0x6f, 0x6e, 0x64, 0x73, 0x12, 0x30, 0x0a,
+	0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e,
+	0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x49, 0x42,
+	0x43, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x52, 0x06, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22,
+	0x5d, 0x0a, 0x09, 0x49, 0x42, 0x43, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x12, 0x27, 0x0a, 0x0f,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x6e, 0x75, 0x6d, 0x62, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x4e,
+	0x75, 0x6d, 0x62, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x0f, 0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f,
+	0x6e, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0e,
+	0x72, 0x65, 0x76, 0x69, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x22, 0x62,
+	0x0a, 0x18, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x46, 0x0a, 0x0c, 0x74, 0x72,
+	0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x22, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x52, 0x0c, 0x74, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x22, 0xb9, 0x01, 0x0a, 0x13, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68,
+	0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x72, 0x12, 0x3a,
+	0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x18, 0x03, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x1e, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65,
+	0x6d, 0x6f, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x1e,
+	0x0a, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x0c, 0x52, 0x0a, 0x62, 0x6f, 0x64, 0x79, 0x5f, 0x62, 0x79, 0x74, 0x65, 0x73, 0x22, 0x63,
+	0x0a, 0x0f, 0x55, 0x6e, 0x73, 0x69, 0x67, 0x6e, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x12, 0x1a, 0x0a, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x08, 0x74, 0x79, 0x70, 0x65, 0x5f, 0x75, 0x72, 0x6c, 0x12, 0x14, 0x0a,
+	0x05, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x76, 0x61,
+	0x6c, 0x75, 0x65, 0x12, 0x1e, 0x0a, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a, 0x73, 0x6f,
+	0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x61, 0x6d, 0x69, 0x6e, 0x6f, 0x5f, 0x6a,
+	0x73, 0x6f, 0x6e, 0x22, 0x5b, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50,
+	0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x42, 0x0a, 0x06,
+	0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x1e, 0x2e, 0x70,
+	0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75, 0x65, 0x72, 0x79, 0x42, 0x0a, 0xba, 0x48,
+	0x07, 0x92, 0x01, 0x04, 0x08, 0x01, 0x10, 0x32, 0x52, 0x06, 0x74, 0x6f, 0x6b, 0x65, 0x6e, 0x73,
+	0x22, 0x59, 0x0a, 0x0f, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x51, 0x75,
+	0x65, 0x72, 0x79, 0x12, 0x21, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xba, 0x48, 0x03, 0xc8, 0x01, 0x01, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x69, 0x6e, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0d, 0xba, 0x48, 0x0a, 0xc8, 0x01, 0x01, 0x72, 0x05, 0x10,
+	0x01, 0x18, 0x80, 0x01, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x22, 0x4b, 0x0a, 0x16, 0x47,
+	0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x18,
+	0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x52, 0x06, 0x70, 0x72, 0x69, 0x63, 0x65, 0x73, 0x22, 0xbe, 0x01, 0x0a, 0x0a, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1a, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x63, 0x68, 0x61, 0x69, 0x6e,
+	0x5f, 0x69, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x64, 0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x79, 0x6d,
+	0x62, 0x6f, 0x6c, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x79, 0x6d, 0x62, 0x6f,
+	0x6c, 0x12, 0x1a, 0x0a, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x05, 0x52, 0x08, 0x64, 0x65, 0x63, 0x69, 0x6d, 0x61, 0x6c, 0x73, 0x12, 0x1c, 0x0a,
+	0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x09, 0x70, 0x72, 0x69, 0x63, 0x65, 0x5f, 0x75, 0x73, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x73,
+	0x6f, 0x75, 0x72, 0x63, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x6f, 0x75,
+	0x72, 0x63, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x05, 0x65, 0x72, 0x72, 0x6f, 0x72, 0x32, 0x8b, 0x09, 0x0a, 0x11, 0x50, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x50, 0x0a, 0x08, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x1e, 0x2e, 0x70, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61,
+	0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64,
+	0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02,
+	0x01, 0x12, 0x52, 0x0a, 0x09, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x12, 0x1e,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20,
+	0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x46,
+	0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
 	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x59, 0x0a, 0x0b, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44,
 	0x65, 0x6e, 0x6f, 0x6d, 0x12, 0x21, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
 	0x72, 0x2e, 0x76, 0x31, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x44, 0x65, 0x6e, 0x6f, 0x6d,
@@ -3250,11 +5885,43 @@ var file_pathfinder_route_proto_rawDesc = []byte{
 	0x65, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x74,
 	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x68,
 	0x61, 0x69, 0x6e, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62,
-	0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x67, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x2d, 0x56, 0x61,
-	0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x72, 0x61, 0x2d,
-	0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65,
-	0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x65, 0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x68, 0x0a, 0x10, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54,
+	0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x26, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64,
+	0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x27, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x42, 0x75, 0x69, 0x6c, 0x64, 0x54, 0x72, 0x61, 0x6e, 0x73, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90, 0x02, 0x01,
+	0x12, 0x62, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63,
+	0x65, 0x73, 0x12, 0x24, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x50, 0x72, 0x69, 0x63, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x25, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66,
+	0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x47, 0x65, 0x74, 0x54, 0x6f, 0x6b, 0x65,
+	0x6e, 0x50, 0x72, 0x69, 0x63, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x03, 0x90, 0x02, 0x01, 0x12, 0x5f, 0x0a, 0x0d, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6e,
+	0x64, 0x50, 0x61, 0x74, 0x68, 0x12, 0x23, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68, 0x46, 0x69, 0x6e, 0x64, 0x50,
+	0x61, 0x74, 0x68, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x24, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x42, 0x61, 0x74, 0x63, 0x68,
+	0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x03, 0x90, 0x02, 0x01, 0x12, 0x6b, 0x0a, 0x11, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e,
+	0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x27, 0x2e, 0x70, 0x61, 0x74,
+	0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43,
+	0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72,
+	0x2e, 0x76, 0x31, 0x2e, 0x50, 0x6c, 0x61, 0x6e, 0x43, 0x6f, 0x6e, 0x73, 0x6f, 0x6c, 0x69, 0x64,
+	0x61, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x03, 0x90,
+	0x02, 0x01, 0x12, 0x53, 0x0a, 0x0a, 0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65,
+	0x12, 0x20, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e, 0x76, 0x31,
+	0x2e, 0x57, 0x61, 0x74, 0x63, 0x68, 0x51, 0x75, 0x6f, 0x74, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64, 0x65, 0x72, 0x2e,
+	0x76, 0x31, 0x2e, 0x46, 0x69, 0x6e, 0x64, 0x50, 0x61, 0x74, 0x68, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x42, 0x40, 0x5a, 0x3e, 0x67, 0x69, 0x74, 0x68, 0x75,
+	0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x43, 0x6f, 0x67, 0x77, 0x68, 0x65, 0x65, 0x6c, 0x2d, 0x56,
+	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x2f, 0x73, 0x70, 0x65, 0x63, 0x74, 0x72, 0x61,
+	0x2d, 0x70, 0x6f, 0x72, 0x74, 0x61, 0x6c, 0x2f, 0x70, 0x61, 0x74, 0x68, 0x66, 0x69, 0x6e, 0x64,
+	0x65, 0x72, 0x2f, 0x72, 0x70, 0x63, 0x2f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
 }
 
 var (
@@ -3269,102 +5936,179 @@ func file_pathfinder_route_proto_rawDescGZIP() []byte {
 	return file_pathfinder_route_proto_rawDescData
 }
 
-var file_pathfinder_route_proto_msgTypes = make([]protoimpl.MessageInfo, 39)
+var file_pathfinder_route_proto_msgTypes = make([]protoimpl.MessageInfo, 70)
 var file_pathfinder_route_proto_goTypes = []any{
 	(*FindPathRequest)(nil),                   // 0: pathfinder.v1.FindPathRequest
-	(*FindPathResponse)(nil),                  // 1: pathfinder.v1.FindPathResponse
-	(*DirectRoute)(nil),                       // 2: pathfinder.v1.DirectRoute
-	(*IndirectRoute)(nil),                     // 3: pathfinder.v1.IndirectRoute
-	(*BrokerSwapRoute)(nil),                   // 4: pathfinder.v1.BrokerSwapRoute
-	(*BrokerExecutionData)(nil),               // 5: pathfinder.v1.BrokerExecutionData
-	(*IBCLeg)(nil),                            // 6: pathfinder.v1.IBCLeg
-	(*TokenMapping)(nil),                      // 7: pathfinder.v1.TokenMapping
-	(*SwapQuote)(nil),                         // 8: pathfinder.v1.SwapQuote
-	(*OsmosisRouteData)(nil),                  // 9: pathfinder.v1.OsmosisRouteData
-	(*OsmosisRoute)(nil),                      // 10: pathfinder.v1.OsmosisRoute
-	(*OsmosisPool)(nil),                       // 11: pathfinder.v1.OsmosisPool
-	(*LookupDenomRequest)(nil),                // 12: pathfinder.v1.LookupDenomRequest
-	(*LookupDenomResponse)(nil),               // 13: pathfinder.v1.LookupDenomResponse
-	(*ChainDenom)(nil),                        // 14: pathfinder.v1.ChainDenom
-	(*GetTokenDenomsRequest)(nil),             // 15: pathfinder.v1.GetTokenDenomsRequest
-	(*GetTokenDenomsResponse)(nil),            // 16: pathfinder.v1.GetTokenDenomsResponse
-	(*GetChainTokensRequest)(nil),             // 17: pathfinder.v1.GetChainTokensRequest
-	(*GetChainTokensResponse)(nil),            // 18: pathfinder.v1.GetChainTokensResponse
-	(*TokenDetails)(nil),                      // 19: pathfinder.v1.TokenDetails
-	(*PathfinderSupportedChainsResponse)(nil), // 20: pathfinder.v1.PathfinderSupportedChainsResponse
-	(*ChainInfoRequest)(nil),                  // 21: pathfinder.v1.ChainInfoRequest
-	(*ChainInfoResponse)(nil),                 // 22: pathfinder.v1.ChainInfoResponse
-	(*ChainInfo)(nil),                         // 23: pathfinder.v1.ChainInfo
-	(*TokenInfo)(nil),                         // 24: pathfinder.v1.TokenInfo
-	(*BasicRoute)(nil),                        // 25: pathfinder.v1.BasicRoute
-	(*WasmData)(nil),                          // 26: pathfinder.v1.WasmData
-	(*WasmMsg)(nil),                           // 27: pathfinder.v1.WasmMsg
-	(*SwapAndAction)(nil),                     // 28: pathfinder.v1.SwapAndAction
-	(*SwapExactAssetIn)(nil),                  // 29: pathfinder.v1.SwapExactAssetIn
-	(*SwapOperation)(nil),                     // 30: pathfinder.v1.SwapOperation
-	(*MinAsset)(nil),                          // 31: pathfinder.v1.MinAsset
-	(*Asset)(nil),                             // 32: pathfinder.v1.Asset
-	(*PostSwapAction)(nil),                    // 33: pathfinder.v1.PostSwapAction
-	(*IBCTransfer)(nil),                       // 34: pathfinder.v1.IBCTransfer
-	(*Transfer)(nil),                          // 35: pathfinder.v1.Transfer
-	(*IBCInfo)(nil),                           // 36: pathfinder.v1.IBCInfo
-	(*UserSwap)(nil),                          // 37: pathfinder.v1.UserSwap
-	nil,                                       // 38: pathfinder.v1.BasicRoute.AllowedTokensEntry
-	(*emptypb.Empty)(nil),                     // 39: google.protobuf.Empty
+	(*BatchFindPathRequest)(nil),              // 1: pathfinder.v1.BatchFindPathRequest
+	(*BatchFindPathResponse)(nil),             // 2: pathfinder.v1.BatchFindPathResponse
+	(*BatchFindPathResult)(nil),               // 3: pathfinder.v1.BatchFindPathResult
+	(*PlanConsolidationRequest)(nil),          // 4: pathfinder.v1.PlanConsolidationRequest
+	(*Balance)(nil),                           // 5: pathfinder.v1.Balance
+	(*PlanConsolidationResponse)(nil),         // 6: pathfinder.v1.PlanConsolidationResponse
+	(*ConsolidationRoute)(nil),                // 7: pathfinder.v1.ConsolidationRoute
+	(*PlannedTransaction)(nil),                // 8: pathfinder.v1.PlannedTransaction
+	(*WatchQuoteRequest)(nil),                 // 9: pathfinder.v1.WatchQuoteRequest
+	(*FindPathResponse)(nil),                  // 10: pathfinder.v1.FindPathResponse
+	(*RouteWarning)(nil),                      // 11: pathfinder.v1.RouteWarning
+	(*RouteValuation)(nil),                    // 12: pathfinder.v1.RouteValuation
+	(*RouteFees)(nil),                         // 13: pathfinder.v1.RouteFees
+	(*FeeEstimate)(nil),                       // 14: pathfinder.v1.FeeEstimate
+	(*FindPathsResponse)(nil),                 // 15: pathfinder.v1.FindPathsResponse
+	(*RankedRoute)(nil),                       // 16: pathfinder.v1.RankedRoute
+	(*DirectRoute)(nil),                       // 17: pathfinder.v1.DirectRoute
+	(*IndirectRoute)(nil),                     // 18: pathfinder.v1.IndirectRoute
+	(*BrokerSwapRoute)(nil),                   // 19: pathfinder.v1.BrokerSwapRoute
+	(*BrokerExecutionData)(nil),               // 20: pathfinder.v1.BrokerExecutionData
+	(*IBCLeg)(nil),                            // 21: pathfinder.v1.IBCLeg
+	(*TokenMapping)(nil),                      // 22: pathfinder.v1.TokenMapping
+	(*SwapQuote)(nil),                         // 23: pathfinder.v1.SwapQuote
+	(*OsmosisRouteData)(nil),                  // 24: pathfinder.v1.OsmosisRouteData
+	(*OsmosisRoute)(nil),                      // 25: pathfinder.v1.OsmosisRoute
+	(*OsmosisPool)(nil),                       // 26: pathfinder.v1.OsmosisPool
+	(*AstroportRouteData)(nil),                // 27: pathfinder.v1.AstroportRouteData
+	(*AstroportHop)(nil),                      // 28: pathfinder.v1.AstroportHop
+	(*LookupDenomRequest)(nil),                // 29: pathfinder.v1.LookupDenomRequest
+	(*LookupDenomResponse)(nil),               // 30: pathfinder.v1.LookupDenomResponse
+	(*ChainDenom)(nil),                        // 31: pathfinder.v1.ChainDenom
+	(*GetTokenDenomsRequest)(nil),             // 32: pathfinder.v1.GetTokenDenomsRequest
+	(*GetTokenDenomsResponse)(nil),            // 33: pathfinder.v1.GetTokenDenomsResponse
+	(*GetChainTokensRequest)(nil),             // 34: pathfinder.v1.GetChainTokensRequest
+	(*GetChainTokensResponse)(nil),            // 35: pathfinder.v1.GetChainTokensResponse
+	(*TokenDetails)(nil),                      // 36: pathfinder.v1.TokenDetails
+	(*PathfinderSupportedChainsResponse)(nil), // 37: pathfinder.v1.PathfinderSupportedChainsResponse
+	(*ChainInfoRequest)(nil),                  // 38: pathfinder.v1.ChainInfoRequest
+	(*ChainInfoResponse)(nil),                 // 39: pathfinder.v1.ChainInfoResponse
+	(*ChainInfo)(nil),                         // 40: pathfinder.v1.ChainInfo
+	(*TokenInfo)(nil),                         // 41: pathfinder.v1.TokenInfo
+	(*BasicRoute)(nil),                        // 42: pathfinder.v1.BasicRoute
+	(*WasmData)(nil),                          // 43: pathfinder.v1.WasmData
+	(*WasmMsg)(nil),                           // 44: pathfinder.v1.WasmMsg
+	(*SwapAndAction)(nil),                     // 45: pathfinder.v1.SwapAndAction
+	(*SwapExactAssetIn)(nil),                  // 46: pathfinder.v1.SwapExactAssetIn
+	(*SwapExactAssetOut)(nil),                 // 47: pathfinder.v1.SwapExactAssetOut
+	(*SmartSwapExactAssetIn)(nil),             // 48: pathfinder.v1.SmartSwapExactAssetIn
+	(*SwapRoute)(nil),                         // 49: pathfinder.v1.SwapRoute
+	(*OfferAsset)(nil),                        // 50: pathfinder.v1.OfferAsset
+	(*SwapOperation)(nil),                     // 51: pathfinder.v1.SwapOperation
+	(*MinAsset)(nil),                          // 52: pathfinder.v1.MinAsset
+	(*Asset)(nil),                             // 53: pathfinder.v1.Asset
+	(*PostSwapAction)(nil),                    // 54: pathfinder.v1.PostSwapAction
+	(*IBCTransfer)(nil),                       // 55: pathfinder.v1.IBCTransfer
+	(*Transfer)(nil),                          // 56: pathfinder.v1.Transfer
+	(*IBCInfo)(nil),                           // 57: pathfinder.v1.IBCInfo
+	(*UserSwap)(nil),                          // 58: pathfinder.v1.UserSwap
+	(*BuildTransactionRequest)(nil),           // 59: pathfinder.v1.BuildTransactionRequest
+	(*TransactionTimeout)(nil),                // 60: pathfinder.v1.TransactionTimeout
+	(*IBCHeight)(nil),                         // 61: pathfinder.v1.IBCHeight
+	(*BuildTransactionResponse)(nil),          // 62: pathfinder.v1.BuildTransactionResponse
+	(*UnsignedTransaction)(nil),               // 63: pathfinder.v1.UnsignedTransaction
+	(*UnsignedMessage)(nil),                   // 64: pathfinder.v1.UnsignedMessage
+	(*GetTokenPricesRequest)(nil),             // 65: pathfinder.v1.GetTokenPricesRequest
+	(*TokenPriceQuery)(nil),                   // 66: pathfinder.v1.TokenPriceQuery
+	(*GetTokenPricesResponse)(nil),            // 67: pathfinder.v1.GetTokenPricesResponse
+	(*TokenPrice)(nil),                        // 68: pathfinder.v1.TokenPrice
+	nil,                                       // 69: pathfinder.v1.BasicRoute.AllowedTokensEntry
+	(*emptypb.Empty)(nil),                     // 70: google.protobuf.Empty
 }
 var file_pathfinder_route_proto_depIdxs = []int32{
-	2,  // 0: pathfinder.v1.FindPathResponse.direct:type_name -> pathfinder.v1.DirectRoute
-	3,  // 1: pathfinder.v1.FindPathResponse.indirect:type_name -> pathfinder.v1.IndirectRoute
-	4,  // 2: pathfinder.v1.FindPathResponse.broker_swap:type_name -> pathfinder.v1.BrokerSwapRoute
-	6,  // 3: pathfinder.v1.DirectRoute.transfer:type_name -> pathfinder.v1.IBCLeg
-	6,  // 4: pathfinder.v1.IndirectRoute.legs:type_name -> pathfinder.v1.IBCLeg
-	6,  // 5: pathfinder.v1.BrokerSwapRoute.inbound_legs:type_name -> pathfinder.v1.IBCLeg
-	8,  // 6: pathfinder.v1.BrokerSwapRoute.swap:type_name -> pathfinder.v1.SwapQuote
-	6,  // 7: pathfinder.v1.BrokerSwapRoute.outbound_legs:type_name -> pathfinder.v1.IBCLeg
-	5,  // 8: pathfinder.v1.BrokerSwapRoute.execution:type_name -> pathfinder.v1.BrokerExecutionData
-	26, // 9: pathfinder.v1.BrokerExecutionData.smart_contract_data:type_name -> pathfinder.v1.WasmData
-	7,  // 10: pathfinder.v1.IBCLeg.token:type_name -> pathfinder.v1.TokenMapping
-	7,  // 11: pathfinder.v1.SwapQuote.token_in:type_name -> pathfinder.v1.TokenMapping
-	7,  // 12: pathfinder.v1.SwapQuote.token_out:type_name -> pathfinder.v1.TokenMapping
-	9,  // 13: pathfinder.v1.SwapQuote.osmosis_route_data:type_name -> pathfinder.v1.OsmosisRouteData
-	10, // 14: pathfinder.v1.OsmosisRouteData.routes:type_name -> pathfinder.v1.OsmosisRoute
-	11, // 15: pathfinder.v1.OsmosisRoute.pools:type_name -> pathfinder.v1.OsmosisPool
-	14, // 16: pathfinder.v1.LookupDenomResponse.available_on:type_name -> pathfinder.v1.ChainDenom
-	14, // 17: pathfinder.v1.GetTokenDenomsResponse.denoms:type_name -> pathfinder.v1.ChainDenom
-	19, // 18: pathfinder.v1.GetChainTokensResponse.native_tokens:type_name -> pathfinder.v1.TokenDetails
-	19, // 19: pathfinder.v1.GetChainTokensResponse.ibc_tokens:type_name -> pathfinder.v1.TokenDetails
-	23, // 20: pathfinder.v1.ChainInfoResponse.chain_info:type_name -> pathfinder.v1.ChainInfo
-	25, // 21: pathfinder.v1.ChainInfo.routes:type_name -> pathfinder.v1.BasicRoute
-	38, // 22: pathfinder.v1.BasicRoute.allowed_tokens:type_name -> pathfinder.v1.BasicRoute.AllowedTokensEntry
-	27, // 23: pathfinder.v1.WasmData.msg:type_name -> pathfinder.v1.WasmMsg
-	28, // 24: pathfinder.v1.WasmMsg.swap_and_action:type_name -> pathfinder.v1.SwapAndAction
-	37, // 25: pathfinder.v1.SwapAndAction.user_swap:type_name -> pathfinder.v1.UserSwap
-	31, // 26: pathfinder.v1.SwapAndAction.min_asset:type_name -> pathfinder.v1.MinAsset
-	33, // 27: pathfinder.v1.SwapAndAction.post_swap_action:type_name -> pathfinder.v1.PostSwapAction
-	30, // 28: pathfinder.v1.SwapExactAssetIn.operations:type_name -> pathfinder.v1.SwapOperation
-	32, // 29: pathfinder.v1.MinAsset.native:type_name -> pathfinder.v1.Asset
-	34, // 30: pathfinder.v1.PostSwapAction.ibc_transfer:type_name -> pathfinder.v1.IBCTransfer
-	35, // 31: pathfinder.v1.PostSwapAction.transfer:type_name -> pathfinder.v1.Transfer
-	36, // 32: pathfinder.v1.IBCTransfer.ibc_info:type_name -> pathfinder.v1.IBCInfo
-	29, // 33: pathfinder.v1.UserSwap.swap_exact_asset_in:type_name -> pathfinder.v1.SwapExactAssetIn
-	24, // 34: pathfinder.v1.BasicRoute.AllowedTokensEntry.value:type_name -> pathfinder.v1.TokenInfo
-	0,  // 35: pathfinder.v1.PathfinderService.FindPath:input_type -> pathfinder.v1.FindPathRequest
-	12, // 36: pathfinder.v1.PathfinderService.LookupDenom:input_type -> pathfinder.v1.LookupDenomRequest
-	15, // 37: pathfinder.v1.PathfinderService.GetTokenDenoms:input_type -> pathfinder.v1.GetTokenDenomsRequest
-	21, // 38: pathfinder.v1.PathfinderService.GetChainInfo:input_type -> pathfinder.v1.ChainInfoRequest
-	39, // 39: pathfinder.v1.PathfinderService.ListSupportedChains:input_type -> google.protobuf.Empty
-	17, // 40: pathfinder.v1.PathfinderService.GetChainTokens:input_type -> pathfinder.v1.GetChainTokensRequest
-	1,  // 41: pathfinder.v1.PathfinderService.FindPath:output_type -> pathfinder.v1.FindPathResponse
-	13, // 42: pathfinder.v1.PathfinderService.LookupDenom:output_type -> pathfinder.v1.LookupDenomResponse
-	16, // 43: pathfinder.v1.PathfinderService.GetTokenDenoms:output_type -> pathfinder.v1.GetTokenDenomsResponse
-	22, // 44: pathfinder.v1.PathfinderService.GetChainInfo:output_type -> pathfinder.v1.ChainInfoResponse
-	20, // 45: pathfinder.v1.PathfinderService.ListSupportedChains:output_type -> pathfinder.v1.PathfinderSupportedChainsResponse
-	18, // 46: pathfinder.v1.PathfinderService.GetChainTokens:output_type -> pathfinder.v1.GetChainTokensResponse
-	41, // [41:47] is the sub-list for method output_type
-	35, // [35:41] is the sub-list for method input_type
-	35, // [35:35] is the sub-list for extension type_name
-	35, // [35:35] is the sub-list for extension extendee
-	0,  // [0:35] is the sub-list for field type_name
+	0,  // 0: pathfinder.v1.BatchFindPathRequest.requests:type_name -> pathfinder.v1.FindPathRequest
+	3,  // 1: pathfinder.v1.BatchFindPathResponse.results:type_name -> pathfinder.v1.BatchFindPathResult
+	10, // 2: pathfinder.v1.BatchFindPathResult.route:type_name -> pathfinder.v1.FindPathResponse
+	5,  // 3: pathfinder.v1.PlanConsolidationRequest.balances:type_name -> pathfinder.v1.Balance
+	7,  // 4: pathfinder.v1.PlanConsolidationResponse.routes:type_name -> pathfinder.v1.ConsolidationRoute
+	8,  // 5: pathfinder.v1.PlanConsolidationResponse.transactions:type_name -> pathfinder.v1.PlannedTransaction
+	5,  // 6: pathfinder.v1.ConsolidationRoute.balance:type_name -> pathfinder.v1.Balance
+	10, // 7: pathfinder.v1.ConsolidationRoute.route:type_name -> pathfinder.v1.FindPathResponse
+	0,  // 8: pathfinder.v1.WatchQuoteRequest.route:type_name -> pathfinder.v1.FindPathRequest
+	17, // 9: pathfinder.v1.FindPathResponse.direct:type_name -> pathfinder.v1.DirectRoute
+	18, // 10: pathfinder.v1.FindPathResponse.indirect:type_name -> pathfinder.v1.IndirectRoute
+	19, // 11: pathfinder.v1.FindPathResponse.broker_swap:type_name -> pathfinder.v1.BrokerSwapRoute
+	13, // 12: pathfinder.v1.FindPathResponse.fees:type_name -> pathfinder.v1.RouteFees
+	12, // 13: pathfinder.v1.FindPathResponse.valuation:type_name -> pathfinder.v1.RouteValuation
+	11, // 14: pathfinder.v1.FindPathResponse.warnings:type_name -> pathfinder.v1.RouteWarning
+	14, // 15: pathfinder.v1.RouteFees.total:type_name -> pathfinder.v1.FeeEstimate
+	16, // 16: pathfinder.v1.FindPathsResponse.routes:type_name -> pathfinder.v1.RankedRoute
+	10, // 17: pathfinder.v1.RankedRoute.route:type_name -> pathfinder.v1.FindPathResponse
+	21, // 18: pathfinder.v1.DirectRoute.transfer:type_name -> pathfinder.v1.IBCLeg
+	21, // 19: pathfinder.v1.IndirectRoute.legs:type_name -> pathfinder.v1.IBCLeg
+	21, // 20: pathfinder.v1.BrokerSwapRoute.inbound_legs:type_name -> pathfinder.v1.IBCLeg
+	23, // 21: pathfinder.v1.BrokerSwapRoute.swap:type_name -> pathfinder.v1.SwapQuote
+	21, // 22: pathfinder.v1.BrokerSwapRoute.outbound_legs:type_name -> pathfinder.v1.IBCLeg
+	20, // 23: pathfinder.v1.BrokerSwapRoute.execution:type_name -> pathfinder.v1.BrokerExecutionData
+	23, // 24: pathfinder.v1.BrokerSwapRoute.alternative_quotes:type_name -> pathfinder.v1.SwapQuote
+	43, // 25: pathfinder.v1.BrokerExecutionData.smart_contract_data:type_name -> pathfinder.v1.WasmData
+	14, // 26: pathfinder.v1.BrokerExecutionData.fee:type_name -> pathfinder.v1.FeeEstimate
+	22, // 27: pathfinder.v1.IBCLeg.token:type_name -> pathfinder.v1.TokenMapping
+	14, // 28: pathfinder.v1.IBCLeg.fee:type_name -> pathfinder.v1.FeeEstimate
+	22, // 29: pathfinder.v1.SwapQuote.token_in:type_name -> pathfinder.v1.TokenMapping
+	22, // 30: pathfinder.v1.SwapQuote.token_out:type_name -> pathfinder.v1.TokenMapping
+	24, // 31: pathfinder.v1.SwapQuote.osmosis_route_data:type_name -> pathfinder.v1.OsmosisRouteData
+	27, // 32: pathfinder.v1.SwapQuote.astroport_route_data:type_name -> pathfinder.v1.AstroportRouteData
+	25, // 33: pathfinder.v1.OsmosisRouteData.routes:type_name -> pathfinder.v1.OsmosisRoute
+	26, // 34: pathfinder.v1.OsmosisRoute.pools:type_name -> pathfinder.v1.OsmosisPool
+	28, // 35: pathfinder.v1.AstroportRouteData.hops:type_name -> pathfinder.v1.AstroportHop
+	31, // 36: pathfinder.v1.LookupDenomResponse.available_on:type_name -> pathfinder.v1.ChainDenom
+	31, // 37: pathfinder.v1.GetTokenDenomsResponse.denoms:type_name -> pathfinder.v1.ChainDenom
+	36, // 38: pathfinder.v1.GetChainTokensResponse.native_tokens:type_name -> pathfinder.v1.TokenDetails
+	36, // 39: pathfinder.v1.GetChainTokensResponse.ibc_tokens:type_name -> pathfinder.v1.TokenDetails
+	40, // 40: pathfinder.v1.ChainInfoResponse.chain_info:type_name -> pathfinder.v1.ChainInfo
+	42, // 41: pathfinder.v1.ChainInfo.routes:type_name -> pathfinder.v1.BasicRoute
+	69, // 42: pathfinder.v1.BasicRoute.allowed_tokens:type_name -> pathfinder.v1.BasicRoute.AllowedTokensEntry
+	44, // 43: pathfinder.v1.WasmData.msg:type_name -> pathfinder.v1.WasmMsg
+	45, // 44: pathfinder.v1.WasmMsg.swap_and_action:type_name -> pathfinder.v1.SwapAndAction
+	58, // 45: pathfinder.v1.SwapAndAction.user_swap:type_name -> pathfinder.v1.UserSwap
+	52, // 46: pathfinder.v1.SwapAndAction.min_asset:type_name -> pathfinder.v1.MinAsset
+	54, // 47: pathfinder.v1.SwapAndAction.post_swap_action:type_name -> pathfinder.v1.PostSwapAction
+	51, // 48: pathfinder.v1.SwapExactAssetIn.operations:type_name -> pathfinder.v1.SwapOperation
+	51, // 49: pathfinder.v1.SwapExactAssetOut.operations:type_name -> pathfinder.v1.SwapOperation
+	49, // 50: pathfinder.v1.SmartSwapExactAssetIn.routes:type_name -> pathfinder.v1.SwapRoute
+	50, // 51: pathfinder.v1.SwapRoute.offer_asset:type_name -> pathfinder.v1.OfferAsset
+	51, // 52: pathfinder.v1.SwapRoute.operations:type_name -> pathfinder.v1.SwapOperation
+	53, // 53: pathfinder.v1.OfferAsset.native:type_name -> pathfinder.v1.Asset
+	53, // 54: pathfinder.v1.MinAsset.native:type_name -> pathfinder.v1.Asset
+	55, // 55: pathfinder.v1.PostSwapAction.ibc_transfer:type_name -> pathfinder.v1.IBCTransfer
+	56, // 56: pathfinder.v1.PostSwapAction.transfer:type_name -> pathfinder.v1.Transfer
+	57, // 57: pathfinder.v1.IBCTransfer.ibc_info:type_name -> pathfinder.v1.IBCInfo
+	46, // 58: pathfinder.v1.UserSwap.swap_exact_asset_in:type_name -> pathfinder.v1.SwapExactAssetIn
+	47, // 59: pathfinder.v1.UserSwap.swap_exact_asset_out:type_name -> pathfinder.v1.SwapExactAssetOut
+	48, // 60: pathfinder.v1.UserSwap.smart_swap_exact_asset_in:type_name -> pathfinder.v1.SmartSwapExactAssetIn
+	10, // 61: pathfinder.v1.BuildTransactionRequest.route:type_name -> pathfinder.v1.FindPathResponse
+	60, // 62: pathfinder.v1.BuildTransactionRequest.timeout:type_name -> pathfinder.v1.TransactionTimeout
+	61, // 63: pathfinder.v1.TransactionTimeout.height:type_name -> pathfinder.v1.IBCHeight
+	63, // 64: pathfinder.v1.BuildTransactionResponse.transactions:type_name -> pathfinder.v1.UnsignedTransaction
+	64, // 65: pathfinder.v1.UnsignedTransaction.messages:type_name -> pathfinder.v1.UnsignedMessage
+	66, // 66: pathfinder.v1.GetTokenPricesRequest.tokens:type_name -> pathfinder.v1.TokenPriceQuery
+	68, // 67: pathfinder.v1.GetTokenPricesResponse.prices:type_name -> pathfinder.v1.TokenPrice
+	41, // 68: pathfinder.v1.BasicRoute.AllowedTokensEntry.value:type_name -> pathfinder.v1.TokenInfo
+	0,  // 69: pathfinder.v1.PathfinderService.FindPath:input_type -> pathfinder.v1.FindPathRequest
+	0,  // 70: pathfinder.v1.PathfinderService.FindPaths:input_type -> pathfinder.v1.FindPathRequest
+	29, // 71: pathfinder.v1.PathfinderService.LookupDenom:input_type -> pathfinder.v1.LookupDenomRequest
+	32, // 72: pathfinder.v1.PathfinderService.GetTokenDenoms:input_type -> pathfinder.v1.GetTokenDenomsRequest
+	38, // 73: pathfinder.v1.PathfinderService.GetChainInfo:input_type -> pathfinder.v1.ChainInfoRequest
+	70, // 74: pathfinder.v1.PathfinderService.ListSupportedChains:input_type -> google.protobuf.Empty
+	34, // 75: pathfinder.v1.PathfinderService.GetChainTokens:input_type -> pathfinder.v1.GetChainTokensRequest
+	59, // 76: pathfinder.v1.PathfinderService.BuildTransaction:input_type -> pathfinder.v1.BuildTransactionRequest
+	65, // 77: pathfinder.v1.PathfinderService.GetTokenPrices:input_type -> pathfinder.v1.GetTokenPricesRequest
+	1,  // 78: pathfinder.v1.PathfinderService.BatchFindPath:input_type -> pathfinder.v1.BatchFindPathRequest
+	4,  // 79: pathfinder.v1.PathfinderService.PlanConsolidation:input_type -> pathfinder.v1.PlanConsolidationRequest
+	9,  // 80: pathfinder.v1.PathfinderService.WatchQuote:input_type -> pathfinder.v1.WatchQuoteRequest
+	10, // 81: pathfinder.v1.PathfinderService.FindPath:output_type -> pathfinder.v1.FindPathResponse
+	15, // 82: pathfinder.v1.PathfinderService.FindPaths:output_type -> pathfinder.v1.FindPathsResponse
+	30, // 83: pathfinder.v1.PathfinderService.LookupDenom:output_type -> pathfinder.v1.LookupDenomResponse
+	33, // 84: pathfinder.v1.PathfinderService.GetTokenDenoms:output_type -> pathfinder.v1.GetTokenDenomsResponse
+	39, // 85: pathfinder.v1.PathfinderService.GetChainInfo:output_type -> pathfinder.v1.ChainInfoResponse
+	37, // 86: pathfinder.v1.PathfinderService.ListSupportedChains:output_type -> pathfinder.v1.PathfinderSupportedChainsResponse
+	35, // 87: pathfinder.v1.PathfinderService.GetChainTokens:output_type -> pathfinder.v1.GetChainTokensResponse
+	62, // 88: pathfinder.v1.PathfinderService.BuildTransaction:output_type -> pathfinder.v1.BuildTransactionResponse
+	67, // 89: pathfinder.v1.PathfinderService.GetTokenPrices:output_type -> pathfinder.v1.GetTokenPricesResponse
+	2,  // 90: pathfinder.v1.PathfinderService.BatchFindPath:output_type -> pathfinder.v1.BatchFindPathResponse
+	6,  // 91: pathfinder.v1.PathfinderService.PlanConsolidation:output_type -> pathfinder.v1.PlanConsolidationResponse
+	10, // 92: pathfinder.v1.PathfinderService.WatchQuote:output_type -> pathfinder.v1.FindPathResponse
+	81, // [81:93] is the sub-list for method output_type
+	69, // [69:81] is the sub-list for method input_type
+	69, // [69:69] is the sub-list for extension type_name
+	69, // [69:69] is the sub-list for extension extendee
+	0,  // [0:69] is the sub-list for field type_name
 }
 
 func init() { file_pathfinder_route_proto_init() }
@@ -3385,8 +6129,152 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[1].Exporter = func(v any, i int) any {
-			switch v := v.(*FindPathResponse); i {
+		file_pathfinder_route_proto_msgTypes[1].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchFindPathRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[2].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchFindPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[3].Exporter = func(v any, i int) any {
+			switch v := v.(*BatchFindPathResult); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[4].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanConsolidationRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[5].Exporter = func(v any, i int) any {
+			switch v := v.(*Balance); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[6].Exporter = func(v any, i int) any {
+			switch v := v.(*PlanConsolidationResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[7].Exporter = func(v any, i int) any {
+			switch v := v.(*ConsolidationRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[8].Exporter = func(v any, i int) any {
+			switch v := v.(*PlannedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[9].Exporter = func(v any, i int) any {
+			switch v := v.(*WatchQuoteRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[10].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[11].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteWarning); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[12].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteValuation); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[13].Exporter = func(v any, i int) any {
+			switch v := v.(*RouteFees); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -3397,7 +6285,43 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[2].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[14].Exporter = func(v any, i int) any {
+			switch v := v.(*FeeEstimate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[15].Exporter = func(v any, i int) any {
+			switch v := v.(*FindPathsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[16].Exporter = func(v any, i int) any {
+			switch v := v.(*RankedRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[17].Exporter = func(v any, i int) any {
 			switch v := v.(*DirectRoute); i {
 			case 0:
 				return &v.state
@@ -3409,7 +6333,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[3].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[18].Exporter = func(v any, i int) any {
 			switch v := v.(*IndirectRoute); i {
 			case 0:
 				return &v.state
@@ -3421,7 +6345,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[4].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[19].Exporter = func(v any, i int) any {
 			switch v := v.(*BrokerSwapRoute); i {
 			case 0:
 				return &v.state
@@ -3433,7 +6357,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[5].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[20].Exporter = func(v any, i int) any {
 			switch v := v.(*BrokerExecutionData); i {
 			case 0:
 				return &v.state
@@ -3445,7 +6369,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[6].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[21].Exporter = func(v any, i int) any {
 			switch v := v.(*IBCLeg); i {
 			case 0:
 				return &v.state
@@ -3457,7 +6381,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[7].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[22].Exporter = func(v any, i int) any {
 			switch v := v.(*TokenMapping); i {
 			case 0:
 				return &v.state
@@ -3469,7 +6393,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[8].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[23].Exporter = func(v any, i int) any {
 			switch v := v.(*SwapQuote); i {
 			case 0:
 				return &v.state
@@ -3481,7 +6405,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[9].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[24].Exporter = func(v any, i int) any {
 			switch v := v.(*OsmosisRouteData); i {
 			case 0:
 				return &v.state
@@ -3493,7 +6417,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[10].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[25].Exporter = func(v any, i int) any {
 			switch v := v.(*OsmosisRoute); i {
 			case 0:
 				return &v.state
@@ -3505,7 +6429,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[11].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[26].Exporter = func(v any, i int) any {
 			switch v := v.(*OsmosisPool); i {
 			case 0:
 				return &v.state
@@ -3517,7 +6441,31 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[12].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[27].Exporter = func(v any, i int) any {
+			switch v := v.(*AstroportRouteData); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[28].Exporter = func(v any, i int) any {
+			switch v := v.(*AstroportHop); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[29].Exporter = func(v any, i int) any {
 			switch v := v.(*LookupDenomRequest); i {
 			case 0:
 				return &v.state
@@ -3529,7 +6477,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[13].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[30].Exporter = func(v any, i int) any {
 			switch v := v.(*LookupDenomResponse); i {
 			case 0:
 				return &v.state
@@ -3541,7 +6489,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[14].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[31].Exporter = func(v any, i int) any {
 			switch v := v.(*ChainDenom); i {
 			case 0:
 				return &v.state
@@ -3553,7 +6501,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[15].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[32].Exporter = func(v any, i int) any {
 			switch v := v.(*GetTokenDenomsRequest); i {
 			case 0:
 				return &v.state
@@ -3565,7 +6513,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[16].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[33].Exporter = func(v any, i int) any {
 			switch v := v.(*GetTokenDenomsResponse); i {
 			case 0:
 				return &v.state
@@ -3577,7 +6525,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[17].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[34].Exporter = func(v any, i int) any {
 			switch v := v.(*GetChainTokensRequest); i {
 			case 0:
 				return &v.state
@@ -3589,7 +6537,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[18].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[35].Exporter = func(v any, i int) any {
 			switch v := v.(*GetChainTokensResponse); i {
 			case 0:
 				return &v.state
@@ -3601,7 +6549,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[19].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[36].Exporter = func(v any, i int) any {
 			switch v := v.(*TokenDetails); i {
 			case 0:
 				return &v.state
@@ -3613,7 +6561,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[20].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[37].Exporter = func(v any, i int) any {
 			switch v := v.(*PathfinderSupportedChainsResponse); i {
 			case 0:
 				return &v.state
@@ -3625,7 +6573,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[21].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[38].Exporter = func(v any, i int) any {
 			switch v := v.(*ChainInfoRequest); i {
 			case 0:
 				return &v.state
@@ -3637,7 +6585,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[22].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[39].Exporter = func(v any, i int) any {
 			switch v := v.(*ChainInfoResponse); i {
 			case 0:
 				return &v.state
@@ -3649,7 +6597,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[23].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[40].Exporter = func(v any, i int) any {
 			switch v := v.(*ChainInfo); i {
 			case 0:
 				return &v.state
@@ -3661,7 +6609,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[24].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[41].Exporter = func(v any, i int) any {
 			switch v := v.(*TokenInfo); i {
 			case 0:
 				return &v.state
@@ -3673,7 +6621,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[25].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[42].Exporter = func(v any, i int) any {
 			switch v := v.(*BasicRoute); i {
 			case 0:
 				return &v.state
@@ -3685,7 +6633,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[26].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[43].Exporter = func(v any, i int) any {
 			switch v := v.(*WasmData); i {
 			case 0:
 				return &v.state
@@ -3697,7 +6645,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[27].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[44].Exporter = func(v any, i int) any {
 			switch v := v.(*WasmMsg); i {
 			case 0:
 				return &v.state
@@ -3709,7 +6657,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[28].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[45].Exporter = func(v any, i int) any {
 			switch v := v.(*SwapAndAction); i {
 			case 0:
 				return &v.state
@@ -3721,7 +6669,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[29].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[46].Exporter = func(v any, i int) any {
 			switch v := v.(*SwapExactAssetIn); i {
 			case 0:
 				return &v.state
@@ -3733,7 +6681,55 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[30].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[47].Exporter = func(v any, i int) any {
+			switch v := v.(*SwapExactAssetOut); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[48].Exporter = func(v any, i int) any {
+			switch v := v.(*SmartSwapExactAssetIn); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[49].Exporter = func(v any, i int) any {
+			switch v := v.(*SwapRoute); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[50].Exporter = func(v any, i int) any {
+			switch v := v.(*OfferAsset); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[51].Exporter = func(v any, i int) any {
 			switch v := v.(*SwapOperation); i {
 			case 0:
 				return &v.state
@@ -3745,7 +6741,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[31].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[52].Exporter = func(v any, i int) any {
 			switch v := v.(*MinAsset); i {
 			case 0:
 				return &v.state
@@ -3757,7 +6753,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[32].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[53].Exporter = func(v any, i int) any {
 			switch v := v.(*Asset); i {
 			case 0:
 				return &v.state
@@ -3769,7 +6765,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[33].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[54].Exporter = func(v any, i int) any {
 			switch v := v.(*PostSwapAction); i {
 			case 0:
 				return &v.state
@@ -3781,7 +6777,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[34].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[55].Exporter = func(v any, i int) any {
 			switch v := v.(*IBCTransfer); i {
 			case 0:
 				return &v.state
@@ -3793,7 +6789,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[35].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[56].Exporter = func(v any, i int) any {
 			switch v := v.(*Transfer); i {
 			case 0:
 				return &v.state
@@ -3805,7 +6801,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[36].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[57].Exporter = func(v any, i int) any {
 			switch v := v.(*IBCInfo); i {
 			case 0:
 				return &v.state
@@ -3817,7 +6813,7 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
-		file_pathfinder_route_proto_msgTypes[37].Exporter = func(v any, i int) any {
+		file_pathfinder_route_proto_msgTypes[58].Exporter = func(v any, i int) any {
 			switch v := v.(*UserSwap); i {
 			case 0:
 				return &v.state
@@ -3829,18 +6825,141 @@ func file_pathfinder_route_proto_init() {
 				return nil
 			}
 		}
+		file_pathfinder_route_proto_msgTypes[59].Exporter = func(v any, i int) any {
+			switch v := v.(*BuildTransactionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[60].Exporter = func(v any, i int) any {
+			switch v := v.(*TransactionTimeout); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[61].Exporter = func(v any, i int) any {
+			switch v := v.(*IBCHeight); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[62].Exporter = func(v any, i int) any {
+			switch v := v.(*BuildTransactionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[63].Exporter = func(v any, i int) any {
+			switch v := v.(*UnsignedTransaction); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[64].Exporter = func(v any, i int) any {
+			switch v := v.(*UnsignedMessage); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[65].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenPricesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[66].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenPriceQuery); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[67].Exporter = func(v any, i int) any {
+			switch v := v.(*GetTokenPricesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_pathfinder_route_proto_msgTypes[68].Exporter = func(v any, i int) any {
+			switch v := v.(*TokenPrice); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
 	}
-	file_pathfinder_route_proto_msgTypes[1].OneofWrappers = []any{
+	file_pathfinder_route_proto_msgTypes[0].OneofWrappers = []any{}
+	file_pathfinder_route_proto_msgTypes[9].OneofWrappers = []any{}
+	file_pathfinder_route_proto_msgTypes[10].OneofWrappers = []any{
 		(*FindPathResponse_Direct)(nil),
 		(*FindPathResponse_Indirect)(nil),
 		(*FindPathResponse_BrokerSwap)(nil),
 	}
-	file_pathfinder_route_proto_msgTypes[5].OneofWrappers = []any{}
-	file_pathfinder_route_proto_msgTypes[8].OneofWrappers = []any{
+	file_pathfinder_route_proto_msgTypes[20].OneofWrappers = []any{}
+	file_pathfinder_route_proto_msgTypes[23].OneofWrappers = []any{
 		(*SwapQuote_OsmosisRouteData)(nil),
+		(*SwapQuote_AstroportRouteData)(nil),
 	}
-	file_pathfinder_route_proto_msgTypes[30].OneofWrappers = []any{}
-	file_pathfinder_route_proto_msgTypes[33].OneofWrappers = []any{
+	file_pathfinder_route_proto_msgTypes[51].OneofWrappers = []any{}
+	file_pathfinder_route_proto_msgTypes[54].OneofWrappers = []any{
 		(*PostSwapAction_IbcTransfer)(nil),
 		(*PostSwapAction_Transfer)(nil),
 	}
@@ -3850,7 +6969,7 @@ func file_pathfinder_route_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_pathfinder_route_proto_rawDesc,
 			NumEnums:      0,
-			NumMessages:   39,
+			NumMessages:   70,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
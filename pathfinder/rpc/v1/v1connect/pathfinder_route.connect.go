@@ -37,6 +37,9 @@ const (
 	// PathfinderServiceFindPathProcedure is the fully-qualified name of the PathfinderService's
 	// FindPath RPC.
 	PathfinderServiceFindPathProcedure = "/pathfinder.v1.PathfinderService/FindPath"
+	// PathfinderServiceFindPathsProcedure is the fully-qualified name of the PathfinderService's
+	// FindPaths RPC.
+	PathfinderServiceFindPathsProcedure = "/pathfinder.v1.PathfinderService/FindPaths"
 	// PathfinderServiceLookupDenomProcedure is the fully-qualified name of the PathfinderService's
 	// LookupDenom RPC.
 	PathfinderServiceLookupDenomProcedure = "/pathfinder.v1.PathfinderService/LookupDenom"
@@ -52,6 +55,21 @@ const (
 	// PathfinderServiceGetChainTokensProcedure is the fully-qualified name of the PathfinderService's
 	// GetChainTokens RPC.
 	PathfinderServiceGetChainTokensProcedure = "/pathfinder.v1.PathfinderService/GetChainTokens"
+	// PathfinderServiceBuildTransactionProcedure is the fully-qualified name of the PathfinderService's
+	// BuildTransaction RPC.
+	PathfinderServiceBuildTransactionProcedure = "/pathfinder.v1.PathfinderService/BuildTransaction"
+	// PathfinderServiceGetTokenPricesProcedure is the fully-qualified name of the PathfinderService's
+	// GetTokenPrices RPC.
+	PathfinderServiceGetTokenPricesProcedure = "/pathfinder.v1.PathfinderService/GetTokenPrices"
+	// PathfinderServiceBatchFindPathProcedure is the fully-qualified name of the PathfinderService's
+	// BatchFindPath RPC.
+	PathfinderServiceBatchFindPathProcedure = "/pathfinder.v1.PathfinderService/BatchFindPath"
+	// PathfinderServicePlanConsolidationProcedure is the fully-qualified name of the
+	// PathfinderService's PlanConsolidation RPC.
+	PathfinderServicePlanConsolidationProcedure = "/pathfinder.v1.PathfinderService/PlanConsolidation"
+	// PathfinderServiceWatchQuoteProcedure is the fully-qualified name of the PathfinderService's
+	// WatchQuote RPC.
+	PathfinderServiceWatchQuoteProcedure = "/pathfinder.v1.PathfinderService/WatchQuote"
 )
 
 // PathfinderServiceClient is a client for the pathfinder.v1.PathfinderService service.
@@ -59,6 +77,9 @@ type PathfinderServiceClient interface {
 	// FindPath finds and validates a route between two chains
 	// Supports human-readable denoms (e.g., "uatone") or IBC denoms
 	FindPath(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error)
+	// FindPaths evaluates every viable route between two chains and returns them ranked
+	// Routes are scored by expected output after swap fees and hop count, best route first
+	FindPaths(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathsResponse], error)
 	// LookupDenom resolves denom information on a specific chain
 	// Accepts human-readable base denoms or IBC denom hashes
 	LookupDenom(context.Context, *connect.Request[v1.LookupDenomRequest]) (*connect.Response[v1.LookupDenomResponse], error)
@@ -72,6 +93,22 @@ type PathfinderServiceClient interface {
 	// GetChainTokens returns all tokens available on a specific chain
 	// Includes both native tokens and IBC tokens with their denoms
 	GetChainTokens(context.Context, *connect.Request[v1.GetChainTokensRequest]) (*connect.Response[v1.GetChainTokensResponse], error)
+	// BuildTransaction builds the unsigned transactions that execute a route returned by FindPath or FindPaths
+	// Messages are returned protobuf encoded, as Amino JSON and as the body bytes of a direct sign doc
+	BuildTransaction(context.Context, *connect.Request[v1.BuildTransactionRequest]) (*connect.Response[v1.BuildTransactionResponse], error)
+	// GetTokenPrices returns the USD prices of tokens from the configured price providers
+	// Accepts human-readable base denoms or IBC denom hashes
+	GetTokenPrices(context.Context, *connect.Request[v1.GetTokenPricesRequest]) (*connect.Response[v1.GetTokenPricesResponse], error)
+	// BatchFindPath finds the routes of several FindPathRequests at once
+	// The requests are run concurrently, every request gets its own result in the order of the requests
+	BatchFindPath(context.Context, *connect.Request[v1.BatchFindPathRequest]) (*connect.Response[v1.BatchFindPathResponse], error)
+	// PlanConsolidation plans moving balances on several chains into one token on one chain
+	// Every balance gets a route, their transfers are grouped into the fewest transactions per chain
+	PlanConsolidation(context.Context, *connect.Request[v1.PlanConsolidationRequest]) (*connect.Response[v1.PlanConsolidationResponse], error)
+	// WatchQuote streams the route of a FindPathRequest, re-quoting its swap on an interval
+	// The first route found is sent right away, after that a route is only sent when its quote changes
+	// beyond the threshold. The stream ends when the client cancels it or after 15 minutes
+	WatchQuote(context.Context, *connect.Request[v1.WatchQuoteRequest]) (*connect.ServerStreamForClient[v1.FindPathResponse], error)
 }
 
 // NewPathfinderServiceClient constructs a client for the pathfinder.v1.PathfinderService service.
@@ -92,6 +129,13 @@ func NewPathfinderServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		findPaths: connect.NewClient[v1.FindPathRequest, v1.FindPathsResponse](
+			httpClient,
+			baseURL+PathfinderServiceFindPathsProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("FindPaths")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
 		lookupDenom: connect.NewClient[v1.LookupDenomRequest, v1.LookupDenomResponse](
 			httpClient,
 			baseURL+PathfinderServiceLookupDenomProcedure,
@@ -127,17 +171,57 @@ func NewPathfinderServiceClient(httpClient connect.HTTPClient, baseURL string, o
 			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 			connect.WithClientOptions(opts...),
 		),
+		buildTransaction: connect.NewClient[v1.BuildTransactionRequest, v1.BuildTransactionResponse](
+			httpClient,
+			baseURL+PathfinderServiceBuildTransactionProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("BuildTransaction")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		getTokenPrices: connect.NewClient[v1.GetTokenPricesRequest, v1.GetTokenPricesResponse](
+			httpClient,
+			baseURL+PathfinderServiceGetTokenPricesProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("GetTokenPrices")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		batchFindPath: connect.NewClient[v1.BatchFindPathRequest, v1.BatchFindPathResponse](
+			httpClient,
+			baseURL+PathfinderServiceBatchFindPathProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("BatchFindPath")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		planConsolidation: connect.NewClient[v1.PlanConsolidationRequest, v1.PlanConsolidationResponse](
+			httpClient,
+			baseURL+PathfinderServicePlanConsolidationProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("PlanConsolidation")),
+			connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+			connect.WithClientOptions(opts...),
+		),
+		watchQuote: connect.NewClient[v1.WatchQuoteRequest, v1.FindPathResponse](
+			httpClient,
+			baseURL+PathfinderServiceWatchQuoteProcedure,
+			connect.WithSchema(pathfinderServiceMethods.ByName("WatchQuote")),
+			connect.WithClientOptions(opts...),
+		),
 	}
 }
 
 // pathfinderServiceClient implements PathfinderServiceClient.
 type pathfinderServiceClient struct {
 	findPath            *connect.Client[v1.FindPathRequest, v1.FindPathResponse]
+	findPaths           *connect.Client[v1.FindPathRequest, v1.FindPathsResponse]
 	lookupDenom         *connect.Client[v1.LookupDenomRequest, v1.LookupDenomResponse]
 	getTokenDenoms      *connect.Client[v1.GetTokenDenomsRequest, v1.GetTokenDenomsResponse]
 	getChainInfo        *connect.Client[v1.ChainInfoRequest, v1.ChainInfoResponse]
 	listSupportedChains *connect.Client[emptypb.Empty, v1.PathfinderSupportedChainsResponse]
 	getChainTokens      *connect.Client[v1.GetChainTokensRequest, v1.GetChainTokensResponse]
+	buildTransaction    *connect.Client[v1.BuildTransactionRequest, v1.BuildTransactionResponse]
+	getTokenPrices      *connect.Client[v1.GetTokenPricesRequest, v1.GetTokenPricesResponse]
+	batchFindPath       *connect.Client[v1.BatchFindPathRequest, v1.BatchFindPathResponse]
+	planConsolidation   *connect.Client[v1.PlanConsolidationRequest, v1.PlanConsolidationResponse]
+	watchQuote          *connect.Client[v1.WatchQuoteRequest, v1.FindPathResponse]
 }
 
 // FindPath calls pathfinder.v1.PathfinderService.FindPath.
@@ -145,6 +229,11 @@ func (c *pathfinderServiceClient) FindPath(ctx context.Context, req *connect.Req
 	return c.findPath.CallUnary(ctx, req)
 }
 
+// FindPaths calls pathfinder.v1.PathfinderService.FindPaths.
+func (c *pathfinderServiceClient) FindPaths(ctx context.Context, req *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathsResponse], error) {
+	return c.findPaths.CallUnary(ctx, req)
+}
+
 // LookupDenom calls pathfinder.v1.PathfinderService.LookupDenom.
 func (c *pathfinderServiceClient) LookupDenom(ctx context.Context, req *connect.Request[v1.LookupDenomRequest]) (*connect.Response[v1.LookupDenomResponse], error) {
 	return c.lookupDenom.CallUnary(ctx, req)
@@ -170,11 +259,39 @@ func (c *pathfinderServiceClient) GetChainTokens(ctx context.Context, req *conne
 	return c.getChainTokens.CallUnary(ctx, req)
 }
 
+// BuildTransaction calls pathfinder.v1.PathfinderService.BuildTransaction.
+func (c *pathfinderServiceClient) BuildTransaction(ctx context.Context, req *connect.Request[v1.BuildTransactionRequest]) (*connect.Response[v1.BuildTransactionResponse], error) {
+	return c.buildTransaction.CallUnary(ctx, req)
+}
+
+// GetTokenPrices calls pathfinder.v1.PathfinderService.GetTokenPrices.
+func (c *pathfinderServiceClient) GetTokenPrices(ctx context.Context, req *connect.Request[v1.GetTokenPricesRequest]) (*connect.Response[v1.GetTokenPricesResponse], error) {
+	return c.getTokenPrices.CallUnary(ctx, req)
+}
+
+// BatchFindPath calls pathfinder.v1.PathfinderService.BatchFindPath.
+func (c *pathfinderServiceClient) BatchFindPath(ctx context.Context, req *connect.Request[v1.BatchFindPathRequest]) (*connect.Response[v1.BatchFindPathResponse], error) {
+	return c.batchFindPath.CallUnary(ctx, req)
+}
+
+// PlanConsolidation calls pathfinder.v1.PathfinderService.PlanConsolidation.
+func (c *pathfinderServiceClient) PlanConsolidation(ctx context.Context, req *connect.Request[v1.PlanConsolidationRequest]) (*connect.Response[v1.PlanConsolidationResponse], error) {
+	return c.planConsolidation.CallUnary(ctx, req)
+}
+
+// WatchQuote calls pathfinder.v1.PathfinderService.WatchQuote.
+func (c *pathfinderServiceClient) WatchQuote(ctx context.Context, req *connect.Request[v1.WatchQuoteRequest]) (*connect.ServerStreamForClient[v1.FindPathResponse], error) {
+	return c.watchQuote.CallServerStream(ctx, req)
+}
+
 // PathfinderServiceHandler is an implementation of the pathfinder.v1.PathfinderService service.
 type PathfinderServiceHandler interface {
 	// FindPath finds and validates a route between two chains
 	// Supports human-readable denoms (e.g., "uatone") or IBC denoms
 	FindPath(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathResponse], error)
+	// FindPaths evaluates every viable route between two chains and returns them ranked
+	// Routes are scored by expected output after swap fees and hop count, best route first
+	FindPaths(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathsResponse], error)
 	// LookupDenom resolves denom information on a specific chain
 	// Accepts human-readable base denoms or IBC denom hashes
 	LookupDenom(context.Context, *connect.Request[v1.LookupDenomRequest]) (*connect.Response[v1.LookupDenomResponse], error)
@@ -188,6 +305,22 @@ type PathfinderServiceHandler interface {
 	// GetChainTokens returns all tokens available on a specific chain
 	// Includes both native tokens and IBC tokens with their denoms
 	GetChainTokens(context.Context, *connect.Request[v1.GetChainTokensRequest]) (*connect.Response[v1.GetChainTokensResponse], error)
+	// BuildTransaction builds the unsigned transactions that execute a route returned by FindPath or FindPaths
+	// Messages are returned protobuf encoded, as Amino JSON and as the body bytes of a direct sign doc
+	BuildTransaction(context.Context, *connect.Request[v1.BuildTransactionRequest]) (*connect.Response[v1.BuildTransactionResponse], error)
+	// GetTokenPrices returns the USD prices of tokens from the configured price providers
+	// Accepts human-readable base denoms or IBC denom hashes
+	GetTokenPrices(context.Context, *connect.Request[v1.GetTokenPricesRequest]) (*connect.Response[v1.GetTokenPricesResponse], error)
+	// BatchFindPath finds the routes of several FindPathRequests at once
+	// The requests are run concurrently, every request gets its own result in the order of the requests
+	BatchFindPath(context.Context, *connect.Request[v1.BatchFindPathRequest]) (*connect.Response[v1.BatchFindPathResponse], error)
+	// PlanConsolidation plans moving balances on several chains into one token on one chain
+	// Every balance gets a route, their transfers are grouped into the fewest transactions per chain
+	PlanConsolidation(context.Context, *connect.Request[v1.PlanConsolidationRequest]) (*connect.Response[v1.PlanConsolidationResponse], error)
+	// WatchQuote streams the route of a FindPathRequest, re-quoting its swap on an interval
+	// The first route found is sent right away, after that a route is only sent when its quote changes
+	// beyond the threshold. The stream ends when the client cancels it or after 15 minutes
+	WatchQuote(context.Context, *connect.Request[v1.WatchQuoteRequest], *connect.ServerStream[v1.FindPathResponse]) error
 }
 
 // NewPathfinderServiceHandler builds an HTTP handler from the service implementation. It returns
@@ -204,6 +337,13 @@ func NewPathfinderServiceHandler(svc PathfinderServiceHandler, opts ...connect.H
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	pathfinderServiceFindPathsHandler := connect.NewUnaryHandler(
+		PathfinderServiceFindPathsProcedure,
+		svc.FindPaths,
+		connect.WithSchema(pathfinderServiceMethods.ByName("FindPaths")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
 	pathfinderServiceLookupDenomHandler := connect.NewUnaryHandler(
 		PathfinderServiceLookupDenomProcedure,
 		svc.LookupDenom,
@@ -239,10 +379,46 @@ func NewPathfinderServiceHandler(svc PathfinderServiceHandler, opts ...connect.H
 		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
 		connect.WithHandlerOptions(opts...),
 	)
+	pathfinderServiceBuildTransactionHandler := connect.NewUnaryHandler(
+		PathfinderServiceBuildTransactionProcedure,
+		svc.BuildTransaction,
+		connect.WithSchema(pathfinderServiceMethods.ByName("BuildTransaction")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pathfinderServiceGetTokenPricesHandler := connect.NewUnaryHandler(
+		PathfinderServiceGetTokenPricesProcedure,
+		svc.GetTokenPrices,
+		connect.WithSchema(pathfinderServiceMethods.ByName("GetTokenPrices")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pathfinderServiceBatchFindPathHandler := connect.NewUnaryHandler(
+		PathfinderServiceBatchFindPathProcedure,
+		svc.BatchFindPath,
+		connect.WithSchema(pathfinderServiceMethods.ByName("BatchFindPath")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pathfinderServicePlanConsolidationHandler := connect.NewUnaryHandler(
+		PathfinderServicePlanConsolidationProcedure,
+		svc.PlanConsolidation,
+		connect.WithSchema(pathfinderServiceMethods.ByName("PlanConsolidation")),
+		connect.WithIdempotency(connect.IdempotencyNoSideEffects),
+		connect.WithHandlerOptions(opts...),
+	)
+	pathfinderServiceWatchQuoteHandler := connect.NewServerStreamHandler(
+		PathfinderServiceWatchQuoteProcedure,
+		svc.WatchQuote,
+		connect.WithSchema(pathfinderServiceMethods.ByName("WatchQuote")),
+		connect.WithHandlerOptions(opts...),
+	)
 	return "/pathfinder.v1.PathfinderService/", http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		switch r.URL.Path {
 		case PathfinderServiceFindPathProcedure:
 			pathfinderServiceFindPathHandler.ServeHTTP(w, r)
+		case PathfinderServiceFindPathsProcedure:
+			pathfinderServiceFindPathsHandler.ServeHTTP(w, r)
 		case PathfinderServiceLookupDenomProcedure:
 			pathfinderServiceLookupDenomHandler.ServeHTTP(w, r)
 		case PathfinderServiceGetTokenDenomsProcedure:
@@ -253,6 +429,16 @@ func NewPathfinderServiceHandler(svc PathfinderServiceHandler, opts ...connect.H
 			pathfinderServiceListSupportedChainsHandler.ServeHTTP(w, r)
 		case PathfinderServiceGetChainTokensProcedure:
 			pathfinderServiceGetChainTokensHandler.ServeHTTP(w, r)
+		case PathfinderServiceBuildTransactionProcedure:
+			pathfinderServiceBuildTransactionHandler.ServeHTTP(w, r)
+		case PathfinderServiceGetTokenPricesProcedure:
+			pathfinderServiceGetTokenPricesHandler.ServeHTTP(w, r)
+		case PathfinderServiceBatchFindPathProcedure:
+			pathfinderServiceBatchFindPathHandler.ServeHTTP(w, r)
+		case PathfinderServicePlanConsolidationProcedure:
+			pathfinderServicePlanConsolidationHandler.ServeHTTP(w, r)
+		case PathfinderServiceWatchQuoteProcedure:
+			pathfinderServiceWatchQuoteHandler.ServeHTTP(w, r)
 		default:
 			http.NotFound(w, r)
 		}
@@ -266,6 +452,10 @@ func (UnimplementedPathfinderServiceHandler) FindPath(context.Context, *connect.
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.FindPath is not implemented"))
 }
 
+func (UnimplementedPathfinderServiceHandler) FindPaths(context.Context, *connect.Request[v1.FindPathRequest]) (*connect.Response[v1.FindPathsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.FindPaths is not implemented"))
+}
+
 func (UnimplementedPathfinderServiceHandler) LookupDenom(context.Context, *connect.Request[v1.LookupDenomRequest]) (*connect.Response[v1.LookupDenomResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.LookupDenom is not implemented"))
 }
@@ -285,3 +475,23 @@ func (UnimplementedPathfinderServiceHandler) ListSupportedChains(context.Context
 func (UnimplementedPathfinderServiceHandler) GetChainTokens(context.Context, *connect.Request[v1.GetChainTokensRequest]) (*connect.Response[v1.GetChainTokensResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.GetChainTokens is not implemented"))
 }
+
+func (UnimplementedPathfinderServiceHandler) BuildTransaction(context.Context, *connect.Request[v1.BuildTransactionRequest]) (*connect.Response[v1.BuildTransactionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.BuildTransaction is not implemented"))
+}
+
+func (UnimplementedPathfinderServiceHandler) GetTokenPrices(context.Context, *connect.Request[v1.GetTokenPricesRequest]) (*connect.Response[v1.GetTokenPricesResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.GetTokenPrices is not implemented"))
+}
+
+func (UnimplementedPathfinderServiceHandler) BatchFindPath(context.Context, *connect.Request[v1.BatchFindPathRequest]) (*connect.Response[v1.BatchFindPathResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.BatchFindPath is not implemented"))
+}
+
+func (UnimplementedPathfinderServiceHandler) PlanConsolidation(context.Context, *connect.Request[v1.PlanConsolidationRequest]) (*connect.Response[v1.PlanConsolidationResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.PlanConsolidation is not implemented"))
+}
+
+func (UnimplementedPathfinderServiceHandler) WatchQuote(context.Context, *connect.Request[v1.WatchQuoteRequest], *connect.ServerStream[v1.FindPathResponse]) error {
+	return connect.NewError(connect.CodeUnimplemented, errors.New("pathfinder.v1.PathfinderService.WatchQuote is not implemented"))
+}
@@ -1,6 +1,7 @@
 package sqsquery
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -15,6 +16,7 @@ import (
 
 	"github.com/rs/zerolog"
 	"github.com/shopspring/decimal"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
 )
 
 var log zerolog.Logger
@@ -86,8 +88,10 @@ func NewSqsQueryClientWithFailover(urls []string, config FailoverConfig) *SqsQue
 	copy(healthyURLs, urls)
 
 	client := &SqsQueryClient{
+		// The OTel transport creates a client span for every request and propagates the trace to SQS
 		httpClient: &http.Client{
-			Timeout: config.Timeout,
+			Timeout:   config.Timeout,
+			Transport: otelhttp.NewTransport(http.DefaultTransport),
 		},
 		urls:           urls,
 		healthyURLs:    healthyURLs,
@@ -204,21 +208,27 @@ func (c *SqsQueryClient) Close() {
 	}
 }
 
-// doRequestWithFailover performs an HTTP GET request with retry and failover logic
-func (c *SqsQueryClient) doRequestWithFailover(path string) ([]byte, error) {
+// doRequestWithFailover performs an HTTP GET request with retry and failover logic.
+// Retries and failover stop as soon as ctx is done.
+func (c *SqsQueryClient) doRequestWithFailover(ctx context.Context, path string) ([]byte, error) {
 	var lastErr error
 	retryDelay := c.failoverConfig.RetryDelay
 
 	// Try on current endpoint with retries
 	for attempt := 0; attempt <= c.failoverConfig.MaxRetries; attempt++ {
 		if attempt > 0 {
-			time.Sleep(retryDelay)
+			if err := sleepContext(ctx, retryDelay); err != nil {
+				return nil, err
+			}
 			retryDelay *= 2
 		}
 
 		fullURL := c.getRandomHealthyURL() + path
-		resp, err := c.httpClient.Get(fullURL)
+		resp, err := c.get(ctx, fullURL)
 		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
 			lastErr = err
 			continue
 		}
@@ -243,7 +253,7 @@ func (c *SqsQueryClient) doRequestWithFailover(path string) ([]byte, error) {
 	if len(c.healthyURLs) > 0 && c.getRandomHealthyURL() != "" {
 		// Retry once on the new endpoint
 		fullURL := c.getRandomHealthyURL() + path
-		resp, err := c.httpClient.Get(fullURL)
+		resp, err := c.get(ctx, fullURL)
 		if err != nil {
 			return nil, fmt.Errorf("failover request failed: %w (original: %w)", err, lastErr)
 		}
@@ -265,6 +275,28 @@ func (c *SqsQueryClient) doRequestWithFailover(path string) ([]byte, error) {
 	return nil, fmt.Errorf("request failed after %d retries: %w", c.failoverConfig.MaxRetries+1, lastErr)
 }
 
+// get sends a GET request bound to ctx
+func (c *SqsQueryClient) get(ctx context.Context, fullURL string) (*http.Response, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, fullURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	return c.httpClient.Do(req)
+}
+
+// sleep waits for delay, returning early with the context error if ctx is done first
+func sleepContext(ctx context.Context, delay time.Duration) error {
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
 /*
 GetRoute returns the best quote it can compute for the exact in or exact out token swap method.
 
@@ -280,6 +312,7 @@ So when using this query, you can only use one of the following parameters:
 - tokenOut and tokenInDenom
 */
 func (c *SqsQueryClient) GetRoute(
+	ctx context.Context,
 	tokenIn, tokenOut *TokenRequest,
 	tokenInDenom, tokenOutDenom *string,
 	singleRoute bool) (RouteTokenResponse, error) {
@@ -318,7 +351,7 @@ func (c *SqsQueryClient) GetRoute(
 		return RouteTokenResponse{}, errors.New("invalid parameters")
 	}
 
-	body, err := c.doRequestWithFailover(path)
+	body, err := c.doRequestWithFailover(ctx, path)
 	if err != nil {
 		return RouteTokenResponse{}, err
 	}
@@ -330,10 +363,10 @@ func (c *SqsQueryClient) GetRoute(
 }
 
 // GetTokenPrice fetches the price of a token in USD terms
-func (c *SqsQueryClient) GetTokenPrice(tokenDenom string) (decimal.Decimal, error) {
+func (c *SqsQueryClient) GetTokenPrice(ctx context.Context, tokenDenom string) (decimal.Decimal, error) {
 	path := fmt.Sprintf("/token-price?tokenDenom=%s", url.QueryEscape(tokenDenom))
 
-	body, err := c.doRequestWithFailover(path)
+	body, err := c.doRequestWithFailover(ctx, path)
 	if err != nil {
 		return decimal.Decimal{}, err
 	}
@@ -375,13 +408,13 @@ func (c *SqsQueryClient) GetTokenPrice(tokenDenom string) (decimal.Decimal, erro
 }
 
 // GetAllPossibleRoutes returns all possible routes between two tokens
-func (c *SqsQueryClient) GetAllPossibleRoutes(tokenInDenom, tokenOutDenom string) (AllPossibleRoutesResponse, error) {
+func (c *SqsQueryClient) GetAllPossibleRoutes(ctx context.Context, tokenInDenom, tokenOutDenom string) (AllPossibleRoutesResponse, error) {
 	path := fmt.Sprintf(
 		"/router/routes?tokenInDenom=%s&tokenOutDenom=%s",
 		url.QueryEscape(tokenInDenom), url.QueryEscape(tokenOutDenom),
 	)
 
-	body, err := c.doRequestWithFailover(path)
+	body, err := c.doRequestWithFailover(ctx, path)
 	if err != nil {
 		return AllPossibleRoutesResponse{}, err
 	}
@@ -28,6 +28,8 @@ type Pool struct {
 	Balances      []any  `json:"balances"`
 	SpreadFactor  string `json:"spread_factor"`
 	TokenOutDenom string `json:"token_out_denom"`
+	// TokenInDenom is only set on exact amount out quotes, instead of TokenOutDenom
+	TokenInDenom string `json:"token_in_denom"`
 	TakerFee      string `json:"taker_fee"`
 	LiquidityCap  string `json:"liquidity_cap"`
 }